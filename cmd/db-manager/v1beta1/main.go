@@ -20,21 +20,49 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
 	"os"
+	"time"
 
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	db "github.com/kubeflow/katib/pkg/db/v1beta1"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	"k8s.io/klog"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
 )
 
 const (
 	port = "0.0.0.0:6789"
+
+	// streamBatchSize is the max number of metric logs inserted at once by StreamObservationLog.
+	streamBatchSize = 500
+	// streamFlushInterval is the max time metric logs received by StreamObservationLog wait for insert.
+	streamFlushInterval = time.Second
+)
+
+var (
+	tlsCertFile     = flag.String("tls-cert-file", "", "Server certificate file. TLS is enabled if it is set, grpc_health_probe must use -tls flags then")
+	tlsKeyFile      = flag.String("tls-key-file", "", "Server private key file")
+	tlsClientCAFile = flag.String("tls-client-ca-file", "", "CA certificate file to verify clients. Clients must present certificates (mTLS) if it is set, except metrics collectors with Trial tokens")
+	tokenFile       = flag.String("token-file", "", "File with the bearer token. Clients must send this token if it is set, TLS must be enabled")
+	metricsAddr     = flag.String("metrics-addr", "", "The address the Prometheus metrics endpoint binds to. Metrics endpoint is disabled if it is empty")
+	httpAddr        = flag.String("http-addr", "0.0.0.0:"+consts.DefaultKatibDBManagerServiceHTTPPort, "The address the HTTP endpoint to push metrics binds to")
+	trialKeyFile    = flag.String("trial-token-key-file", "", "File with the key to verify Trial tokens. HTTP endpoint to push metrics is enabled and metrics collectors can report metrics with Trial tokens if it is set")
 )
 
 var dbIf common.KatibDBInterface
@@ -46,22 +74,151 @@ type server struct {
 // The log consists of timestamp and value of metric.
 // Katib store every log of metrics.
 // You can see accuracy curve or other metric logs on UI.
+// If the source offset is set, logs which were already reported with the offset are skipped.
 func (s *server) ReportObservationLog(ctx context.Context, in *api_pb.ReportObservationLogRequest) (*api_pb.ReportObservationLogReply, error) {
-	err := dbIf.RegisterObservationLog(in.TrialName, in.ObservationLog)
+	err := registerObservationLog(in)
 	return &api_pb.ReportObservationLogReply{}, err
 }
 
+// registerObservationLog registers logs of the request, logs with the source offset are registered only once.
+func registerObservationLog(in *api_pb.ReportObservationLogRequest) error {
+	if in.SourceOffset <= 0 {
+		return dbIf.RegisterObservationLog(in.Namespace, in.ExperimentName, in.TrialName, in.TrialUid, in.ObservationLog)
+	}
+	registered, err := dbIf.RegisterObservationLogWithOffset(in.Namespace, in.ExperimentName, in.TrialName, in.TrialUid, in.Source, in.SourceOffset, in.ObservationLog)
+	if err == nil && !registered {
+		klog.Infof("Logs of Trial %s/%s from %s with offset %d are already reported", in.Namespace, in.TrialName, in.Source, in.SourceOffset)
+	}
+	return err
+}
+
+// Report a log of Observations for a Trial while metrics are collected.
+// Received logs are inserted in batches, the batch is flushed when it is full,
+// when it waits longer than streamFlushInterval and when the stream is finished or broken.
+// Logs with the source offset are registered separately, so they are skipped as a whole if they were already reported.
+func (s *server) StreamObservationLog(stream api_pb.DBManager_StreamObservationLogServer) error {
+	requests := make(chan *api_pb.ReportObservationLogRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			in, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- in:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	// batch contains logs of the single Trial, it is flushed once another Trial is reported.
+	var batch *api_pb.ReportObservationLogRequest
+	flush := func() error {
+		if batch == nil || len(batch.ObservationLog.MetricLogs) == 0 {
+			return nil
+		}
+		err := dbIf.RegisterObservationLog(batch.Namespace, batch.ExperimentName, batch.TrialName, batch.TrialUid, batch.ObservationLog)
+		batch = nil
+		return err
+	}
+
+	ticker := time.NewTicker(streamFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case in := <-requests:
+			if in.ObservationLog == nil {
+				continue
+			}
+			if in.SourceOffset > 0 {
+				if err := flush(); err != nil {
+					return err
+				}
+				if err := registerObservationLog(in); err != nil {
+					return err
+				}
+				continue
+			}
+			if batch != nil && (batch.Namespace != in.Namespace || batch.TrialName != in.TrialName || batch.TrialUid != in.TrialUid) {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			if batch == nil {
+				batch = &api_pb.ReportObservationLogRequest{
+					TrialName:      in.TrialName,
+					Namespace:      in.Namespace,
+					ExperimentName: in.ExperimentName,
+					TrialUid:       in.TrialUid,
+					ObservationLog: &api_pb.ObservationLog{},
+				}
+			}
+			batch.ObservationLog.MetricLogs = append(batch.ObservationLog.MetricLogs, in.ObservationLog.MetricLogs...)
+			if len(batch.ObservationLog.MetricLogs) >= streamBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		case err := <-recvErr:
+			// Logs received before the stream is broken are kept.
+			if flushErr := flush(); flushErr != nil {
+				return flushErr
+			}
+			if err != io.EOF {
+				klog.Warningf("StreamObservationLog is broken: %v", err)
+				return err
+			}
+			return stream.SendAndClose(&api_pb.ReportObservationLogReply{})
+		}
+	}
+}
+
 // Get all log of Observations for a Trial.
 func (s *server) GetObservationLog(ctx context.Context, in *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
-	ol, err := dbIf.GetObservationLog(in.TrialName, in.MetricName, in.StartTime, in.EndTime)
+	ol, err := dbIf.GetObservationLog(in.Namespace, in.TrialName, in.MetricName, in.StartTime, in.EndTime,
+		in.StartStep, in.EndStep, in.OrderByStep)
 	return &api_pb.GetObservationLogReply{
 		ObservationLog: ol,
 	}, err
 }
 
+// Get logs of Observations for many Trials and metrics in one call.
+func (s *server) GetObservationLogs(ctx context.Context, in *api_pb.GetObservationLogsRequest) (*api_pb.GetObservationLogsReply, error) {
+	logs, err := dbIf.GetObservationLogs(in.Namespace, in.TrialNames, in.MetricNames, in.StartTime, in.EndTime)
+	if err != nil {
+		return &api_pb.GetObservationLogsReply{}, err
+	}
+	reply := &api_pb.GetObservationLogsReply{
+		TrialObservationLogs: make([]*api_pb.TrialObservationLog, 0, len(in.TrialNames)),
+	}
+	for _, trialName := range in.TrialNames {
+		reply.TrialObservationLogs = append(reply.TrialObservationLogs, &api_pb.TrialObservationLog{
+			TrialName:      trialName,
+			ObservationLog: logs[trialName],
+		})
+	}
+	return reply, nil
+}
+
+// Get summary of Observations for a Trial.
+// The summary is computed by the DB and consists of min, max, latest value and number of logs for each metric.
+func (s *server) GetObservationSummary(ctx context.Context, in *api_pb.GetObservationSummaryRequest) (*api_pb.GetObservationSummaryReply, error) {
+	summaries, err := dbIf.GetObservationSummary(in.Namespace, in.TrialName, in.MetricNames)
+	return &api_pb.GetObservationSummaryReply{
+		MetricSummaries: summaries,
+	}, err
+}
+
 // Delete all log of Observations for a Trial.
 func (s *server) DeleteObservationLog(ctx context.Context, in *api_pb.DeleteObservationLogRequest) (*api_pb.DeleteObservationLogReply, error) {
-	err := dbIf.DeleteObservationLog(in.TrialName)
+	err := dbIf.DeleteObservationLog(in.Namespace, in.TrialName)
 	return &api_pb.DeleteObservationLogReply{}, err
 }
 
@@ -86,6 +243,34 @@ func (s *server) Check(ctx context.Context, in *health_pb.HealthCheckRequest) (*
 	return &resp, nil
 }
 
+func newKubeClient(kubeScheme *runtime.Scheme) (client.Client, error) {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{Scheme: kubeScheme})
+}
+
+// servePush serves HTTP endpoint to push metrics, the endpoint uses the same TLS certificate as gRPC server.
+func servePush(handler http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle(consts.KatibMetricsPushPath, handler)
+	pushServer := &http.Server{
+		Addr:    *httpAddr,
+		Handler: mux,
+	}
+	klog.Infof("Start HTTP endpoint to push metrics: %s", *httpAddr)
+	var err error
+	if *tlsCertFile != "" {
+		err = pushServer.ListenAndServeTLS(*tlsCertFile, *tlsKeyFile)
+	} else {
+		err = pushServer.ListenAndServe()
+	}
+	if err != nil {
+		klog.Fatalf("Failed to serve HTTP endpoint to push metrics: %v", err)
+	}
+}
+
 func main() {
 	flag.Parse()
 	var err error
@@ -99,14 +284,104 @@ func main() {
 		klog.Fatalf("Failed to open db connection: %v", err)
 	}
 	dbIf.DBInit()
+	if common.MatchLegacyNamespace() {
+		klog.Infof("Observation logs without namespace are selected by every namespace, %s is set", common.MatchLegacyNamespaceEnvName)
+	}
+
+	prometheus.MustRegister(observationLogsReaped)
+	if *metricsAddr != "" {
+		go func() {
+			http.Handle("/metrics", promhttp.Handler())
+			if err := http.ListenAndServe(*metricsAddr, nil); err != nil {
+				klog.Errorf("Failed to serve metrics: %v", err)
+			}
+		}()
+	}
+
+	// Retention reads katib-config and Trials, so it is disabled outside of Kubernetes cluster.
+	// Retention runs in every replica without leader election, so Katib DB Manager must have a single replica.
+	kubeScheme := runtime.NewScheme()
+	if err = clientgoscheme.AddToScheme(kubeScheme); err != nil {
+		klog.Fatalf("Failed to add Kubernetes types to scheme: %v", err)
+	}
+	if err = trialsv1beta1.AddToScheme(kubeScheme); err != nil {
+		klog.Fatalf("Failed to add Trial types to scheme: %v", err)
+	}
+	kubeClient, err := newKubeClient(kubeScheme)
+	if err != nil {
+		klog.Warningf("Observation log retention is disabled, failed to create Kubernetes client: %v", err)
+	} else {
+		go newObservationLogRetention(kubeClient, dbIf).Run(make(chan struct{}))
+	}
+
 	listener, err := net.Listen("tcp", port)
 	if err != nil {
 		klog.Fatalf("Failed to listen: %v", err)
 	}
 
 	size := 1<<31 - 1
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(size),
+		grpc.MaxSendMsgSize(size),
+		// Clients keep long-lived connections with keepalive pings, which must not be rejected as abusive.
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             katibmanagerv1beta1.DBManagerKeepaliveTime,
+			PermitWithoutStream: true,
+		}),
+	}
+	var push *pushHandler
+	if *trialKeyFile != "" {
+		// Trials are read to reject metrics of the completed Trials.
+		if kubeClient == nil {
+			klog.Fatal("trial-token-key-file can't be used without Kubernetes client")
+		}
+		key, err := katibmanagerv1beta1.ReadTokenFile(*trialKeyFile)
+		if err != nil {
+			klog.Fatalf("Failed to read Trial token key: %v", err)
+		}
+		push = newPushHandler(dbIf, kubeClient, []byte(key))
+		go servePush(push)
+	}
+
+	// Metrics collectors don't have client certificates, they report metrics with Trial tokens.
+	clientCertOptional := *tlsClientCAFile != "" && push != nil
+	if *tlsCertFile != "" {
+		creds, err := katibmanagerv1beta1.NewDBManagerServerCredentials(*tlsCertFile, *tlsKeyFile, *tlsClientCAFile, clientCertOptional)
+		if err != nil {
+			klog.Fatalf("Failed to load TLS credentials: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+		klog.Infof("TLS is enabled, client certificates are required: %v", *tlsClientCAFile != "")
+	} else if *tlsClientCAFile != "" {
+		klog.Fatal("tls-client-ca-file can't be used without tls-cert-file")
+	}
+	if *tokenFile != "" || clientCertOptional {
+		auth := &katibmanagerv1beta1.DBManagerServerAuth{
+			RequireClientCert: clientCertOptional,
+		}
+		if *tokenFile != "" {
+			// Token is a password, so it must not be sent over the insecure connection.
+			if *tlsCertFile == "" {
+				klog.Fatal("token-file can't be used without tls-cert-file")
+			}
+			if auth.Token, err = katibmanagerv1beta1.ReadTokenFile(*tokenFile); err != nil {
+				klog.Fatalf("Failed to read bearer token: %v", err)
+			}
+			klog.Info("Bearer token authentication is enabled")
+		}
+		if push != nil {
+			auth.TrialTokenKey = push.tokenKey
+			auth.CheckTrial = push.checkTrialStatus
+			klog.Info("Trial token authentication is enabled")
+		}
+		opts = append(opts,
+			grpc.UnaryInterceptor(auth.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(auth.StreamServerInterceptor()),
+		)
+	}
+
 	klog.Infof("Start Katib manager: %s", port)
-	s := grpc.NewServer(grpc.MaxRecvMsgSize(size), grpc.MaxSendMsgSize(size))
+	s := grpc.NewServer(opts...)
 	api_pb.RegisterDBManagerServer(s, &server{})
 	health_pb.RegisterHealthServer(s, &server{})
 	reflection.Register(s)
@@ -18,9 +18,13 @@ package main
 
 import (
 	"context"
+	"errors"
+	"io"
+	"reflect"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
 
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
@@ -35,7 +39,10 @@ func TestReportObservationLog(t *testing.T) {
 	dbIf = mockDB
 
 	req := &api_pb.ReportObservationLogRequest{
-		TrialName: "test1-trial1",
+		TrialName:      "test1-trial1",
+		Namespace:      "test-namespace",
+		ExperimentName: "test1",
+		TrialUid:       "test1-trial1-uid",
 		ObservationLog: &api_pb.ObservationLog{
 			MetricLogs: []*api_pb.MetricLog{
 				{
@@ -69,11 +76,139 @@ func TestReportObservationLog(t *testing.T) {
 			},
 		},
 	}
-	mockDB.EXPECT().RegisterObservationLog(req.TrialName, req.ObservationLog).Return(nil)
+	mockDB.EXPECT().RegisterObservationLog(req.Namespace, req.ExperimentName, req.TrialName, req.TrialUid, req.ObservationLog).Return(nil)
 	_, err := s.ReportObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("ReportObservationLog Error %v", err)
 	}
+
+	// Logs with the source offset are skipped if they were already reported.
+	req.Source = "test1-trial1-pod"
+	req.SourceOffset = 100
+	mockDB.EXPECT().RegisterObservationLogWithOffset(req.Namespace, req.ExperimentName, req.TrialName, req.TrialUid, req.Source, int64(100), req.ObservationLog).Return(true, nil)
+	mockDB.EXPECT().RegisterObservationLogWithOffset(req.Namespace, req.ExperimentName, req.TrialName, req.TrialUid, req.Source, int64(100), req.ObservationLog).Return(false, nil)
+	for i := 0; i < 2; i++ {
+		if _, err = s.ReportObservationLog(context.Background(), req); err != nil {
+			t.Fatalf("ReportObservationLog Error %v", err)
+		}
+	}
+}
+
+type fakeStreamObservationLogServer struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*api_pb.ReportObservationLogRequest
+	err      error
+	closed   bool
+}
+
+func (f *fakeStreamObservationLogServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeStreamObservationLogServer) Recv() (*api_pb.ReportObservationLogRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, f.err
+	}
+	in := f.requests[0]
+	f.requests = f.requests[1:]
+	return in, nil
+}
+
+func (f *fakeStreamObservationLogServer) SendAndClose(*api_pb.ReportObservationLogReply) error {
+	f.closed = true
+	return nil
+}
+
+func TestStreamObservationLog(t *testing.T) {
+	newRequest := func(trialName string, values ...string) *api_pb.ReportObservationLogRequest {
+		req := &api_pb.ReportObservationLogRequest{
+			TrialName:      trialName,
+			Namespace:      "test-namespace",
+			ExperimentName: "test1",
+			ObservationLog: &api_pb.ObservationLog{},
+		}
+		for _, v := range values {
+			req.ObservationLog.MetricLogs = append(req.ObservationLog.MetricLogs, &api_pb.MetricLog{
+				TimeStamp: "2019-02-03T04:05:06+09:00",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: v,
+				},
+			})
+		}
+		return req
+	}
+	brokenErr := errors.New("stream is broken")
+
+	testCases := []struct {
+		name           string
+		requests       []*api_pb.ReportObservationLogRequest
+		err            error
+		expectedLogs   map[string][]string
+		expectedErr    error
+		expectedClosed bool
+	}{
+		{
+			name: "Logs of the Trial are inserted at once",
+			requests: []*api_pb.ReportObservationLogRequest{
+				newRequest("test1-trial1", "0.5"),
+				newRequest("test1-trial1"),
+				newRequest("test1-trial1", "0.4", "0.3"),
+			},
+			err: io.EOF,
+			expectedLogs: map[string][]string{
+				"test1-trial1": {"0.5", "0.4", "0.3"},
+			},
+			expectedClosed: true,
+		},
+		{
+			name: "Received logs are inserted when stream is broken",
+			requests: []*api_pb.ReportObservationLogRequest{
+				newRequest("test1-trial1", "0.5"),
+				newRequest("test1-trial2", "0.4"),
+			},
+			err: brokenErr,
+			expectedLogs: map[string][]string{
+				"test1-trial1": {"0.5"},
+				"test1-trial2": {"0.4"},
+			},
+			expectedErr: brokenErr,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			s := &server{}
+			mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+			dbIf = mockDB
+
+			for trialName, values := range tc.expectedLogs {
+				expected := newRequest(trialName, values...)
+				mockDB.EXPECT().RegisterObservationLog(expected.Namespace, expected.ExperimentName, trialName, "", gomock.Any()).DoAndReturn(
+					func(namespace, experimentName, trialName, trialUID string, observationLog *api_pb.ObservationLog) error {
+						if !reflect.DeepEqual(observationLog, expected.ObservationLog) {
+							t.Errorf("Unexpected logs for Trial %v: %v", trialName, observationLog)
+						}
+						return nil
+					})
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stream := &fakeStreamObservationLogServer{
+				ctx:      ctx,
+				requests: tc.requests,
+				err:      tc.err,
+			}
+			if err := s.StreamObservationLog(stream); err != tc.expectedErr {
+				t.Errorf("StreamObservationLog Error %v, expected %v", err, tc.expectedErr)
+			}
+			if stream.closed != tc.expectedClosed {
+				t.Errorf("Stream closed %v, expected %v", stream.closed, tc.expectedClosed)
+			}
+		})
+	}
 }
 
 func TestGetObservationLog(t *testing.T) {
@@ -84,9 +219,12 @@ func TestGetObservationLog(t *testing.T) {
 	dbIf = mockDB
 
 	req := &api_pb.GetObservationLogRequest{
-		TrialName: "test1-trial1",
-		StartTime: "2019-02-03T03:05:06+09:00",
-		EndTime:   "2019-02-03T05:05:06+09:00",
+		TrialName:   "test1-trial1",
+		Namespace:   "test-namespace",
+		StartTime:   "2019-02-03T03:05:06+09:00",
+		EndTime:     "2019-02-03T05:05:06+09:00",
+		StartStep:   "10",
+		OrderByStep: true,
 	}
 
 	obs := &api_pb.ObservationLog{
@@ -122,7 +260,8 @@ func TestGetObservationLog(t *testing.T) {
 		},
 	}
 
-	mockDB.EXPECT().GetObservationLog(req.TrialName, req.MetricName, req.StartTime, req.EndTime).Return(obs, nil)
+	mockDB.EXPECT().GetObservationLog(req.Namespace, req.TrialName, req.MetricName, req.StartTime, req.EndTime,
+		req.StartStep, req.EndStep, req.OrderByStep).Return(obs, nil)
 	ret, err := s.GetObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("GetObservationLog Error %v", err)
@@ -132,6 +271,115 @@ func TestGetObservationLog(t *testing.T) {
 	}
 }
 
+func TestGetObservationLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.GetObservationLogsRequest{
+		TrialNames:  []string{"test1-trial2", "test1-trial1"},
+		Namespace:   "test-namespace",
+		MetricNames: []string{"f1_score", "loss"},
+		StartTime:   "2019-02-03T03:05:06+09:00",
+		EndTime:     "2019-02-03T05:05:06+09:00",
+	}
+
+	logs := map[string]*api_pb.ObservationLog{
+		"test1-trial1": {
+			MetricLogs: []*api_pb.MetricLog{
+				{
+					TimeStamp: "2019-02-03T04:05:06+09:00",
+					Metric: &api_pb.Metric{
+						Name:  "f1_score",
+						Value: "88.95",
+					},
+				},
+				{
+					TimeStamp: "2019-02-03T04:05:06+09:00",
+					Metric: &api_pb.Metric{
+						Name:  "loss",
+						Value: "0.5",
+					},
+				},
+			},
+		},
+		"test1-trial2": {
+			MetricLogs: []*api_pb.MetricLog{},
+		},
+	}
+
+	mockDB.EXPECT().GetObservationLogs(req.Namespace, req.TrialNames, req.MetricNames, req.StartTime, req.EndTime).Return(logs, nil)
+	ret, err := s.GetObservationLogs(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetObservationLogs Error %v", err)
+	}
+	if len(ret.TrialObservationLogs) != len(req.TrialNames) {
+		t.Fatalf("GetObservationLogs Test fail expect trials number %d got %d", len(req.TrialNames), len(ret.TrialObservationLogs))
+	}
+	for i, trialName := range req.TrialNames {
+		got := ret.TrialObservationLogs[i]
+		if got.TrialName != trialName {
+			t.Fatalf("GetObservationLogs Test fail expect trial %s at %d got %s", trialName, i, got.TrialName)
+		}
+		if len(got.ObservationLog.MetricLogs) != len(logs[trialName].MetricLogs) {
+			t.Fatalf("GetObservationLogs Test fail expect metrics number %d got %d for trial %s",
+				len(logs[trialName].MetricLogs), len(got.ObservationLog.MetricLogs), trialName)
+		}
+	}
+}
+
+func TestGetObservationSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.GetObservationSummaryRequest{
+		TrialName:   "test1-trial1",
+		MetricNames: []string{"f1_score", "loss"},
+		Namespace:   "test-namespace",
+	}
+
+	summaries := []*api_pb.MetricSummary{
+		{
+			MetricName:     "f1_score",
+			Min:            "80.1",
+			Max:            "88.950",
+			Latest:         "88.950",
+			Count:          10,
+			FirstTimeStamp: "2019-02-03T04:05:06Z",
+			LastTimeStamp:  "2019-02-03T04:15:06Z",
+		},
+		{
+			MetricName:     "loss",
+			Min:            "5e-1",
+			Max:            "1.2",
+			Latest:         "0.5",
+			Count:          10,
+			FirstTimeStamp: "2019-02-03T04:05:06Z",
+			LastTimeStamp:  "2019-02-03T04:15:06Z",
+		},
+	}
+
+	mockDB.EXPECT().GetObservationSummary(req.Namespace, req.TrialName, req.MetricNames).Return(summaries, nil)
+	ret, err := s.GetObservationSummary(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetObservationSummary Error %v", err)
+	}
+	if len(summaries) != len(ret.MetricSummaries) {
+		t.Fatalf("GetObservationSummary Test fail expect metrics number %d got %d", len(summaries), len(ret.MetricSummaries))
+	}
+	for i, summary := range ret.MetricSummaries {
+		if summary.Min != summaries[i].Min || summary.Max != summaries[i].Max || summary.Latest != summaries[i].Latest {
+			t.Errorf("GetObservationSummary Test fail expect min %s, max %s, latest %s got %v",
+				summaries[i].Min, summaries[i].Max, summaries[i].Latest, summary)
+		}
+	}
+}
+
 func TestDeleteObservationLog(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -141,8 +389,9 @@ func TestDeleteObservationLog(t *testing.T) {
 
 	req := &api_pb.DeleteObservationLogRequest{
 		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
 	}
-	mockDB.EXPECT().DeleteObservationLog(req.TrialName).Return(nil)
+	mockDB.EXPECT().DeleteObservationLog(req.Namespace, req.TrialName).Return(nil)
 	_, err := s.DeleteObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("DeleteExperiment Error %v", err)
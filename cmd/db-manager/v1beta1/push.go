@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+// maxPushBodySize is the max size of the request body with pushed metrics.
+const maxPushBodySize = 10 << 20
+
+// pushHandler reports metrics which are pushed by the Trial over HTTP, it's the JSON mapping of ReportObservationLog.
+// Request body is ReportObservationLogRequest in the JSON format, e.g.
+//
+//	{"trialName": "random-abc", "observationLog": {"metricLogs": [{"metric": {"name": "loss", "value": "0.5"}, "step": "1"}]}}
+//
+// Request must have the "Authorization: Bearer <Trial token>" header with the token which is injected to the Trial.
+// Namespace, Experiment name and Trial UID are taken from the token, if time stamp is empty, the receive time is used.
+// Metrics are rejected if the token is expired or the Trial is completed.
+type pushHandler struct {
+	dbIf       common.KatibDBInterface
+	kubeClient client.Client
+	tokenKey   []byte
+	now        func() time.Time
+}
+
+func newPushHandler(dbIf common.KatibDBInterface, kubeClient client.Client, tokenKey []byte) *pushHandler {
+	return &pushHandler{
+		dbIf:       dbIf,
+		kubeClient: kubeClient,
+		tokenKey:   tokenKey,
+		now:        time.Now,
+	}
+}
+
+func (h *pushHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writePushError(w, http.StatusMethodNotAllowed, fmt.Errorf("Method %s is not allowed", r.Method))
+		return
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		writePushError(w, http.StatusUnauthorized, fmt.Errorf("Bearer token is missing"))
+		return
+	}
+	claims, err := katibmanagerv1beta1.VerifyTrialToken(h.tokenKey, strings.TrimPrefix(authorization, "Bearer "), h.now())
+	if err != nil {
+		writePushError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	in := &api_pb.ReportObservationLogRequest{}
+	if err = jsonpb.Unmarshal(http.MaxBytesReader(w, r.Body, maxPushBodySize), in); err != nil {
+		writePushError(w, http.StatusBadRequest, fmt.Errorf("Failed to parse request: %v", err))
+		return
+	}
+	if err = h.checkRequest(in, claims); err != nil {
+		writePushError(w, http.StatusForbidden, err)
+		return
+	}
+	if code, err := h.checkTrial(r.Context(), claims); err != nil {
+		writePushError(w, code, err)
+		return
+	}
+	if in.ObservationLog == nil || len(in.ObservationLog.MetricLogs) == 0 {
+		writePushError(w, http.StatusBadRequest, fmt.Errorf("Observation log is empty"))
+		return
+	}
+	timeStamp := h.now().UTC().Format(time.RFC3339Nano)
+	for _, mlog := range in.ObservationLog.MetricLogs {
+		if mlog.Metric == nil || mlog.Metric.Name == "" {
+			writePushError(w, http.StatusBadRequest, fmt.Errorf("Metric name is empty"))
+			return
+		}
+		if mlog.TimeStamp == "" {
+			mlog.TimeStamp = timeStamp
+		}
+	}
+
+	if err = h.dbIf.RegisterObservationLog(claims.Namespace, claims.ExperimentName, claims.TrialName, claims.TrialUID, in.ObservationLog); err != nil {
+		klog.Errorf("Failed to register pushed observation log of Trial %s/%s: %v", claims.Namespace, claims.TrialName, err)
+		writePushError(w, http.StatusInternalServerError, fmt.Errorf("Failed to register observation log"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, "{}")
+}
+
+// checkRequest returns error if the request is reported for another Trial than the token Trial.
+func (h *pushHandler) checkRequest(in *api_pb.ReportObservationLogRequest, claims *katibmanagerv1beta1.TrialTokenClaims) error {
+	if in.TrialName != claims.TrialName {
+		return fmt.Errorf("Token is not valid for Trial %s", in.TrialName)
+	}
+	if (in.Namespace != "" && in.Namespace != claims.Namespace) ||
+		(in.ExperimentName != "" && in.ExperimentName != claims.ExperimentName) ||
+		(in.TrialUid != "" && in.TrialUid != claims.TrialUID) {
+		return fmt.Errorf("Token is not valid for Trial %s/%s", in.Namespace, in.TrialName)
+	}
+	return nil
+}
+
+// checkTrial returns error with the HTTP status code if the token Trial doesn't exist or is completed.
+func (h *pushHandler) checkTrial(ctx context.Context, claims *katibmanagerv1beta1.TrialTokenClaims) (int, error) {
+	trial := &trialsv1beta1.Trial{}
+	err := h.kubeClient.Get(ctx, apitypes.NamespacedName{Namespace: claims.Namespace, Name: claims.TrialName}, trial)
+	if errors.IsNotFound(err) {
+		return http.StatusForbidden, fmt.Errorf("Trial %s/%s is not found", claims.Namespace, claims.TrialName)
+	} else if err != nil {
+		klog.Errorf("Failed to get Trial %s/%s: %v", claims.Namespace, claims.TrialName, err)
+		return http.StatusInternalServerError, fmt.Errorf("Failed to get Trial")
+	}
+	// Token of the deleted Trial must not be valid for new Trial with the same name.
+	if string(trial.UID) != claims.TrialUID {
+		return http.StatusForbidden, fmt.Errorf("Token is not valid for Trial %s/%s", claims.Namespace, claims.TrialName)
+	}
+	if trial.IsCompleted() {
+		return http.StatusForbidden, fmt.Errorf("Trial %s/%s is completed", claims.Namespace, claims.TrialName)
+	}
+	return http.StatusOK, nil
+}
+
+// checkTrialStatus returns the gRPC status error if the Trial of the token used by the metrics collector
+// doesn't exist or is completed.
+func (h *pushHandler) checkTrialStatus(ctx context.Context, claims *katibmanagerv1beta1.TrialTokenClaims) error {
+	code, err := h.checkTrial(ctx, claims)
+	if err == nil {
+		return nil
+	}
+	if code == http.StatusForbidden {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// pushError is the JSON body of the failed push request.
+type pushError struct {
+	Error string `json:"error"`
+}
+
+func writePushError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if encodeErr := json.NewEncoder(w).Encode(pushError{Error: err.Error()}); encodeErr != nil {
+		klog.Errorf("Failed to write push error: %v", encodeErr)
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
+	mockdb "github.com/kubeflow/katib/pkg/mock/v1beta1/db"
+)
+
+func TestPushHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+
+	kubeScheme := runtime.NewScheme()
+	if err := trialsv1beta1.AddToScheme(kubeScheme); err != nil {
+		t.Fatalf("Failed to add Trial types to scheme: %v", err)
+	}
+	completedTrial := &trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test1-trial2", UID: "test1-trial2-uid"}}
+	completedTrial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "TrialSucceeded", "Trial has succeeded")
+	kubeClient := fake.NewClientBuilder().WithScheme(kubeScheme).WithObjects(
+		&trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test1-trial1", UID: "test1-trial1-uid"}},
+		completedTrial,
+	).Build()
+
+	now := time.Date(2022, 2, 3, 4, 5, 6, 0, time.UTC)
+	key := []byte("test-key")
+	newToken := func(key []byte, trialName, trialUID string, expiresAt time.Time) string {
+		token, err := katibmanagerv1beta1.NewTrialToken(key, katibmanagerv1beta1.TrialTokenClaims{
+			Namespace:      "test-namespace",
+			ExperimentName: "test1",
+			TrialName:      trialName,
+			TrialUID:       trialUID,
+			ExpiresAt:      expiresAt.Unix(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	token := newToken(key, "test1-trial1", "test1-trial1-uid", now.Add(time.Hour))
+	otherToken := newToken([]byte("other-key"), "test1-trial1", "test1-trial1-uid", now.Add(time.Hour))
+	expiredToken := newToken(key, "test1-trial1", "test1-trial1-uid", now)
+	deletedTrialToken := newToken(key, "test1-trial1", "deleted-uid", now.Add(time.Hour))
+	completedTrialToken := newToken(key, "test1-trial2", "test1-trial2-uid", now.Add(time.Hour))
+	notFoundTrialToken := newToken(key, "test1-trial3", "test1-trial3-uid", now.Add(time.Hour))
+
+	handler := newPushHandler(mockDB, kubeClient, key)
+	handler.now = func() time.Time {
+		return now
+	}
+
+	expectedLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2022-02-03T04:05:06Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+				Step: "1",
+			},
+			{
+				TimeStamp: "2019-02-03T04:05:06+09:00",
+				Metric: &api_pb.Metric{
+					Name:  "accuracy",
+					Value: "0.9",
+				},
+			},
+		},
+	}
+	validBody := `{"trialName": "test1-trial1", "observationLog": {"metricLogs": [
+		{"metric": {"name": "loss", "value": "0.5"}, "step": "1"},
+		{"timeStamp": "2019-02-03T04:05:06+09:00", "metric": {"name": "accuracy", "value": "0.9"}}]}}`
+
+	testCases := []struct {
+		description  string
+		method       string
+		token        string
+		body         string
+		mockCall     *gomock.Call
+		expectedCode int
+	}{
+		{
+			description: "Metrics are registered",
+			method:      http.MethodPost,
+			token:       token,
+			body:        validBody,
+			mockCall: mockDB.EXPECT().RegisterObservationLog("test-namespace", "test1", "test1-trial1", "test1-trial1-uid",
+				gomock.Eq(expectedLog)).Return(nil),
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Invalid method",
+			method:       http.MethodGet,
+			token:        token,
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		{
+			description:  "Token is missing",
+			method:       http.MethodPost,
+			body:         validBody,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			description:  "Token is signed by another key",
+			method:       http.MethodPost,
+			token:        otherToken,
+			body:         validBody,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			description:  "Token is expired",
+			method:       http.MethodPost,
+			token:        expiredToken,
+			body:         validBody,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			description:  "Token of the deleted Trial with the same name",
+			method:       http.MethodPost,
+			token:        deletedTrialToken,
+			body:         validBody,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			description:  "Trial is completed",
+			method:       http.MethodPost,
+			token:        completedTrialToken,
+			body:         `{"trialName": "test1-trial2", "observationLog": {"metricLogs": [{"metric": {"name": "loss", "value": "0.5"}}]}}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			description:  "Trial is not found",
+			method:       http.MethodPost,
+			token:        notFoundTrialToken,
+			body:         `{"trialName": "test1-trial3", "observationLog": {"metricLogs": [{"metric": {"name": "loss", "value": "0.5"}}]}}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			description:  "Token of another Trial",
+			method:       http.MethodPost,
+			token:        token,
+			body:         `{"trialName": "test1-trial2", "observationLog": {"metricLogs": [{"metric": {"name": "loss", "value": "0.5"}}]}}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			description:  "Token of another namespace",
+			method:       http.MethodPost,
+			token:        token,
+			body:         `{"trialName": "test1-trial1", "namespace": "other", "observationLog": {"metricLogs": [{"metric": {"name": "loss", "value": "0.5"}}]}}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			description:  "Invalid JSON",
+			method:       http.MethodPost,
+			token:        token,
+			body:         `{"trialName": "test1-trial1", "metrics": []}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "Empty observation log",
+			method:       http.MethodPost,
+			token:        token,
+			body:         `{"trialName": "test1-trial1"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "Empty metric name",
+			method:       http.MethodPost,
+			token:        token,
+			body:         `{"trialName": "test1-trial1", "observationLog": {"metricLogs": [{"metric": {"value": "0.5"}}]}}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description: "Failed to register metrics",
+			method:      http.MethodPost,
+			token:       token,
+			body:        validBody,
+			mockCall: mockDB.EXPECT().RegisterObservationLog("test-namespace", "test1", "test1-trial1", "test1-trial1-uid",
+				gomock.Any()).Return(errors.New("DB error")),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "/api/v1beta1/observation_log", strings.NewReader(tc.body))
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tc.expectedCode {
+				t.Errorf("Expected code %v, got %v, body: %s", tc.expectedCode, rec.Code, rec.Body.String())
+			}
+			if rec.Code != http.StatusOK {
+				body := pushError{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.Error == "" {
+					t.Errorf("Expected JSON error body, got %s, error: %v", rec.Body.String(), err)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckTrialStatus(t *testing.T) {
+	kubeScheme := runtime.NewScheme()
+	if err := trialsv1beta1.AddToScheme(kubeScheme); err != nil {
+		t.Fatalf("Failed to add Trial types to scheme: %v", err)
+	}
+	completedTrial := &trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test1-trial2", UID: "test1-trial2-uid"}}
+	completedTrial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "TrialSucceeded", "Trial has succeeded")
+	kubeClient := fake.NewClientBuilder().WithScheme(kubeScheme).WithObjects(
+		&trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test1-trial1", UID: "test1-trial1-uid"}},
+		completedTrial,
+	).Build()
+	handler := newPushHandler(nil, kubeClient, []byte("test-key"))
+
+	testCases := []struct {
+		name         string
+		trialName    string
+		trialUID     string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Running Trial",
+			trialName:    "test1-trial1",
+			trialUID:     "test1-trial1-uid",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Deleted Trial with the same name",
+			trialName:    "test1-trial1",
+			trialUID:     "deleted-uid",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "Completed Trial",
+			trialName:    "test1-trial2",
+			trialUID:     "test1-trial2-uid",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "Not found Trial",
+			trialName:    "test1-trial3",
+			trialUID:     "test1-trial3-uid",
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := handler.checkTrialStatus(context.Background(), &katibmanagerv1beta1.TrialTokenClaims{
+				Namespace: "test-namespace",
+				TrialName: tc.trialName,
+				TrialUID:  tc.trialUID,
+			})
+			if code := status.Code(err); code != tc.expectedCode {
+				t.Errorf("Expected code %v, got %v", tc.expectedCode, err)
+			}
+		})
+	}
+}
+
+func TestWritePushError(t *testing.T) {
+	// Message with quotes and control characters is encoded as valid JSON.
+	message := "Metric \"loss\" is invalid:\x00\t\u00e9"
+	rec := httptest.NewRecorder()
+	writePushError(rec, http.StatusBadRequest, errors.New(message))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected code %v, got %v", http.StatusBadRequest, rec.Code)
+	}
+	body := pushError{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Failed to decode error body %s: %v", rec.Body.String(), err)
+	}
+	if body.Error != message {
+		t.Errorf("Expected error %q, got %q", message, body.Error)
+	}
+}
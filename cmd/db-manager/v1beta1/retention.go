@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
+)
+
+const (
+	reapReasonTTL      = "ttl"
+	reapReasonOrphaned = "orphaned"
+)
+
+var observationLogsReaped = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "katib_db_manager_observation_logs_reaped_total",
+	Help: "The total number of observation logs deleted by retention",
+}, []string{"reason"})
+
+// observationLogRetention deletes observation logs which are older than TTL or which Trials don't exist.
+// Orphaned logs are left when Trial is deleted without clean-metrics-in-db finalizer.
+// TTL is applied only to logs of completed or deleted Trials.
+// Retention settings are read from Katib config before each garbage collection.
+// There is no leader election, so Katib DB Manager must run with a single replica when retention is enabled.
+// Otherwise, every replica lists Trials and deletes the same logs at the same time.
+type observationLogRetention struct {
+	kubeClient client.Client
+	dbIf       common.KatibDBInterface
+	now        func() time.Time
+}
+
+func newObservationLogRetention(kubeClient client.Client, dbIf common.KatibDBInterface) *observationLogRetention {
+	return &observationLogRetention{
+		kubeClient: kubeClient,
+		dbIf:       dbIf,
+		now:        time.Now,
+	}
+}
+
+// Run collects garbage until stop is closed.
+func (r *observationLogRetention) Run(stop <-chan struct{}) {
+	for {
+		interval := consts.DefaultObservationLogRetentionInterval
+		config, err := katibconfig.GetDBManagerConfigData(r.kubeClient)
+		if err != nil {
+			klog.Errorf("Failed to get DB manager config: %v", err)
+		} else {
+			interval = config.ObservationLogRetention.Interval.Duration
+			if err = r.collectGarbage(config.ObservationLogRetention); err != nil {
+				klog.Errorf("Failed to collect observation log garbage: %v", err)
+			}
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (r *observationLogRetention) collectGarbage(config katibconfig.ObservationLogRetentionConfig) error {
+	if config.TTL.Duration <= 0 && !config.DeleteOrphaned {
+		return nil
+	}
+	// Owners are listed before Trials, so logs of Trials created in between are not orphaned.
+	owners, err := r.dbIf.GetObservationLogOwners()
+	if err != nil {
+		return err
+	}
+	trialList := &trialsv1beta1.TrialList{}
+	if err = r.kubeClient.List(context.TODO(), trialList); err != nil {
+		return fmt.Errorf("Failed to list Trials: %v", err)
+	}
+	trials := make(map[apitypes.NamespacedName]*trialsv1beta1.Trial, len(trialList.Items))
+	for i := range trialList.Items {
+		trial := &trialList.Items[i]
+		trials[apitypes.NamespacedName{Namespace: trial.Namespace, Name: trial.Name}] = trial
+	}
+
+	for _, owner := range owners {
+		trial := getOwnerTrial(trials, owner)
+		// Logs without namespace can't be matched to the Trial, so they are never orphaned.
+		if config.DeleteOrphaned && owner.Namespace != "" && trial == nil {
+			deleted, err := r.dbIf.DeleteOwnerObservationLog(owner)
+			if err != nil {
+				return fmt.Errorf("Failed to delete observation logs of Trial %s/%s: %v", owner.Namespace, owner.TrialName, err)
+			}
+			observationLogsReaped.WithLabelValues(reapReasonOrphaned).Add(float64(deleted))
+			klog.Infof("Deleted %d orphaned observation logs of Trial %s/%s", deleted, owner.Namespace, owner.TrialName)
+			continue
+		}
+		// Logs of running Trials are kept, since the Trial status is computed from all its logs.
+		if config.TTL.Duration <= 0 || isOwnerTrialRunning(trialList.Items, trial, owner) {
+			continue
+		}
+		deleted, err := r.dbIf.DeleteOwnerObservationLogBefore(owner, r.now().Add(-config.TTL.Duration))
+		if err != nil {
+			return fmt.Errorf("Failed to delete expired observation logs of Trial %s/%s: %v", owner.Namespace, owner.TrialName, err)
+		}
+		observationLogsReaped.WithLabelValues(reapReasonTTL).Add(float64(deleted))
+		if deleted > 0 {
+			klog.Infof("Deleted %d observation logs of Trial %s/%s older than %v", deleted, owner.Namespace, owner.TrialName, config.TTL.Duration)
+		}
+	}
+	return nil
+}
+
+// getOwnerTrial returns the Trial which reported the logs or nil if the Trial doesn't exist.
+// Logs of the deleted Trial are not owned by the new Trial with the same name.
+func getOwnerTrial(trials map[apitypes.NamespacedName]*trialsv1beta1.Trial, owner common.ObservationLogOwner) *trialsv1beta1.Trial {
+	trial, ok := trials[apitypes.NamespacedName{Namespace: owner.Namespace, Name: owner.TrialName}]
+	if !ok || (owner.TrialUID != "" && owner.TrialUID != string(trial.UID)) {
+		return nil
+	}
+	return trial
+}
+
+// isOwnerTrialRunning checks if the Trial which reported the logs is not completed yet.
+// Logs without namespace are owned by any Trial with the name, since their namespace is unknown.
+func isOwnerTrialRunning(trials []trialsv1beta1.Trial, trial *trialsv1beta1.Trial, owner common.ObservationLogOwner) bool {
+	if owner.Namespace != "" {
+		return trial != nil && !trial.IsCompleted()
+	}
+	for i := range trials {
+		if trials[i].Name == owner.TrialName && !trials[i].IsCompleted() {
+			return true
+		}
+	}
+	return false
+}
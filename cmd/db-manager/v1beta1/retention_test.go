@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	mockdb "github.com/kubeflow/katib/pkg/mock/v1beta1/db"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
+)
+
+func TestObservationLogRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+
+	kubeScheme := runtime.NewScheme()
+	if err := trialsv1beta1.AddToScheme(kubeScheme); err != nil {
+		t.Fatalf("Failed to add Trial types to scheme: %v", err)
+	}
+	kubeClient := fake.NewClientBuilder().WithScheme(kubeScheme).WithObjects(
+		&trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test1-trial1", UID: "uid-1"}},
+		&trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test1-trial2", UID: "uid-3"}},
+		&trialsv1beta1.Trial{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test1-trial3", UID: "uid-5"},
+			Status: trialsv1beta1.TrialStatus{
+				Conditions: []trialsv1beta1.TrialCondition{
+					{Type: trialsv1beta1.TrialSucceeded, Status: corev1.ConditionTrue},
+				},
+			},
+		},
+	).Build()
+
+	now := time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)
+	before := now.Add(-24 * time.Hour)
+	r := newObservationLogRetention(kubeClient, mockDB)
+	r.now = func() time.Time { return now }
+
+	owners := []common.ObservationLogOwner{
+		// Trial exists and is running.
+		{Namespace: "test-namespace", TrialName: "test1-trial1", TrialUID: "uid-1"},
+		// Trial was recreated with the same name.
+		{Namespace: "test-namespace", TrialName: "test1-trial2", TrialUID: "uid-2"},
+		// Trial with the same name exists in another namespace only.
+		{Namespace: "another-namespace", TrialName: "test1-trial1", TrialUID: "uid-4"},
+		// Trial is completed.
+		{Namespace: "test-namespace", TrialName: "test1-trial3", TrialUID: "uid-5"},
+		// Logs without namespace of the running Trial.
+		{TrialName: "test1-trial1"},
+		// Logs without namespace of the deleted Trial.
+		{TrialName: "test1-trial4"},
+	}
+	mockDB.EXPECT().GetObservationLogOwners().Return(owners, nil)
+	mockDB.EXPECT().DeleteOwnerObservationLog(owners[1]).Return(int64(2), nil)
+	mockDB.EXPECT().DeleteOwnerObservationLog(owners[2]).Return(int64(3), nil)
+	mockDB.EXPECT().DeleteOwnerObservationLogBefore(owners[3], before).Return(int64(5), nil)
+	mockDB.EXPECT().DeleteOwnerObservationLogBefore(owners[5], before).Return(int64(1), nil)
+
+	ttlReaped := testutil.ToFloat64(observationLogsReaped.WithLabelValues(reapReasonTTL))
+	orphanedReaped := testutil.ToFloat64(observationLogsReaped.WithLabelValues(reapReasonOrphaned))
+	err := r.collectGarbage(katibconfig.ObservationLogRetentionConfig{
+		TTL:            metav1.Duration{Duration: 24 * time.Hour},
+		DeleteOrphaned: true,
+	})
+	if err != nil {
+		t.Fatalf("collectGarbage failed: %v", err)
+	}
+	if reaped := testutil.ToFloat64(observationLogsReaped.WithLabelValues(reapReasonTTL)) - ttlReaped; reaped != 6 {
+		t.Errorf("Expected 6 logs reaped by TTL, got %v", reaped)
+	}
+	if reaped := testutil.ToFloat64(observationLogsReaped.WithLabelValues(reapReasonOrphaned)) - orphanedReaped; reaped != 5 {
+		t.Errorf("Expected 5 orphaned logs reaped, got %v", reaped)
+	}
+
+	// Only TTL is applied to logs of deleted Trials if orphaned logs are kept.
+	mockDB.EXPECT().GetObservationLogOwners().Return(owners, nil)
+	mockDB.EXPECT().DeleteOwnerObservationLogBefore(owners[1], before).Return(int64(0), nil)
+	mockDB.EXPECT().DeleteOwnerObservationLogBefore(owners[2], before).Return(int64(0), nil)
+	mockDB.EXPECT().DeleteOwnerObservationLogBefore(owners[3], before).Return(int64(0), nil)
+	mockDB.EXPECT().DeleteOwnerObservationLogBefore(owners[5], before).Return(int64(0), nil)
+	err = r.collectGarbage(katibconfig.ObservationLogRetentionConfig{
+		TTL: metav1.Duration{Duration: 24 * time.Hour},
+	})
+	if err != nil {
+		t.Fatalf("collectGarbage failed: %v", err)
+	}
+
+	// Nothing is deleted if retention is not configured.
+	if err = r.collectGarbage(katibconfig.ObservationLogRetentionConfig{}); err != nil {
+		t.Errorf("collectGarbage failed: %v", err)
+	}
+}
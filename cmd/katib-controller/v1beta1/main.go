@@ -22,6 +22,7 @@ package main
 import (
 	"flag"
 	"os"
+	"time"
 
 	"github.com/spf13/viper"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -48,6 +49,8 @@ func main() {
 	var injectSecurityContext bool
 	var enableGRPCProbeInSuggestion bool
 	var trialResources trialutil.GvkListFlag
+	var trialTokenKeyFile string
+	var trialTokenLifetime time.Duration
 	var enableLeaderElection bool
 	var leaderElectionID string
 
@@ -57,6 +60,8 @@ func main() {
 	flag.BoolVar(&injectSecurityContext, "webhook-inject-securitycontext", false, "Inject the securityContext of container[0] in the sidecar")
 	flag.BoolVar(&enableGRPCProbeInSuggestion, "enable-grpc-probe-in-suggestion", true, "enable grpc probe in suggestions")
 	flag.Var(&trialResources, "trial-resources", "The list of resources that can be used as trial template, in the form: Kind.version.group (e.g. TFJob.v1.kubeflow.org)")
+	flag.StringVar(&trialTokenKeyFile, "trial-token-key-file", "", "File with the key to sign Trial tokens. Tokens to push metrics are injected to Trials with None metrics collector if it is set")
+	flag.DurationVar(&trialTokenLifetime, "trial-token-lifetime", 7*24*time.Hour, "The time after which Trial tokens expire. Trials can't push metrics after the token is expired")
 	flag.IntVar(&webhookPort, "webhook-port", 8443, "The port number to be used for admission webhook server.")
 	// For leader election
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false, "Enable leader election for katib-controller. Enabling this will ensure there is only one active katib-controller.")
@@ -74,6 +79,8 @@ func main() {
 	viper.Set(consts.ConfigInjectSecurityContext, injectSecurityContext)
 	viper.Set(consts.ConfigEnableGRPCProbeInSuggestion, enableGRPCProbeInSuggestion)
 	viper.Set(consts.ConfigTrialResources, trialResources)
+	viper.Set(consts.ConfigTrialTokenKeyFile, trialTokenKeyFile)
+	viper.Set(consts.ConfigTrialTokenLifetime, trialTokenLifetime)
 
 	log.Info("Config:",
 		consts.ConfigExperimentSuggestionName,
@@ -88,6 +95,10 @@ func main() {
 		viper.GetBool(consts.ConfigEnableGRPCProbeInSuggestion),
 		"trial-resources",
 		viper.Get(consts.ConfigTrialResources),
+		consts.ConfigTrialTokenKeyFile,
+		viper.GetString(consts.ConfigTrialTokenKeyFile),
+		consts.ConfigTrialTokenLifetime,
+		viper.GetDuration(consts.ConfigTrialTokenLifetime),
 	)
 
 	// Get a config to talk to the apiserver
@@ -33,87 +33,65 @@ For example, the objective value name is F1 and the metrics are loss, your train
      F1=0.7
      ---
 The metrics collector will collect all logs of metrics.
+New metrics are reported every flush interval while training is running, so they are kept if the collector is restarted.
+Metrics file can be also written in JSON, CSV with the header row or LOGFMT (space separated key=value pairs) format.
+In JSON format, metric names can be GJSON paths of the nested values, e.g. "metrics.val.acc".
 */
 
 package main
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
-	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/hpcloud/tail"
-	psutil "github.com/shirou/gopsutil/v3/process"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"k8s.io/klog"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
 )
 
-type stopRulesFlag []commonv1beta1.EarlyStoppingRule
-
-func (flag *stopRulesFlag) String() string {
-	stopRuleStrings := []string{}
-	for _, r := range *flag {
-		stopRuleStrings = append(stopRuleStrings, r.Name)
-		stopRuleStrings = append(stopRuleStrings, r.Value)
-		stopRuleStrings = append(stopRuleStrings, string(r.Comparison))
-		stopRuleStrings = append(stopRuleStrings, strconv.Itoa(r.StartStep))
-	}
-	return strings.Join(stopRuleStrings, ";")
-}
-
-func (flag *stopRulesFlag) Set(value string) error {
-	stopRuleParsed := strings.Split(value, ";")
-	if len(stopRuleParsed) != 4 {
-		return fmt.Errorf("Invalid Early Stopping rule: %v", value)
-	}
-
-	// Get int start step.
-	startStep, err := strconv.Atoi(stopRuleParsed[3])
-	if err != nil {
-		klog.Fatalf("Parse start step: %v to int error: %v", stopRuleParsed[3], err)
-	}
-
-	// For each stop rule this order: 1 - metric name, 2 - metric value, 3 - comparison type, 4 - start step.
-	// Start step is equal to 0, if it's not defined.
-	stopRule := commonv1beta1.EarlyStoppingRule{
-		Name:       stopRuleParsed[0],
-		Value:      stopRuleParsed[1],
-		Comparison: commonv1beta1.ComparisonType(stopRuleParsed[2]),
-		StartStep:  startStep,
-	}
-
-	*flag = append(*flag, stopRule)
-	return nil
-}
+// checkpointFileSuffix is the suffix of the file with offsets of the flushed metrics next to the metrics file.
+const checkpointFileSuffix = ".checkpoint"
 
 var (
 	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
 	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
 	trialName            = flag.String("t", "", "Trial Name")
+	trialNamespace       = flag.String("n", "", "Trial Namespace")
+	experimentName       = flag.String("e", "", "Experiment Name")
+	trialUID             = flag.String("uid", "", "Trial UID")
 	metricsFilePath      = flag.String("path", "", "Metrics File Path")
 	metricsFileFormat    = flag.String("format", "", "Metrics File Format")
+	timestampKey         = flag.String("timestamp-key", common.TimeStampJsonKey, "Key of the metrics timestamp in JSON, CSV and LOGFMT formats")
 	metricNames          = flag.String("m", "", "Metric names")
 	objectiveType        = flag.String("o-type", "", "Objective type")
 	metricFilters        = flag.String("f", "", "Metric filters")
 	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
+	flushInterval        = flag.Duration("flush-interval", common.DefaultFlushInterval, "Interval between reports of new metrics while training is running")
 	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
 	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
-	stopRules            stopRulesFlag
+	stopMode             = flag.String("stop-mode", common.DefaultTerminationMode, "Training processes which are signaled when training is early stopped: ProcessTree or ProcessGroup")
+	stopSignal           = flag.String("stop-signal", common.DefaultTerminationSignal, "Signal which is sent to training processes when training is early stopped")
+	stopGracePeriod      = flag.Duration("stop-grace-period", common.DefaultTerminationGracePeriod, "Time to wait until training processes are stopped by the signal before they are killed")
+	stopRules            common.StopRulesFlag
 	isEarlyStopped       = false
+
+	// Metrics are flushed to DB Manager while training is running.
+	// Flushing is stopped by closing flushStop, the result is sent to flushDone.
+	flushStop = make(chan struct{})
+	flushDone = make(chan error, 1)
+	// Metrics are reported once, either after early stopping or after main process is completed.
+	reportOnce sync.Once
 )
 
 func checkMetricFile(mFile string) {
@@ -141,21 +119,7 @@ func printMetricsFile(mFile string) {
 	}
 }
 
-func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string, fileFormat commonv1beta1.FileFormat) {
-
-	// metricStartStep is the dict where key = metric name, value = start step.
-	// We should apply early stopping rule only if metric is reported at least "start_step" times.
-	metricStartStep := make(map[string]int)
-	for _, stopRule := range stopRules {
-		if stopRule.StartStep != 0 {
-			metricStartStep[stopRule.Name] = stopRule.StartStep
-		}
-	}
-
-	// For objective metric we calculate best optimal value from the recorded metrics.
-	// This is workaround for Median Stop algorithm.
-	// TODO (andreyvelich): Think about it, maybe define latest, max or min strategy type in stop-rule as well ?
-	var optimalObjValue *float64
+func watchMetricsFile(mFile string, stopRules *common.StopRules, terminationOpts common.TerminationOpts, filters []string, fileFormat commonv1beta1.FileFormat) {
 
 	// Check that metric file exists.
 	checkMetricFile(mFile)
@@ -165,10 +129,13 @@ func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string, f
 	if err != nil {
 		klog.Fatalf("GetMainProcesses failed: %v", err)
 	}
-	mainProc, err := psutil.NewProcess(int32(mainProcPid))
-	if err != nil {
-		klog.Fatalf("Failed to create new Process from pid %v, error: %v", mainProcPid, err)
+
+	// JSON, CSV and LOGFMT lines are parsed by the file collector parser, since CSV header must be parsed once.
+	var ruleMetrics []string
+	for _, rule := range stopRules.Rules() {
+		ruleMetrics = append(ruleMetrics, rule.Name)
 	}
+	lineParser := filemc.NewMetricLogParser(ruleMetrics, filters, fileFormat, *timestampKey)
 
 	// Start watch log lines.
 	t, _ := tail.TailFile(mFile, tail.Config{Follow: true})
@@ -185,7 +152,7 @@ func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string, f
 
 			// Check if log line contains metric from stop rules.
 			isRuleLine := false
-			for _, rule := range stopRules {
+			for _, rule := range stopRules.Rules() {
 				if strings.Contains(logText, rule.Name) {
 					isRuleLine = true
 					break
@@ -209,186 +176,64 @@ func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string, f
 					if err != nil {
 						klog.Fatalf("Unable to parse value %v to float for metric %v", metricValue, metricName)
 					}
-
-					// stopRules contains array of EarlyStoppingRules that has not been reached yet.
-					// After rule is reached we delete appropriate element from the array.
-					for idx, rule := range stopRules {
-						if metricName != rule.Name {
-							continue
-						}
-						stopRules, optimalObjValue = updateStopRules(stopRules, optimalObjValue, metricValue, metricStartStep, rule, idx)
+					if err = stopRules.Update(metricName, metricValue); err != nil {
+						klog.Fatal(err)
 					}
 				}
 			}
-		case commonv1beta1.JsonFormat:
-			var logJsonObj map[string]interface{}
-			if err = json.Unmarshal([]byte(logText), &logJsonObj); err != nil {
-				klog.Fatalf("Failed to unmarshal logs in %v format, log: %s, error: %v", commonv1beta1.JsonFormat, logText, err)
-			}
-			// Check if log line contains metric from stop rules.
-			isRuleLine := false
-			for _, rule := range stopRules {
-				if _, exist := logJsonObj[rule.Name]; exist {
-					isRuleLine = true
-					break
-				}
-			}
-			// If log line doesn't contain appropriate metric, continue track file.
-			if !isRuleLine {
-				continue
+		case commonv1beta1.JsonFormat, commonv1beta1.CsvFormat, commonv1beta1.LogfmtFormat:
+			mlogs, err := lineParser.Parse([]string{logText})
+			if err != nil {
+				klog.Fatalf("Failed to parse logs in %v format, log: %s, error: %v", fileFormat, logText, err)
 			}
-
-			// stopRules contains array of EarlyStoppingRules that has not been reached yet.
-			// After rule is reached we delete appropriate element from the array.
-			for idx, rule := range stopRules {
-				value, exist := logJsonObj[rule.Name].(string)
-				if !exist {
+			// Rules are updated for each metric once, since reached rules are deleted by the update.
+			updatedMetrics := make(map[string]bool)
+			for _, mlog := range mlogs {
+				if updatedMetrics[mlog.Metric.Name] {
 					continue
 				}
-				metricValue, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+				updatedMetrics[mlog.Metric.Name] = true
+				metricValue, err := strconv.ParseFloat(strings.TrimSpace(mlog.Metric.Value), 64)
 				if err != nil {
-					klog.Fatalf("Unable to parse value %v to float for metric %v", metricValue, rule.Name)
+					klog.Fatalf("Unable to parse value %v to float for metric %v", mlog.Metric.Value, mlog.Metric.Name)
+				}
+				if err = stopRules.Update(mlog.Metric.Name, metricValue); err != nil {
+					klog.Fatal(err)
 				}
-				stopRules, optimalObjValue = updateStopRules(stopRules, optimalObjValue, metricValue, metricStartStep, rule, idx)
 			}
 		default:
-			klog.Fatalf("Format must be set to %v or %v", commonv1beta1.TextFormat, commonv1beta1.JsonFormat)
+			klog.Fatalf("Format must be set to %v, %v, %v or %v",
+				commonv1beta1.TextFormat, commonv1beta1.JsonFormat, commonv1beta1.CsvFormat, commonv1beta1.LogfmtFormat)
 		}
 
-		// If stopRules array is empty, Trial is early stopped.
-		if len(stopRules) == 0 {
+		// If all stop rules are reached, Trial is early stopped.
+		if stopRules.Reached() {
 			klog.Info("Training container is early stopped")
 			isEarlyStopped = true
 
-			// Create ".pid" file with "early-stopped" line.
-			// Which means that training is early stopped and Trial status is updated.
-			markFile := filepath.Join(filepath.Dir(mFile), fmt.Sprintf("%d.pid", mainProcPid))
-			_, err := os.Create(markFile)
-			if err != nil {
-				klog.Fatalf("Create mark file %v error: %v", markFile, err)
-			}
-
-			err = ioutil.WriteFile(markFile, []byte(common.TrainingEarlyStopped), 0)
-			if err != nil {
-				klog.Fatalf("Write to file %v error: %v", markFile, err)
-			}
-
-			// Get child process from main PID.
-			childProc, err := mainProc.Children()
-			if err != nil {
-				klog.Fatalf("Get children proceses for main PID: %v failed: %v", mainProcPid, err)
-			}
-
-			// TODO (andreyvelich): Currently support only single child process.
-			if len(childProc) != 1 {
-				klog.Fatalf("Multiple children processes are not supported. Children processes: %v", childProc)
+			if err = common.MarkTrainingEarlyStopped(filepath.Dir(mFile), mainProcPid); err != nil {
+				klog.Fatal(err)
 			}
-
-			// Terminate the child process.
-			err = childProc[0].Terminate()
-			if err != nil {
-				klog.Fatalf("Unable to terminate child process %v, error: %v", childProc[0], err)
+			if err = common.TerminateTraining(mainProcPid, terminationOpts); err != nil {
+				klog.Fatal(err)
 			}
 
 			// Report metrics to DB.
 			reportMetrics(filters, fileFormat)
 
 			// Wait until main process is completed.
-			timeout := 60 * time.Second
-			endTime := time.Now().Add(timeout)
-			isProcRunning := true
-			for isProcRunning && time.Now().Before(endTime) {
-				isProcRunning, err = mainProc.IsRunning()
-				// Ignore "no such file error". It means that process is complete.
-				if err != nil && !os.IsNotExist(err) {
-					klog.Fatalf("Check process status for main PID: %v failed: %v", mainProcPid, err)
-				}
+			if err = common.WaitMainProcessStopped(mainProcPid); err != nil {
+				klog.Fatal(err)
 			}
-
-			// Create connection and client for Early Stopping service.
-			conn, err := grpc.Dial(*earlyStopServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-			if err != nil {
-				klog.Fatalf("Could not connect to Early Stopping service, error: %v", err)
+			if err = common.SetTrialEarlyStopped(*earlyStopServiceAddr, *trialName); err != nil {
+				klog.Fatal(err)
 			}
-			c := api.NewEarlyStoppingClient(conn)
-
-			setTrialStatusReq := &api.SetTrialStatusRequest{
-				TrialName: *trialName,
-			}
-
-			// Send request to change Trial status to early stopped.
-			_, err = c.SetTrialStatus(context.Background(), setTrialStatusReq)
-			if err != nil {
-				klog.Fatalf("Set Trial status error: %v", err)
-			}
-			conn.Close()
 
 			klog.Infof("Trial status is successfully updated")
 		}
 	}
 }
 
-func updateStopRules(
-	stopRules []commonv1beta1.EarlyStoppingRule,
-	optimalObjValue *float64,
-	metricValue float64,
-	metricStartStep map[string]int,
-	rule commonv1beta1.EarlyStoppingRule,
-	ruleIdx int,
-) ([]commonv1beta1.EarlyStoppingRule, *float64) {
-
-	// First metric is objective in metricNames array.
-	objMetric := strings.Split(*metricNames, ";")[0]
-	objType := commonv1beta1.ObjectiveType(*objectiveType)
-
-	// Calculate optimalObjValue.
-	if rule.Name == objMetric {
-		if optimalObjValue == nil {
-			optimalObjValue = &metricValue
-		} else if objType == commonv1beta1.ObjectiveTypeMaximize && metricValue > *optimalObjValue {
-			optimalObjValue = &metricValue
-		} else if objType == commonv1beta1.ObjectiveTypeMinimize && metricValue < *optimalObjValue {
-			optimalObjValue = &metricValue
-		}
-		// Assign best optimal value to metric value.
-		metricValue = *optimalObjValue
-	}
-
-	// Reduce steps if appropriate metric is reported.
-	// Once rest steps are empty we apply early stopping rule.
-	if _, ok := metricStartStep[rule.Name]; ok {
-		metricStartStep[rule.Name]--
-		if metricStartStep[rule.Name] != 0 {
-			return stopRules, optimalObjValue
-		}
-	}
-
-	ruleValue, err := strconv.ParseFloat(rule.Value, 64)
-	if err != nil {
-		klog.Fatalf("Unable to parse value %v to float for rule metric %v", rule.Value, rule.Name)
-	}
-
-	// Metric value can be equal, less or greater than stop rule.
-	// Deleting suitable stop rule from the array.
-	if rule.Comparison == commonv1beta1.ComparisonTypeEqual && metricValue == ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	} else if rule.Comparison == commonv1beta1.ComparisonTypeLess && metricValue < ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	} else if rule.Comparison == commonv1beta1.ComparisonTypeGreater && metricValue > ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	}
-	return stopRules, optimalObjValue
-}
-
-func deleteStopRule(stopRules []commonv1beta1.EarlyStoppingRule, idx int) []commonv1beta1.EarlyStoppingRule {
-	if idx >= len(stopRules) {
-		klog.Fatalf("Index %v out of range stopRules: %v", idx, stopRules)
-	}
-	stopRules[idx] = stopRules[len(stopRules)-1]
-	stopRules[len(stopRules)-1] = commonv1beta1.EarlyStoppingRule{}
-	return stopRules[:len(stopRules)-1]
-}
-
 func main() {
 	flag.Var(&stopRules, "stop-rule", "The list of early stopping stop rules")
 	flag.Parse()
@@ -401,9 +246,20 @@ func main() {
 
 	fileFormat := commonv1beta1.FileFormat(*metricsFileFormat)
 
+	go func() {
+		flushDone <- flushMetrics(filters, fileFormat)
+	}()
+
 	// If stop rule is set we need to parse metrics during run.
 	if len(stopRules) != 0 {
-		go watchMetricsFile(*metricsFilePath, stopRules, filters, fileFormat)
+		// First metric is objective in metricNames array.
+		objMetric := strings.Split(*metricNames, ";")[0]
+		objType := commonv1beta1.ObjectiveType(*objectiveType)
+		terminationOpts, err := common.NewTerminationOpts(*stopMode, *stopSignal, *stopGracePeriod)
+		if err != nil {
+			klog.Fatal(err)
+		}
+		go watchMetricsFile(*metricsFilePath, common.NewStopRules(stopRules, objMetric, objType), terminationOpts, filters, fileFormat)
 	} else {
 		go printMetricsFile(*metricsFilePath)
 	}
@@ -426,25 +282,91 @@ func main() {
 	}
 }
 
-func reportMetrics(filters []string, fileFormat commonv1beta1.FileFormat) {
-
-	conn, err := grpc.Dial(*dbManagerServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func newDBManagerClient() *katibmanagerv1beta1.KatibDBManagerClient {
+	dbManagerOpts, err := katibmanagerv1beta1.DBManagerClientOptionsFromEnv()
+	if err != nil {
+		klog.Fatalf("Failed to load DB manager client credentials, error: %v", err)
+	}
+	c, err := katibmanagerv1beta1.NewKatibDBManagerClient(*dbManagerServiceAddr, dbManagerOpts...)
 	if err != nil {
 		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
 	}
-	defer conn.Close()
-	c := api.NewDBManagerClient(conn)
-	ctx := context.Background()
+	return c
+}
+
+func getMetricList() []string {
 	var metricList []string
 	if len(*metricNames) != 0 {
 		metricList = strings.Split(*metricNames, ";")
 	}
-	olog, err := filemc.CollectObservationLog(*metricsFilePath, metricList, filters, fileFormat)
+	return metricList
+}
+
+// flushMetrics streams new metrics to DB every flush interval while they are written to the metrics file.
+// Metrics are streamed with the metrics file offset and offsets are saved in the checkpoint file,
+// so metrics are not registered twice once the metrics collector is restarted.
+func flushMetrics(filters []string, fileFormat commonv1beta1.FileFormat) error {
+	c := newDBManagerClient()
+	defer c.Close()
+	// Offsets of the metrics file are valid only for the pod, since the file is recreated with the pod.
+	podName, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := common.NewObservationLogStream(ctx, c, *trialName, *trialNamespace, *experimentName, *trialUID)
+	if err != nil {
+		return err
+	}
+	err = filemc.FollowObservationLog(*metricsFilePath, getMetricList(), filters, fileFormat, *timestampKey, *flushInterval,
+		*metricsFilePath+checkpointFileSuffix, flushStop, func(mlogs []*api.MetricLog, offset int64) error {
+			return stream.SendWithOffset(mlogs, podName, offset)
+		})
+	if err != nil {
+		return err
+	}
+	// Errors of the sent metrics are returned once the stream is closed.
+	return stream.Close()
+}
+
+func reportMetrics(filters []string, fileFormat commonv1beta1.FileFormat) {
+	reportOnce.Do(func() {
+		finishMetricsReport(filters, fileFormat)
+	})
+}
+
+func finishMetricsReport(filters []string, fileFormat commonv1beta1.FileFormat) {
+	// Wait until the rest of the metrics file is flushed.
+	close(flushStop)
+	err := <-flushDone
+	if err == nil {
+		klog.Infof("Metrics reported.")
+		return
+	}
+	klog.Warningf("Failed to flush metrics, all metrics are reported again: %v", err)
+
+	c := newDBManagerClient()
+	defer c.Close()
+	ctx := context.Background()
+	olog, err := filemc.CollectObservationLog(*metricsFilePath, getMetricList(), filters, fileFormat, *timestampKey)
 	if err != nil {
 		klog.Fatalf("Failed to collect logs: %v", err)
 	}
+	// Delete partially flushed metrics to avoid duplicates.
+	deletereq := &api.DeleteObservationLogRequest{
+		TrialName: *trialName,
+		Namespace: *trialNamespace,
+	}
+	_, err = c.DeleteObservationLog(ctx, deletereq)
+	if err != nil {
+		klog.Fatalf("Failed to delete flushed logs: %v", err)
+	}
 	reportreq := &api.ReportObservationLogRequest{
 		TrialName:      *trialName,
+		Namespace:      *trialNamespace,
+		ExperimentName: *experimentName,
+		TrialUid:       *trialUID,
 		ObservationLog: olog,
 	}
 	_, err = c.ReportObservationLog(ctx, reportreq)
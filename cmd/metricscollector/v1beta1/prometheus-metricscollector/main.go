@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+PrometheusMetricsCollector is a metricscollector for the PrometheusMetric collector kind.
+It scrapes metrics endpoint of the training container in the Prometheus text format.
+The training container should expose the objective and additional metrics as gauges, for example:
+     ---
+     # TYPE accuracy gauge
+     accuracy 0.7
+     # TYPE loss gauge
+     loss 0.2
+     ---
+Metrics are reported when their values or timestamps are changed since the previous scrape.
+Summary and histogram metrics can be collected with _sum and _count names.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog"
+
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	promc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/prometheus-metricscollector"
+)
+
+type stringsFlag []string
+
+func (flag *stringsFlag) String() string {
+	return strings.Join(*flag, ",")
+}
+
+func (flag *stringsFlag) Set(value string) error {
+	*flag = append(*flag, value)
+	return nil
+}
+
+var (
+	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
+	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
+	trialName            = flag.String("t", "", "Trial Name")
+	trialNamespace       = flag.String("n", "", "Trial Namespace")
+	experimentName       = flag.String("e", "", "Experiment Name")
+	trialUID             = flag.String("uid", "", "Trial UID")
+	metricsURL           = flag.String("url", "", "Prometheus metrics endpoint URL")
+	metricNames          = flag.String("m", "", "Metric names")
+	objectiveType        = flag.String("o-type", "", "Objective type")
+	scrapeInterval       = flag.Duration("i", common.DefaultScrapeInterval, "Interval between metrics scrapes")
+	scrapeTimeout        = flag.Duration("scrape-timeout", common.DefaultScrapeTimeout, "Timeout of metrics scrape")
+	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
+	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
+	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
+	stopRules            stringsFlag
+)
+
+// reporter streams scraped metrics to DB Manager and keeps them to report again if the stream is broken.
+type reporter struct {
+	stream     *common.ObservationLogStream
+	streamErr  error
+	metricLogs []*api.MetricLog
+}
+
+func (r *reporter) send(metricLogs []*api.MetricLog) error {
+	r.metricLogs = append(r.metricLogs, metricLogs...)
+	if r.streamErr == nil {
+		if r.streamErr = r.stream.Send(metricLogs); r.streamErr != nil {
+			klog.Warningf("Failed to stream metrics, metrics are reported after training is completed: %v", r.streamErr)
+		}
+	}
+	// Scraping is continued even if the stream is broken.
+	return nil
+}
+
+func main() {
+	flag.Var(&stopRules, "stop-rule", "The list of early stopping stop rules")
+	flag.Parse()
+	klog.Infof("Trial Name: %s", *trialName)
+	if len(stopRules) != 0 {
+		klog.Warningf("Early stopping is not supported by Prometheus metrics collector, stop rules %v are ignored", stopRules)
+	}
+
+	dbManagerOpts, err := katibmanagerv1beta1.DBManagerClientOptionsFromEnv()
+	if err != nil {
+		klog.Fatalf("Failed to load DB manager client credentials, error: %v", err)
+	}
+	c, err := katibmanagerv1beta1.NewKatibDBManagerClient(*dbManagerServiceAddr, dbManagerOpts...)
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := &reporter{}
+	r.stream, r.streamErr = common.NewObservationLogStream(ctx, c, *trialName, *trialNamespace, *experimentName, *trialUID)
+	if r.streamErr != nil {
+		klog.Warningf("Failed to open metrics stream, metrics are reported after training is completed: %v", r.streamErr)
+	}
+
+	scraper := promc.NewScraper(*metricsURL, strings.Split(*metricNames, ";"), *scrapeTimeout)
+	stop := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- promc.FollowObservationLog(scraper, *scrapeInterval, stop, r.send)
+	}()
+
+	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
+	wopts := common.WaitPidsOpts{
+		PollInterval: *pollInterval,
+		Timeout:      *timeout,
+		WaitAll:      waitAll,
+	}
+	if err := common.WaitMainProcesses(wopts); err != nil {
+		klog.Fatalf("Failed to wait for worker container: %v", err)
+	}
+
+	// Wait until the last scrape is reported.
+	close(stop)
+	if err := <-done; err != nil {
+		klog.Fatalf("Failed to collect metrics: %v", err)
+	}
+	if r.streamErr == nil {
+		if r.streamErr = r.stream.Close(); r.streamErr == nil {
+			klog.Infof("Metrics reported.")
+			return
+		}
+	}
+	klog.Warningf("Failed to stream metrics, all metrics are reported again: %v", r.streamErr)
+
+	// Delete partially streamed metrics to avoid duplicates.
+	deletereq := &api.DeleteObservationLogRequest{
+		TrialName: *trialName,
+		Namespace: *trialNamespace,
+	}
+	if _, err = c.DeleteObservationLog(context.Background(), deletereq); err != nil {
+		klog.Fatalf("Failed to delete streamed logs: %v", err)
+	}
+	reportreq := &api.ReportObservationLogRequest{
+		TrialName:      *trialName,
+		Namespace:      *trialNamespace,
+		ExperimentName: *experimentName,
+		TrialUid:       *trialUID,
+		ObservationLog: &api.ObservationLog{MetricLogs: r.metricLogs},
+	}
+	if _, err = c.ReportObservationLog(context.Background(), reportreq); err != nil {
+		klog.Fatalf("Failed to Report logs: %v", err)
+	}
+	klog.Infof("Metrics reported. :\n%v", reportreq.ObservationLog)
+}
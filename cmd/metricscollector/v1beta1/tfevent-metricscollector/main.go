@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+TFEventMetricsCollector is a metricscollector for the TensorFlowEvent collector kind.
+It parses scalar summaries from the TensorFlow event files in the metrics directory.
+When the event file is under a directory (e.g. test dir), metric name must be "{{dirname}}/{{metric name}}".
+For example, the training code writes summaries like below.
+     ---
+     /log/train/events.out.tfevents.1640995200: accuracy, loss
+     /log/test/events.out.tfevents.1640995200: accuracy, loss
+     ---
+To collect accuracy of the test directory, the metric name is "test/accuracy".
+If early stopping rules are set, event files are parsed while training is running.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	tfeventmc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/tfevent-metricscollector"
+)
+
+var (
+	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
+	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
+	trialName            = flag.String("t", "", "Trial Name")
+	trialNamespace       = flag.String("n", "", "Trial Namespace")
+	experimentName       = flag.String("e", "", "Experiment Name")
+	trialUID             = flag.String("uid", "", "Trial UID")
+	metricsFileDir       = flag.String("path", "/log", "TensorFlow event files directory")
+	metricNames          = flag.String("m", "", "Metric names")
+	objectiveType        = flag.String("o-type", "", "Objective type")
+	metricFilters        = flag.String("f", "", "Metric filters")
+	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
+	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
+	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
+	stopMode             = flag.String("stop-mode", common.DefaultTerminationMode, "Training processes which are signaled when training is early stopped: ProcessTree or ProcessGroup")
+	stopSignal           = flag.String("stop-signal", common.DefaultTerminationSignal, "Signal which is sent to training processes when training is early stopped")
+	stopGracePeriod      = flag.Duration("stop-grace-period", common.DefaultTerminationGracePeriod, "Time to wait until training processes are stopped by the signal before they are killed")
+	stopRules            common.StopRulesFlag
+	isEarlyStopped       = false
+
+	// Metrics are reported once, either after early stopping or after main process is completed.
+	reportOnce sync.Once
+)
+
+// watchEventFiles parses event files while training is running and early stops training once all stop rules are reached.
+func watchEventFiles(dir string, stopRules *common.StopRules, terminationOpts common.TerminationOpts) {
+	// Get Main process.
+	_, mainProcPid, err := common.GetMainProcesses(dir)
+	if err != nil {
+		klog.Fatalf("GetMainProcesses failed: %v", err)
+	}
+
+	follower := tfeventmc.NewFollower(dir, getMetricList())
+	for !stopRules.Reached() {
+		time.Sleep(*pollInterval)
+		mlogs, err := follower.Poll()
+		if err != nil {
+			klog.Fatalf("Failed to parse event files: %v", err)
+		}
+		for _, mlog := range mlogs {
+			metricValue, err := strconv.ParseFloat(mlog.Metric.Value, 64)
+			if err != nil {
+				klog.Fatalf("Unable to parse value %v to float for metric %v", mlog.Metric.Value, mlog.Metric.Name)
+			}
+			if err = stopRules.Update(mlog.Metric.Name, metricValue); err != nil {
+				klog.Fatal(err)
+			}
+		}
+	}
+
+	klog.Info("Training container is early stopped")
+	isEarlyStopped = true
+
+	if err = common.MarkTrainingEarlyStopped(dir, mainProcPid); err != nil {
+		klog.Fatal(err)
+	}
+	if err = common.TerminateTraining(mainProcPid, terminationOpts); err != nil {
+		klog.Fatal(err)
+	}
+
+	// Report metrics to DB.
+	reportMetrics()
+
+	// Wait until main process is completed.
+	if err = common.WaitMainProcessStopped(mainProcPid); err != nil {
+		klog.Fatal(err)
+	}
+	if err = common.SetTrialEarlyStopped(*earlyStopServiceAddr, *trialName); err != nil {
+		klog.Fatal(err)
+	}
+
+	klog.Infof("Trial status is successfully updated")
+}
+
+func main() {
+	flag.Var(&stopRules, "stop-rule", "The list of early stopping stop rules")
+	flag.Parse()
+	klog.Infof("Trial Name: %s", *trialName)
+	if len(*metricFilters) != 0 {
+		klog.Warningf("Metric filters %v are not supported by TensorFlow event metrics collector", *metricFilters)
+	}
+
+	// If stop rule is set we need to parse event files during run.
+	if len(stopRules) != 0 {
+		// First metric is objective in metricNames array.
+		objMetric := strings.Split(*metricNames, ";")[0]
+		objType := commonv1beta1.ObjectiveType(*objectiveType)
+		terminationOpts, err := common.NewTerminationOpts(*stopMode, *stopSignal, *stopGracePeriod)
+		if err != nil {
+			klog.Fatal(err)
+		}
+		go watchEventFiles(*metricsFileDir, common.NewStopRules(stopRules, objMetric, objType), terminationOpts)
+	}
+
+	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
+
+	wopts := common.WaitPidsOpts{
+		PollInterval:           *pollInterval,
+		Timeout:                *timeout,
+		WaitAll:                waitAll,
+		CompletedMarkedDirPath: *metricsFileDir,
+	}
+	if err := common.WaitMainProcesses(wopts); err != nil {
+		klog.Fatalf("Failed to wait for worker container: %v", err)
+	}
+
+	// If training was not early stopped, report the metrics.
+	if !isEarlyStopped {
+		reportMetrics()
+	}
+}
+
+func getMetricList() []string {
+	var metricList []string
+	if len(*metricNames) != 0 {
+		metricList = strings.Split(*metricNames, ";")
+	}
+	return metricList
+}
+
+func reportMetrics() {
+	reportOnce.Do(func() {
+		finishMetricsReport()
+	})
+}
+
+func finishMetricsReport() {
+	dbManagerOpts, err := katibmanagerv1beta1.DBManagerClientOptionsFromEnv()
+	if err != nil {
+		klog.Fatalf("Failed to load DB manager client credentials, error: %v", err)
+	}
+	c, err := katibmanagerv1beta1.NewKatibDBManagerClient(*dbManagerServiceAddr, dbManagerOpts...)
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer c.Close()
+
+	olog, err := tfeventmc.CollectObservationLog(*metricsFileDir, getMetricList())
+	if err != nil {
+		klog.Fatalf("Failed to collect logs: %v", err)
+	}
+	reportreq := &api.ReportObservationLogRequest{
+		TrialName:      *trialName,
+		Namespace:      *trialNamespace,
+		ExperimentName: *experimentName,
+		TrialUid:       *trialUID,
+		ObservationLog: olog,
+	}
+	if _, err = c.ReportObservationLog(context.Background(), reportreq); err != nil {
+		klog.Fatalf("Failed to Report logs: %v", err)
+	}
+	klog.Infof("In %v %d metrics are reported.", *trialName, len(olog.MetricLogs))
+}
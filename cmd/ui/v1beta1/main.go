@@ -41,7 +41,16 @@ func init() {
 
 func main() {
 	flag.Parse()
-	kuh := ui.NewKatibUIHandler(*dbManagerAddr)
+	dbManagerOpts, err := common_v1beta1.DBManagerClientOptionsFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to load Katib DB Manager client credentials: %v", err)
+	}
+	dbManagerClient, err := common_v1beta1.NewKatibDBManagerClient(*dbManagerAddr, dbManagerOpts...)
+	if err != nil {
+		log.Fatalf("Failed to create Katib DB Manager client: %v", err)
+	}
+	defer dbManagerClient.Close()
+	kuh := ui.NewKatibUIHandler(dbManagerClient)
 
 	log.Printf("Serving the frontend dir %s", *buildDir)
 	frontend := http.FileServer(http.Dir(*buildDir))
@@ -73,8 +73,37 @@ type EarlyStoppingRule struct {
 	// that should be received before applying the rule.
 	// If start step is empty, rule is applied from the first recorded metric.
 	StartStep int `json:"startStep,omitempty"`
+
+	// Strategy defines how the reported metric values are aggregated
+	// before comparison with the rule value, one of min, max, latest or movingAverage.
+	// If strategy is empty, the best value is used for the objective metric
+	// and the latest value for other metrics.
+	Strategy EarlyStoppingStrategyType `json:"strategy,omitempty"`
+
+	// WindowSize defines quantity of the latest reported values
+	// that are averaged by the movingAverage strategy.
+	// Rule is applied once WindowSize values are received.
+	WindowSize int `json:"windowSize,omitempty"`
 }
 
+// EarlyStoppingStrategyType is the approach to aggregate metric values for early stopping rule.
+type EarlyStoppingStrategyType string
+
+const (
+	// EarlyStoppingStrategyMin means that minimum of the reported values is compared with the rule value.
+	EarlyStoppingStrategyMin EarlyStoppingStrategyType = "min"
+
+	// EarlyStoppingStrategyMax means that maximum of the reported values is compared with the rule value.
+	EarlyStoppingStrategyMax EarlyStoppingStrategyType = "max"
+
+	// EarlyStoppingStrategyLatest means that the latest reported value is compared with the rule value.
+	EarlyStoppingStrategyLatest EarlyStoppingStrategyType = "latest"
+
+	// EarlyStoppingStrategyMovingAverage means that average of the latest
+	// WindowSize reported values is compared with the rule value.
+	EarlyStoppingStrategyMovingAverage EarlyStoppingStrategyType = "movingAverage"
+)
+
 // ComparisonType is the type of comparison, one of equal, less or greater.
 type ComparisonType string
 
@@ -110,6 +139,45 @@ type ObjectiveSpec struct {
 	// MetricStrategies defines various rules (min, max or latest) to extract metrics values.
 	// This field is allowed to missing, experiment defaulter (webhook) will fill it.
 	MetricStrategies []MetricStrategy `json:"metricStrategies,omitempty"`
+
+	// AdditionalObjectives represents metrics which are optimized together with the primary metric
+	// in the multi-objective Experiment. Each objective has its own type and goal.
+	// For the multi-objective Experiment status contains the Pareto front of Trials.
+	// Experiment defaulter (webhook) adds the objective metrics to AdditionalMetricNames.
+	// Additional objectives are supported only by algorithms which sample independently of objective values:
+	// random, grid and sobol.
+	AdditionalObjectives []AdditionalObjective `json:"additionalObjectives,omitempty"`
+
+	// Constraints represents outcome constraints which Trial metrics must satisfy,
+	// e.g. latency less than 50. Infeasible Trials are not considered as optimal Trials.
+	// Experiment defaulter (webhook) adds the constraint metrics to AdditionalMetricNames.
+	Constraints []ObjectiveConstraint `json:"constraints,omitempty"`
+}
+
+// AdditionalObjective is the additional objective of the multi-objective Experiment.
+type AdditionalObjective struct {
+	// Type for the objective optimization.
+	Type ObjectiveType `json:"type,omitempty"`
+
+	// Goal is the objective goal that should be reached.
+	// Experiment goal is reached when one Trial reaches goals of all objectives which have goal.
+	Goal *float64 `json:"goal,omitempty"`
+
+	// ObjectiveMetricName represents metric to optimize.
+	ObjectiveMetricName string `json:"objectiveMetricName,omitempty"`
+}
+
+// ObjectiveConstraint is the outcome constraint of the Experiment.
+type ObjectiveConstraint struct {
+	// MetricName contains metric name for the constraint.
+	MetricName string `json:"metricName,omitempty"`
+
+	// Value contains metric value for the constraint.
+	Value string `json:"value,omitempty"`
+
+	// Comparison defines correlation between metric value and constraint value.
+	// Trial is feasible if metric value satisfies the comparison, e.g. latency < 50.
+	Comparison ComparisonType `json:"comparison,omitempty"`
 }
 
 // ObjectiveType is the type of Experiment optimization, one of minimize or maximize.
@@ -121,6 +189,24 @@ const (
 	ObjectiveTypeMaximize ObjectiveType = "maximize"
 )
 
+// SuspendPolicyType describes how active Trials are handled once the Experiment is suspended.
+type SuspendPolicyType string
+
+const (
+	// SuspendPolicyKeepRunning means that active Trials are running until completion.
+	SuspendPolicyKeepRunning SuspendPolicyType = "KeepRunning"
+
+	// SuspendPolicySuspendJobs means that jobs of active Trials are suspended with the job suspend field,
+	// spec.suspend for batch Job and spec.runPolicy.suspend for Kubeflow Training Operator jobs.
+	// Other job kinds can't be suspended with this policy.
+	SuspendPolicySuspendJobs SuspendPolicyType = "SuspendJobs"
+
+	// SuspendPolicyDeleteJobs means that jobs of active Trials are deleted
+	// and created again once the Experiment is resumed. Active Trials are restarted from scratch,
+	// their observation logs are deleted together with the jobs.
+	SuspendPolicyDeleteJobs SuspendPolicyType = "DeleteJobs"
+)
+
 type ParameterAssignment struct {
 	Name  string `json:"name,omitempty"`
 	Value string `json:"value,omitempty"`
@@ -193,6 +279,10 @@ type FileFormat string
 const (
 	TextFormat FileFormat = "TEXT"
 	JsonFormat FileFormat = "JSON"
+	// CsvFormat is the CSV file with the header row which contains metric names.
+	CsvFormat FileFormat = "CSV"
+	// LogfmtFormat is the file with lines of space separated key=value pairs.
+	LogfmtFormat FileFormat = "LOGFMT"
 )
 
 // +k8s:deepcopy-gen=true
@@ -200,6 +290,9 @@ type FileSystemPath struct {
 	Path   string         `json:"path,omitempty"`
 	Kind   FileSystemKind `json:"kind,omitempty"`
 	Format FileFormat     `json:"format,omitempty"`
+	// Key of the metrics timestamp in JSON, CSV and LOGFMT formats. Defaults to "timestamp".
+	// In JSON format, it can be GJSON path, e.g. "meta.time".
+	TimestampKey string `json:"timestampKey,omitempty"`
 }
 
 type CollectorKind string
@@ -219,6 +312,10 @@ const (
 
 	CustomCollector CollectorKind = "Custom"
 
+	// Metrics are parsed from logs of the Trial primary container which are streamed by Katib controller
+	// with Kubernetes API, so the Trial pod is not mutated.
+	PodLogsCollector CollectorKind = "PodLogs"
+
 	// When model training source code persists metrics into persistent layer
 	// directly, metricsCollector isn't in need, and its kind is "noneCollector"
 	NoneCollector CollectorKind = "None"
@@ -25,6 +25,27 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalObjective) DeepCopyInto(out *AdditionalObjective) {
+	*out = *in
+	if in.Goal != nil {
+		in, out := &in.Goal, &out.Goal
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalObjective.
+func (in *AdditionalObjective) DeepCopy() *AdditionalObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlgorithmSetting) DeepCopyInto(out *AlgorithmSetting) {
 	*out = *in
@@ -231,6 +252,22 @@ func (in *MetricsCollectorSpec) DeepCopy() *MetricsCollectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectiveConstraint) DeepCopyInto(out *ObjectiveConstraint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectiveConstraint.
+func (in *ObjectiveConstraint) DeepCopy() *ObjectiveConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectiveConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
 	*out = *in
@@ -249,6 +286,18 @@ func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
 		*out = make([]MetricStrategy, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalObjectives != nil {
+		in, out := &in.AdditionalObjectives, &out.AdditionalObjectives
+		*out = make([]AdditionalObjective, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]ObjectiveConstraint, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
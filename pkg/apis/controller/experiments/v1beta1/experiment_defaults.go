@@ -75,6 +75,46 @@ func (e *Experiment) setDefaultObjective() {
 			obj.MetricStrategies = append(obj.MetricStrategies, strategy)
 		}
 
+		// Metrics of additional objectives are collected as additional metrics,
+		// default strategy is set according to the type of each objective.
+		for _, additionalObjective := range obj.AdditionalObjectives {
+			metricName := additionalObjective.ObjectiveMetricName
+			if !containsMetricName(obj.AdditionalMetricNames, metricName) {
+				obj.AdditionalMetricNames = append(obj.AdditionalMetricNames, metricName)
+			}
+			if _, ok := metricsWithDefault[metricName]; ok {
+				continue
+			}
+			var strategy common.MetricStrategy
+			switch additionalObjective.Type {
+			case common.ObjectiveTypeMinimize:
+				strategy = common.MetricStrategy{Name: metricName, Value: common.ExtractByMin}
+			case common.ObjectiveTypeMaximize:
+				strategy = common.MetricStrategy{Name: metricName, Value: common.ExtractByMax}
+			default:
+				strategy = common.MetricStrategy{Name: metricName, Value: common.ExtractByLatest}
+			}
+			obj.MetricStrategies = append(obj.MetricStrategies, strategy)
+			metricsWithDefault[metricName] = 1
+		}
+
+		// Metrics of constraints are collected as additional metrics,
+		// the latest value is compared with the constraint value by default.
+		for _, constraint := range obj.Constraints {
+			metricName := constraint.MetricName
+			if metricName == obj.ObjectiveMetricName {
+				continue
+			}
+			if !containsMetricName(obj.AdditionalMetricNames, metricName) {
+				obj.AdditionalMetricNames = append(obj.AdditionalMetricNames, metricName)
+			}
+			if _, ok := metricsWithDefault[metricName]; ok {
+				continue
+			}
+			obj.MetricStrategies = append(obj.MetricStrategies, common.MetricStrategy{Name: metricName, Value: common.ExtractByLatest})
+			metricsWithDefault[metricName] = 1
+		}
+
 		// Set default strategy of additional metrics to ExtractByLatest.
 		for _, metricName := range obj.AdditionalMetricNames {
 			if _, ok := metricsWithDefault[metricName]; !ok {
@@ -93,6 +133,15 @@ func (e *Experiment) setDefaultObjective() {
 	}
 }
 
+func containsMetricName(metricNames []string, metricName string) bool {
+	for _, name := range metricNames {
+		if name == metricName {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *Experiment) setDefaultTrialTemplate() {
 	t := e.Spec.TrialTemplate
 
@@ -57,6 +57,58 @@ type ExperimentSpec struct {
 
 	// Describes resuming policy which usually take effect after experiment terminated.
 	ResumePolicy ResumePolicyType `json:"resumePolicy,omitempty"`
+
+	// Describes limits of the time and resources which can be consumed by the experiment.
+	// Experiment is succeeded once any of the limits is exceeded.
+	Budget *BudgetSpec `json:"budget,omitempty"`
+
+	// Describes the policy to stop the experiment once the objective doesn't improve.
+	// Experiment is succeeded once the objective plateau is reached.
+	Plateau *PlateauSpec `json:"plateau,omitempty"`
+
+	// Suspend indicates that the experiment is suspended. New trials are not created
+	// and suggestion deployment is scaled to zero while the experiment is suspended.
+	// Experiment is resumed once the flag is cleared.
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Describes how active trials are handled while the experiment is suspended.
+	// Defaults to KeepRunning.
+	SuspendPolicy common.SuspendPolicyType `json:"suspendPolicy,omitempty"`
+}
+
+// BudgetSpec describes limits of the time and resources which can be consumed by the Experiment.
+type BudgetSpec struct {
+	// Max duration in seconds of the Experiment since it was started.
+	MaxDurationSeconds *int64 `json:"maxDurationSeconds,omitempty"`
+
+	// Max cumulative runtime in seconds of all Trials.
+	// Trial runtime is the time between the Trial start and completion.
+	MaxTrialRuntimeSeconds *int64 `json:"maxTrialRuntimeSeconds,omitempty"`
+
+	// Max cumulative CPU-hours of all Trials.
+	// CPU-hours of the Trial are derived from CPU requests of pods in the Trial run spec and the Trial runtime.
+	MaxCPUHours *float64 `json:"maxCPUHours,omitempty"`
+
+	// Max cumulative GPU-hours of all Trials.
+	// GPU-hours of the Trial are derived from GPU requests of pods in the Trial run spec and the Trial runtime.
+	MaxGPUHours *float64 `json:"maxGPUHours,omitempty"`
+
+	// KillActiveTrials indicates that pending and running Trials are killed once the budget is exhausted.
+	// Otherwise, new Trials are not created, but active Trials are running until completion.
+	KillActiveTrials bool `json:"killActiveTrials,omitempty"`
+}
+
+// PlateauSpec describes when the Experiment objective is considered as converged.
+// Objective plateau is reached if the best objective value of the last completed Trials
+// is not improved by more than Delta over the best value of the previous Trials.
+// For the multi-objective Experiment only the primary objective is considered.
+type PlateauSpec struct {
+	// Number of the last completed Trials which are compared with the previous Trials.
+	TrialCount int32 `json:"trialCount,omitempty"`
+
+	// Min improvement of the best objective value which is not considered as plateau.
+	// Defaults to 0, any improvement of the best objective value is not considered as plateau.
+	Delta float64 `json:"delta,omitempty"`
 }
 
 // ExperimentStatus is the current status of an Experiment.
@@ -76,12 +128,24 @@ type ExperimentStatus struct {
 	// It is represented in RFC3339 form and is in UTC.
 	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
 
+	// Represents time when the Experiment was suspended. It is cleared once the Experiment is resumed.
+	// It is represented in RFC3339 form and is in UTC.
+	SuspendTime *metav1.Time `json:"suspendTime,omitempty"`
+
+	// Total time in seconds which the Experiment was suspended before it was resumed last time.
+	// Suspended time is not counted in the Experiment budget.
+	SuspendedSeconds int64 `json:"suspendedSeconds,omitempty"`
+
 	// List of observed runtime conditions for this Experiment.
 	Conditions []ExperimentCondition `json:"conditions,omitempty"`
 
 	// Current optimal trial parameters and observations.
 	CurrentOptimalTrial OptimalTrial `json:"currentOptimalTrial,omitempty"`
 
+	// Pareto optimal trials of the multi-objective Experiment, which are not dominated by other trials.
+	// For the multi-objective Experiment current optimal trial is the best trial by the primary objective.
+	ParetoOptimalTrials []OptimalTrial `json:"paretoOptimalTrials,omitempty"`
+
 	// List of trial names which are running.
 	RunningTrialList []string `json:"runningTrialList,omitempty"`
 
@@ -103,6 +167,10 @@ type ExperimentStatus struct {
 	// List of trial names which have been metrics unavailable
 	MetricsUnavailableTrialList []string `json:"metricsUnavailableTrialList,omitempty"`
 
+	// List of trial names which metrics don't satisfy the objective constraints.
+	// Infeasible trials are also in the list of their current state.
+	InfeasibleTrialList []string `json:"infeasibleTrialList,omitempty"`
+
 	// Trials is the total number of trials owned by the experiment.
 	Trials int32 `json:"trials,omitempty"`
 
@@ -126,6 +194,9 @@ type ExperimentStatus struct {
 
 	// How many trials are currently metrics unavailable.
 	TrialMetricsUnavailable int32 `json:"trialMetricsUnavailable,omitempty"`
+
+	// How many trials don't satisfy the objective constraints.
+	TrialsInfeasible int32 `json:"trialsInfeasible,omitempty"`
 }
 
 // OptimalTrial is the metrics and assignments of the best trial.
@@ -170,6 +241,7 @@ const (
 	ExperimentRestarting ExperimentConditionType = "Restarting"
 	ExperimentSucceeded  ExperimentConditionType = "Succeeded"
 	ExperimentFailed     ExperimentConditionType = "Failed"
+	ExperimentSuspended  ExperimentConditionType = "Suspended"
 )
 
 // ResumePolicyType describes how the experiment should be resumed.
@@ -86,6 +86,10 @@ func (exp *Experiment) IsRestarting() bool {
 	return hasCondition(exp, ExperimentRestarting)
 }
 
+func (exp *Experiment) IsSuspended() bool {
+	return hasCondition(exp, ExperimentSuspended)
+}
+
 func (exp *Experiment) IsCompleted() bool {
 	return exp.IsSucceeded() || exp.IsFailed()
 }
@@ -133,6 +137,10 @@ func (exp *Experiment) MarkExperimentStatusCreated(reason, message string) {
 
 func (exp *Experiment) MarkExperimentStatusRunning(reason, message string) {
 	//exp.removeCondition(ExperimentRestarting)
+	currentCond := getCondition(exp, ExperimentSuspended)
+	if currentCond != nil && currentCond.Status == v1.ConditionTrue {
+		exp.setCondition(ExperimentSuspended, v1.ConditionFalse, currentCond.Reason, currentCond.Message)
+	}
 	exp.setCondition(ExperimentRunning, v1.ConditionTrue, reason, message)
 }
 
@@ -142,6 +150,14 @@ func (exp *Experiment) MarkExperimentStatusRestarting(reason, message string) {
 	exp.setCondition(ExperimentRestarting, v1.ConditionTrue, reason, message)
 }
 
+func (exp *Experiment) MarkExperimentStatusSuspended(reason, message string) {
+	currentCond := getCondition(exp, ExperimentRunning)
+	if currentCond != nil {
+		exp.setCondition(ExperimentRunning, v1.ConditionFalse, currentCond.Reason, currentCond.Message)
+	}
+	exp.setCondition(ExperimentSuspended, v1.ConditionTrue, reason, message)
+}
+
 func (exp *Experiment) MarkExperimentStatusSucceeded(reason, message string) {
 	currentCond := getCondition(exp, ExperimentRunning)
 	if currentCond != nil {
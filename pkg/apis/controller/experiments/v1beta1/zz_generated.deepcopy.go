@@ -26,6 +26,42 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BudgetSpec) DeepCopyInto(out *BudgetSpec) {
+	*out = *in
+	if in.MaxDurationSeconds != nil {
+		in, out := &in.MaxDurationSeconds, &out.MaxDurationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxTrialRuntimeSeconds != nil {
+		in, out := &in.MaxTrialRuntimeSeconds, &out.MaxTrialRuntimeSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxCPUHours != nil {
+		in, out := &in.MaxCPUHours, &out.MaxCPUHours
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxGPUHours != nil {
+		in, out := &in.MaxGPUHours, &out.MaxGPUHours
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetSpec.
+func (in *BudgetSpec) DeepCopy() *BudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapSource) DeepCopyInto(out *ConfigMapSource) {
 	*out = *in
@@ -176,6 +212,16 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		*out = new(NasConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(BudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plateau != nil {
+		in, out := &in.Plateau, &out.Plateau
+		*out = new(PlateauSpec)
+		**out = **in
+	}
 	return
 }
 
@@ -204,6 +250,10 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		in, out := &in.LastReconcileTime, &out.LastReconcileTime
 		*out = (*in).DeepCopy()
 	}
+	if in.SuspendTime != nil {
+		in, out := &in.SuspendTime, &out.SuspendTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]ExperimentCondition, len(*in))
@@ -212,6 +262,13 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		}
 	}
 	in.CurrentOptimalTrial.DeepCopyInto(&out.CurrentOptimalTrial)
+	if in.ParetoOptimalTrials != nil {
+		in, out := &in.ParetoOptimalTrials, &out.ParetoOptimalTrials
+		*out = make([]OptimalTrial, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RunningTrialList != nil {
 		in, out := &in.RunningTrialList, &out.RunningTrialList
 		*out = make([]string, len(*in))
@@ -247,6 +304,11 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.InfeasibleTrialList != nil {
+		in, out := &in.InfeasibleTrialList, &out.InfeasibleTrialList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -398,6 +460,22 @@ func (in *ParameterSpec) DeepCopy() *ParameterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlateauSpec) DeepCopyInto(out *PlateauSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlateauSpec.
+func (in *PlateauSpec) DeepCopy() *PlateauSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlateauSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrialParameterSpec) DeepCopyInto(out *TrialParameterSpec) {
 	*out = *in
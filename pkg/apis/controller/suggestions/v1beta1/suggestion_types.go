@@ -39,6 +39,10 @@ type SuggestionSpec struct {
 	// ResumePolicy describes resuming policy which usually take effect after experiment terminated.
 	// Default value is LongRunning.
 	ResumePolicy experiment.ResumePolicyType `json:"resumePolicy,omitempty"`
+
+	// Suspend indicates that the experiment is suspended.
+	// Suggestion deployment is scaled to zero while suggestion is suspended.
+	Suspend bool `json:"suspend,omitempty"`
 }
 
 // SuggestionStatus is the current status of a Suggestion.
@@ -43,6 +43,12 @@ type TrialSpec struct {
 	// Whether to retain the trial run object after completed.
 	RetainRun bool `json:"retainRun,omitempty"`
 
+	// Suspend indicates that the trial run object is suspended together with the experiment.
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Describes how the trial run object is suspended, the run object is suspended or deleted.
+	SuspendPolicy common.SuspendPolicyType `json:"suspendPolicy,omitempty"`
+
 	// Describes how metrics will be collected
 	MetricsCollector common.MetricsCollectorSpec `json:"metricsCollector,omitempty"`
 
@@ -83,6 +89,14 @@ type TrialStatus struct {
 	// It is represented in RFC3339 form and is in UTC.
 	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
 
+	// Represents time when the Trial was suspended. It is cleared once the Trial is resumed.
+	// It is represented in RFC3339 form and is in UTC.
+	SuspendTime *metav1.Time `json:"suspendTime,omitempty"`
+
+	// Total time in seconds which the Trial was suspended before it was resumed last time.
+	// Suspended time is not counted in the Experiment budget.
+	SuspendedSeconds int64 `json:"suspendedSeconds,omitempty"`
+
 	// List of observed runtime conditions for this Trial.
 	Conditions []TrialCondition `json:"conditions,omitempty"`
 
@@ -170,6 +170,10 @@ func (in *TrialStatus) DeepCopyInto(out *TrialStatus) {
 		in, out := &in.LastReconcileTime, &out.LastReconcileTime
 		*out = (*in).DeepCopy()
 	}
+	if in.SuspendTime != nil {
+		in, out := &in.SuspendTime, &out.SuspendTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]TrialCondition, len(*in))
@@ -13,6 +13,8 @@ It has these top-level messages:
 	ParameterSpec
 	FeasibleSpace
 	ObjectiveSpec
+	AdditionalObjective
+	ObjectiveConstraint
 	AlgorithmSpec
 	AlgorithmSetting
 	EarlyStoppingSpec
@@ -32,6 +34,12 @@ It has these top-level messages:
 	MetricLog
 	GetObservationLogRequest
 	GetObservationLogReply
+	GetObservationLogsRequest
+	GetObservationLogsReply
+	TrialObservationLog
+	GetObservationSummaryRequest
+	GetObservationSummaryReply
+	MetricSummary
 	DeleteObservationLogRequest
 	DeleteObservationLogReply
 	GetSuggestionsRequest
@@ -51,6 +59,7 @@ package api_v1_beta1
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import google_protobuf "google.golang.org/protobuf/types/known/wrapperspb"
 
 import (
 	context "golang.org/x/net/context"
@@ -153,6 +162,36 @@ func (x ComparisonType) String() string {
 }
 func (ComparisonType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
+type EarlyStoppingStrategy int32
+
+const (
+	EarlyStoppingStrategy_UNKNOWN_STRATEGY EarlyStoppingStrategy = 0
+	EarlyStoppingStrategy_MIN              EarlyStoppingStrategy = 1
+	EarlyStoppingStrategy_MAX              EarlyStoppingStrategy = 2
+	EarlyStoppingStrategy_LATEST           EarlyStoppingStrategy = 3
+	EarlyStoppingStrategy_MOVING_AVERAGE   EarlyStoppingStrategy = 4
+)
+
+var EarlyStoppingStrategy_name = map[int32]string{
+	0: "UNKNOWN_STRATEGY",
+	1: "MIN",
+	2: "MAX",
+	3: "LATEST",
+	4: "MOVING_AVERAGE",
+}
+var EarlyStoppingStrategy_value = map[string]int32{
+	"UNKNOWN_STRATEGY": 0,
+	"MIN":              1,
+	"MAX":              2,
+	"LATEST":           3,
+	"MOVING_AVERAGE":   4,
+}
+
+func (x EarlyStoppingStrategy) String() string {
+	return proto.EnumName(EarlyStoppingStrategy_name, int32(x))
+}
+func (EarlyStoppingStrategy) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
 // Trial can be in one of 8 conditions.
 // TODO (andreyvelich): Remove unused conditions.
 type TrialStatus_TrialConditionType int32
@@ -193,7 +232,7 @@ func (x TrialStatus_TrialConditionType) String() string {
 	return proto.EnumName(TrialStatus_TrialConditionType_name, int32(x))
 }
 func (TrialStatus_TrialConditionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{15, 0}
+	return fileDescriptor0, []int{17, 0}
 }
 
 // *
@@ -398,6 +437,12 @@ type ObjectiveSpec struct {
 	// List of additional metrics to record from Trial.
 	// This can be empty if we only care about the objective metric.
 	AdditionalMetricNames []string `protobuf:"bytes,4,rep,name=additional_metric_names,json=additionalMetricNames" json:"additional_metric_names,omitempty"`
+	// List of additional objectives for the multi-objective optimization.
+	// Objective metric names are also in the additional metric names.
+	AdditionalObjectives []*AdditionalObjective `protobuf:"bytes,5,rep,name=additional_objectives,json=additionalObjectives" json:"additional_objectives,omitempty"`
+	// List of outcome constraints which Trial metrics must satisfy.
+	// Constraint metric names are also in the additional metric names.
+	Constraints []*ObjectiveConstraint `protobuf:"bytes,6,rep,name=constraints" json:"constraints,omitempty"`
 }
 
 func (m *ObjectiveSpec) Reset()                    { *m = ObjectiveSpec{} }
@@ -433,6 +478,88 @@ func (m *ObjectiveSpec) GetAdditionalMetricNames() []string {
 	return nil
 }
 
+func (m *ObjectiveSpec) GetAdditionalObjectives() []*AdditionalObjective {
+	if m != nil {
+		return m.AdditionalObjectives
+	}
+	return nil
+}
+
+func (m *ObjectiveSpec) GetConstraints() []*ObjectiveConstraint {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+// *
+// Additional objective of the multi-objective optimization.
+type AdditionalObjective struct {
+	Type                ObjectiveType                `protobuf:"varint,1,opt,name=type,enum=api.v1.beta1.ObjectiveType" json:"type,omitempty"`
+	Goal                *google_protobuf.DoubleValue `protobuf:"bytes,2,opt,name=goal" json:"goal,omitempty"`
+	ObjectiveMetricName string                       `protobuf:"bytes,3,opt,name=objective_metric_name,json=objectiveMetricName" json:"objective_metric_name,omitempty"`
+}
+
+func (m *AdditionalObjective) Reset()                    { *m = AdditionalObjective{} }
+func (m *AdditionalObjective) String() string            { return proto.CompactTextString(m) }
+func (*AdditionalObjective) ProtoMessage()               {}
+func (*AdditionalObjective) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *AdditionalObjective) GetType() ObjectiveType {
+	if m != nil {
+		return m.Type
+	}
+	return ObjectiveType_UNKNOWN
+}
+
+func (m *AdditionalObjective) GetGoal() *google_protobuf.DoubleValue {
+	if m != nil {
+		return m.Goal
+	}
+	return nil
+}
+
+func (m *AdditionalObjective) GetObjectiveMetricName() string {
+	if m != nil {
+		return m.ObjectiveMetricName
+	}
+	return ""
+}
+
+// *
+// Outcome constraint of the optimization.
+type ObjectiveConstraint struct {
+	MetricName string         `protobuf:"bytes,1,opt,name=metric_name,json=metricName" json:"metric_name,omitempty"`
+	Value      string         `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	Comparison ComparisonType `protobuf:"varint,3,opt,name=comparison,enum=api.v1.beta1.ComparisonType" json:"comparison,omitempty"`
+}
+
+func (m *ObjectiveConstraint) Reset()                    { *m = ObjectiveConstraint{} }
+func (m *ObjectiveConstraint) String() string            { return proto.CompactTextString(m) }
+func (*ObjectiveConstraint) ProtoMessage()               {}
+func (*ObjectiveConstraint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ObjectiveConstraint) GetMetricName() string {
+	if m != nil {
+		return m.MetricName
+	}
+	return ""
+}
+
+func (m *ObjectiveConstraint) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ObjectiveConstraint) GetComparison() ComparisonType {
+	if m != nil {
+		return m.Comparison
+	}
+	return ComparisonType_UNKNOWN_COMPARISON
+}
+
 // *
 // HP or NAS algorithm specification.
 type AlgorithmSpec struct {
@@ -443,7 +570,7 @@ type AlgorithmSpec struct {
 func (m *AlgorithmSpec) Reset()                    { *m = AlgorithmSpec{} }
 func (m *AlgorithmSpec) String() string            { return proto.CompactTextString(m) }
 func (*AlgorithmSpec) ProtoMessage()               {}
-func (*AlgorithmSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*AlgorithmSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *AlgorithmSpec) GetAlgorithmName() string {
 	if m != nil {
@@ -469,7 +596,7 @@ type AlgorithmSetting struct {
 func (m *AlgorithmSetting) Reset()                    { *m = AlgorithmSetting{} }
 func (m *AlgorithmSetting) String() string            { return proto.CompactTextString(m) }
 func (*AlgorithmSetting) ProtoMessage()               {}
-func (*AlgorithmSetting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*AlgorithmSetting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *AlgorithmSetting) GetName() string {
 	if m != nil {
@@ -495,7 +622,7 @@ type EarlyStoppingSpec struct {
 func (m *EarlyStoppingSpec) Reset()                    { *m = EarlyStoppingSpec{} }
 func (m *EarlyStoppingSpec) String() string            { return proto.CompactTextString(m) }
 func (*EarlyStoppingSpec) ProtoMessage()               {}
-func (*EarlyStoppingSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*EarlyStoppingSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *EarlyStoppingSpec) GetAlgorithmName() string {
 	if m != nil {
@@ -521,7 +648,7 @@ type EarlyStoppingSetting struct {
 func (m *EarlyStoppingSetting) Reset()                    { *m = EarlyStoppingSetting{} }
 func (m *EarlyStoppingSetting) String() string            { return proto.CompactTextString(m) }
 func (*EarlyStoppingSetting) ProtoMessage()               {}
-func (*EarlyStoppingSetting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*EarlyStoppingSetting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *EarlyStoppingSetting) GetName() string {
 	if m != nil {
@@ -547,7 +674,7 @@ type NasConfig struct {
 func (m *NasConfig) Reset()                    { *m = NasConfig{} }
 func (m *NasConfig) String() string            { return proto.CompactTextString(m) }
 func (*NasConfig) ProtoMessage()               {}
-func (*NasConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*NasConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *NasConfig) GetGraphConfig() *GraphConfig {
 	if m != nil {
@@ -570,7 +697,7 @@ type NasConfig_Operations struct {
 func (m *NasConfig_Operations) Reset()                    { *m = NasConfig_Operations{} }
 func (m *NasConfig_Operations) String() string            { return proto.CompactTextString(m) }
 func (*NasConfig_Operations) ProtoMessage()               {}
-func (*NasConfig_Operations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9, 0} }
+func (*NasConfig_Operations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11, 0} }
 
 func (m *NasConfig_Operations) GetOperation() []*Operation {
 	if m != nil {
@@ -590,7 +717,7 @@ type GraphConfig struct {
 func (m *GraphConfig) Reset()                    { *m = GraphConfig{} }
 func (m *GraphConfig) String() string            { return proto.CompactTextString(m) }
 func (*GraphConfig) ProtoMessage()               {}
-func (*GraphConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*GraphConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *GraphConfig) GetNumLayers() int32 {
 	if m != nil {
@@ -623,7 +750,7 @@ type Operation struct {
 func (m *Operation) Reset()                    { *m = Operation{} }
 func (m *Operation) String() string            { return proto.CompactTextString(m) }
 func (*Operation) ProtoMessage()               {}
-func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *Operation) GetOperationType() string {
 	if m != nil {
@@ -648,7 +775,7 @@ type Operation_ParameterSpecs struct {
 func (m *Operation_ParameterSpecs) Reset()                    { *m = Operation_ParameterSpecs{} }
 func (m *Operation_ParameterSpecs) String() string            { return proto.CompactTextString(m) }
 func (*Operation_ParameterSpecs) ProtoMessage()               {}
-func (*Operation_ParameterSpecs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11, 0} }
+func (*Operation_ParameterSpecs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13, 0} }
 
 func (m *Operation_ParameterSpecs) GetParameters() []*ParameterSpec {
 	if m != nil {
@@ -668,7 +795,7 @@ type Trial struct {
 func (m *Trial) Reset()                    { *m = Trial{} }
 func (m *Trial) String() string            { return proto.CompactTextString(m) }
 func (*Trial) ProtoMessage()               {}
-func (*Trial) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*Trial) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *Trial) GetName() string {
 	if m != nil {
@@ -702,7 +829,7 @@ type TrialSpec struct {
 func (m *TrialSpec) Reset()                    { *m = TrialSpec{} }
 func (m *TrialSpec) String() string            { return proto.CompactTextString(m) }
 func (*TrialSpec) ProtoMessage()               {}
-func (*TrialSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*TrialSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *TrialSpec) GetObjective() *ObjectiveSpec {
 	if m != nil {
@@ -735,7 +862,7 @@ func (m *TrialSpec_ParameterAssignments) Reset()         { *m = TrialSpec_Parame
 func (m *TrialSpec_ParameterAssignments) String() string { return proto.CompactTextString(m) }
 func (*TrialSpec_ParameterAssignments) ProtoMessage()    {}
 func (*TrialSpec_ParameterAssignments) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{13, 0}
+	return fileDescriptor0, []int{15, 0}
 }
 
 func (m *TrialSpec_ParameterAssignments) GetAssignments() []*ParameterAssignment {
@@ -753,7 +880,7 @@ type ParameterAssignment struct {
 func (m *ParameterAssignment) Reset()                    { *m = ParameterAssignment{} }
 func (m *ParameterAssignment) String() string            { return proto.CompactTextString(m) }
 func (*ParameterAssignment) ProtoMessage()               {}
-func (*ParameterAssignment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*ParameterAssignment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *ParameterAssignment) GetName() string {
 	if m != nil {
@@ -781,7 +908,7 @@ type TrialStatus struct {
 func (m *TrialStatus) Reset()                    { *m = TrialStatus{} }
 func (m *TrialStatus) String() string            { return proto.CompactTextString(m) }
 func (*TrialStatus) ProtoMessage()               {}
-func (*TrialStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*TrialStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *TrialStatus) GetStartTime() string {
 	if m != nil {
@@ -818,7 +945,7 @@ type Observation struct {
 func (m *Observation) Reset()                    { *m = Observation{} }
 func (m *Observation) String() string            { return proto.CompactTextString(m) }
 func (*Observation) ProtoMessage()               {}
-func (*Observation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*Observation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *Observation) GetMetrics() []*Metric {
 	if m != nil {
@@ -835,7 +962,7 @@ type Metric struct {
 func (m *Metric) Reset()                    { *m = Metric{} }
 func (m *Metric) String() string            { return proto.CompactTextString(m) }
 func (*Metric) ProtoMessage()               {}
-func (*Metric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*Metric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *Metric) GetName() string {
 	if m != nil {
@@ -854,12 +981,22 @@ func (m *Metric) GetValue() string {
 type ReportObservationLogRequest struct {
 	TrialName      string          `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
 	ObservationLog *ObservationLog `protobuf:"bytes,2,opt,name=observation_log,json=observationLog" json:"observation_log,omitempty"`
+	Namespace      string          `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
+	ExperimentName string          `protobuf:"bytes,4,opt,name=experiment_name,json=experimentName" json:"experiment_name,omitempty"`
+	TrialUid       string          `protobuf:"bytes,5,opt,name=trial_uid,json=trialUid" json:"trial_uid,omitempty"`
+	// / Position of the reported logs in the metrics source, e.g. the metrics file offset after the last reported line.
+	// / If it is set, logs are registered only if the offset is greater than the offset of the previous report of the Trial
+	// / from the same source, so the same report can be sent again after the metrics collector is restarted.
+	SourceOffset int64 `protobuf:"varint,6,opt,name=source_offset,json=sourceOffset" json:"source_offset,omitempty"`
+	// / Source of the source offset, e.g. the Trial pod name. Offset of another source replaces the previous offset of the Trial,
+	// / since metrics of the recreated pod start from the beginning.
+	Source string `protobuf:"bytes,7,opt,name=source" json:"source,omitempty"`
 }
 
 func (m *ReportObservationLogRequest) Reset()                    { *m = ReportObservationLogRequest{} }
 func (m *ReportObservationLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReportObservationLogRequest) ProtoMessage()               {}
-func (*ReportObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*ReportObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *ReportObservationLogRequest) GetTrialName() string {
 	if m != nil {
@@ -875,13 +1012,48 @@ func (m *ReportObservationLogRequest) GetObservationLog() *ObservationLog {
 	return nil
 }
 
+func (m *ReportObservationLogRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ReportObservationLogRequest) GetExperimentName() string {
+	if m != nil {
+		return m.ExperimentName
+	}
+	return ""
+}
+
+func (m *ReportObservationLogRequest) GetTrialUid() string {
+	if m != nil {
+		return m.TrialUid
+	}
+	return ""
+}
+
+func (m *ReportObservationLogRequest) GetSourceOffset() int64 {
+	if m != nil {
+		return m.SourceOffset
+	}
+	return 0
+}
+
+func (m *ReportObservationLogRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 type ReportObservationLogReply struct {
 }
 
 func (m *ReportObservationLogReply) Reset()                    { *m = ReportObservationLogReply{} }
 func (m *ReportObservationLogReply) String() string            { return proto.CompactTextString(m) }
 func (*ReportObservationLogReply) ProtoMessage()               {}
-func (*ReportObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*ReportObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 type ObservationLog struct {
 	MetricLogs []*MetricLog `protobuf:"bytes,1,rep,name=metric_logs,json=metricLogs" json:"metric_logs,omitempty"`
@@ -890,7 +1062,7 @@ type ObservationLog struct {
 func (m *ObservationLog) Reset()                    { *m = ObservationLog{} }
 func (m *ObservationLog) String() string            { return proto.CompactTextString(m) }
 func (*ObservationLog) ProtoMessage()               {}
-func (*ObservationLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*ObservationLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *ObservationLog) GetMetricLogs() []*MetricLog {
 	if m != nil {
@@ -902,12 +1074,13 @@ func (m *ObservationLog) GetMetricLogs() []*MetricLog {
 type MetricLog struct {
 	TimeStamp string  `protobuf:"bytes,1,opt,name=time_stamp,json=timeStamp" json:"time_stamp,omitempty"`
 	Metric    *Metric `protobuf:"bytes,2,opt,name=metric" json:"metric,omitempty"`
+	Step      string  `protobuf:"bytes,3,opt,name=step" json:"step,omitempty"`
 }
 
 func (m *MetricLog) Reset()                    { *m = MetricLog{} }
 func (m *MetricLog) String() string            { return proto.CompactTextString(m) }
 func (*MetricLog) ProtoMessage()               {}
-func (*MetricLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*MetricLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *MetricLog) GetTimeStamp() string {
 	if m != nil {
@@ -923,17 +1096,28 @@ func (m *MetricLog) GetMetric() *Metric {
 	return nil
 }
 
+func (m *MetricLog) GetStep() string {
+	if m != nil {
+		return m.Step
+	}
+	return ""
+}
+
 type GetObservationLogRequest struct {
-	TrialName  string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
-	MetricName string `protobuf:"bytes,2,opt,name=metric_name,json=metricName" json:"metric_name,omitempty"`
-	StartTime  string `protobuf:"bytes,3,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
-	EndTime    string `protobuf:"bytes,4,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
+	TrialName   string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	MetricName  string `protobuf:"bytes,2,opt,name=metric_name,json=metricName" json:"metric_name,omitempty"`
+	StartTime   string `protobuf:"bytes,3,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	EndTime     string `protobuf:"bytes,4,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
+	Namespace   string `protobuf:"bytes,5,opt,name=namespace" json:"namespace,omitempty"`
+	StartStep   string `protobuf:"bytes,6,opt,name=start_step,json=startStep" json:"start_step,omitempty"`
+	EndStep     string `protobuf:"bytes,7,opt,name=end_step,json=endStep" json:"end_step,omitempty"`
+	OrderByStep bool   `protobuf:"varint,8,opt,name=order_by_step,json=orderByStep" json:"order_by_step,omitempty"`
 }
 
 func (m *GetObservationLogRequest) Reset()                    { *m = GetObservationLogRequest{} }
 func (m *GetObservationLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetObservationLogRequest) ProtoMessage()               {}
-func (*GetObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*GetObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *GetObservationLogRequest) GetTrialName() string {
 	if m != nil {
@@ -963,6 +1147,34 @@ func (m *GetObservationLogRequest) GetEndTime() string {
 	return ""
 }
 
+func (m *GetObservationLogRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetObservationLogRequest) GetStartStep() string {
+	if m != nil {
+		return m.StartStep
+	}
+	return ""
+}
+
+func (m *GetObservationLogRequest) GetEndStep() string {
+	if m != nil {
+		return m.EndStep
+	}
+	return ""
+}
+
+func (m *GetObservationLogRequest) GetOrderByStep() bool {
+	if m != nil {
+		return m.OrderByStep
+	}
+	return false
+}
+
 type GetObservationLogReply struct {
 	ObservationLog *ObservationLog `protobuf:"bytes,1,opt,name=observation_log,json=observationLog" json:"observation_log,omitempty"`
 }
@@ -970,7 +1182,7 @@ type GetObservationLogReply struct {
 func (m *GetObservationLogReply) Reset()                    { *m = GetObservationLogReply{} }
 func (m *GetObservationLogReply) String() string            { return proto.CompactTextString(m) }
 func (*GetObservationLogReply) ProtoMessage()               {}
-func (*GetObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*GetObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *GetObservationLogReply) GetObservationLog() *ObservationLog {
 	if m != nil {
@@ -979,14 +1191,225 @@ func (m *GetObservationLogReply) GetObservationLog() *ObservationLog {
 	return nil
 }
 
+type GetObservationLogsRequest struct {
+	TrialNames  []string `protobuf:"bytes,1,rep,name=trial_names,json=trialNames" json:"trial_names,omitempty"`
+	MetricNames []string `protobuf:"bytes,2,rep,name=metric_names,json=metricNames" json:"metric_names,omitempty"`
+	StartTime   string   `protobuf:"bytes,3,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	EndTime     string   `protobuf:"bytes,4,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
+	Namespace   string   `protobuf:"bytes,5,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *GetObservationLogsRequest) Reset()                    { *m = GetObservationLogsRequest{} }
+func (m *GetObservationLogsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetObservationLogsRequest) ProtoMessage()               {}
+func (*GetObservationLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *GetObservationLogsRequest) GetTrialNames() []string {
+	if m != nil {
+		return m.TrialNames
+	}
+	return nil
+}
+
+func (m *GetObservationLogsRequest) GetMetricNames() []string {
+	if m != nil {
+		return m.MetricNames
+	}
+	return nil
+}
+
+func (m *GetObservationLogsRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetObservationLogsRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetObservationLogsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type GetObservationLogsReply struct {
+	TrialObservationLogs []*TrialObservationLog `protobuf:"bytes,1,rep,name=trial_observation_logs,json=trialObservationLogs" json:"trial_observation_logs,omitempty"`
+}
+
+func (m *GetObservationLogsReply) Reset()                    { *m = GetObservationLogsReply{} }
+func (m *GetObservationLogsReply) String() string            { return proto.CompactTextString(m) }
+func (*GetObservationLogsReply) ProtoMessage()               {}
+func (*GetObservationLogsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *GetObservationLogsReply) GetTrialObservationLogs() []*TrialObservationLog {
+	if m != nil {
+		return m.TrialObservationLogs
+	}
+	return nil
+}
+
+type TrialObservationLog struct {
+	TrialName      string          `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	ObservationLog *ObservationLog `protobuf:"bytes,2,opt,name=observation_log,json=observationLog" json:"observation_log,omitempty"`
+}
+
+func (m *TrialObservationLog) Reset()                    { *m = TrialObservationLog{} }
+func (m *TrialObservationLog) String() string            { return proto.CompactTextString(m) }
+func (*TrialObservationLog) ProtoMessage()               {}
+func (*TrialObservationLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *TrialObservationLog) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
+func (m *TrialObservationLog) GetObservationLog() *ObservationLog {
+	if m != nil {
+		return m.ObservationLog
+	}
+	return nil
+}
+
+type GetObservationSummaryRequest struct {
+	TrialName   string   `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	MetricNames []string `protobuf:"bytes,2,rep,name=metric_names,json=metricNames" json:"metric_names,omitempty"`
+	Namespace   string   `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *GetObservationSummaryRequest) Reset()                    { *m = GetObservationSummaryRequest{} }
+func (m *GetObservationSummaryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetObservationSummaryRequest) ProtoMessage()               {}
+func (*GetObservationSummaryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *GetObservationSummaryRequest) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
+func (m *GetObservationSummaryRequest) GetMetricNames() []string {
+	if m != nil {
+		return m.MetricNames
+	}
+	return nil
+}
+
+func (m *GetObservationSummaryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type GetObservationSummaryReply struct {
+	MetricSummaries []*MetricSummary `protobuf:"bytes,1,rep,name=metric_summaries,json=metricSummaries" json:"metric_summaries,omitempty"`
+}
+
+func (m *GetObservationSummaryReply) Reset()                    { *m = GetObservationSummaryReply{} }
+func (m *GetObservationSummaryReply) String() string            { return proto.CompactTextString(m) }
+func (*GetObservationSummaryReply) ProtoMessage()               {}
+func (*GetObservationSummaryReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *GetObservationSummaryReply) GetMetricSummaries() []*MetricSummary {
+	if m != nil {
+		return m.MetricSummaries
+	}
+	return nil
+}
+
+// *
+// Aggregated Observations of a single metric.
+type MetricSummary struct {
+	MetricName     string `protobuf:"bytes,1,opt,name=metric_name,json=metricName" json:"metric_name,omitempty"`
+	Min            string `protobuf:"bytes,2,opt,name=min" json:"min,omitempty"`
+	Max            string `protobuf:"bytes,3,opt,name=max" json:"max,omitempty"`
+	Latest         string `protobuf:"bytes,4,opt,name=latest" json:"latest,omitempty"`
+	Count          int64  `protobuf:"varint,5,opt,name=count" json:"count,omitempty"`
+	FirstTimeStamp string `protobuf:"bytes,6,opt,name=first_time_stamp,json=firstTimeStamp" json:"first_time_stamp,omitempty"`
+	LastTimeStamp  string `protobuf:"bytes,7,opt,name=last_time_stamp,json=lastTimeStamp" json:"last_time_stamp,omitempty"`
+	LatestStep     string `protobuf:"bytes,8,opt,name=latest_step,json=latestStep" json:"latest_step,omitempty"`
+}
+
+func (m *MetricSummary) Reset()                    { *m = MetricSummary{} }
+func (m *MetricSummary) String() string            { return proto.CompactTextString(m) }
+func (*MetricSummary) ProtoMessage()               {}
+func (*MetricSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *MetricSummary) GetMetricName() string {
+	if m != nil {
+		return m.MetricName
+	}
+	return ""
+}
+
+func (m *MetricSummary) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *MetricSummary) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *MetricSummary) GetLatest() string {
+	if m != nil {
+		return m.Latest
+	}
+	return ""
+}
+
+func (m *MetricSummary) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MetricSummary) GetFirstTimeStamp() string {
+	if m != nil {
+		return m.FirstTimeStamp
+	}
+	return ""
+}
+
+func (m *MetricSummary) GetLastTimeStamp() string {
+	if m != nil {
+		return m.LastTimeStamp
+	}
+	return ""
+}
+
+func (m *MetricSummary) GetLatestStep() string {
+	if m != nil {
+		return m.LatestStep
+	}
+	return ""
+}
+
 type DeleteObservationLogRequest struct {
 	TrialName string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
 }
 
 func (m *DeleteObservationLogRequest) Reset()                    { *m = DeleteObservationLogRequest{} }
 func (m *DeleteObservationLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObservationLogRequest) ProtoMessage()               {}
-func (*DeleteObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*DeleteObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *DeleteObservationLogRequest) GetTrialName() string {
 	if m != nil {
@@ -995,13 +1418,20 @@ func (m *DeleteObservationLogRequest) GetTrialName() string {
 	return ""
 }
 
+func (m *DeleteObservationLogRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type DeleteObservationLogReply struct {
 }
 
 func (m *DeleteObservationLogReply) Reset()                    { *m = DeleteObservationLogReply{} }
 func (m *DeleteObservationLogReply) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObservationLogReply) ProtoMessage()               {}
-func (*DeleteObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*DeleteObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 type GetSuggestionsRequest struct {
 	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty"`
@@ -1018,7 +1448,7 @@ type GetSuggestionsRequest struct {
 func (m *GetSuggestionsRequest) Reset()                    { *m = GetSuggestionsRequest{} }
 func (m *GetSuggestionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetSuggestionsRequest) ProtoMessage()               {}
-func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *GetSuggestionsRequest) GetExperiment() *Experiment {
 	if m != nil {
@@ -1064,7 +1494,7 @@ type GetSuggestionsReply struct {
 func (m *GetSuggestionsReply) Reset()                    { *m = GetSuggestionsReply{} }
 func (m *GetSuggestionsReply) String() string            { return proto.CompactTextString(m) }
 func (*GetSuggestionsReply) ProtoMessage()               {}
-func (*GetSuggestionsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*GetSuggestionsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *GetSuggestionsReply) GetParameterAssignments() []*GetSuggestionsReply_ParameterAssignments {
 	if m != nil {
@@ -1101,7 +1531,7 @@ func (m *GetSuggestionsReply_ParameterAssignments) Reset() {
 func (m *GetSuggestionsReply_ParameterAssignments) String() string { return proto.CompactTextString(m) }
 func (*GetSuggestionsReply_ParameterAssignments) ProtoMessage()    {}
 func (*GetSuggestionsReply_ParameterAssignments) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{27, 0}
+	return fileDescriptor0, []int{35, 0}
 }
 
 func (m *GetSuggestionsReply_ParameterAssignments) GetAssignments() []*ParameterAssignment {
@@ -1133,7 +1563,7 @@ func (m *ValidateAlgorithmSettingsRequest) Reset()         { *m = ValidateAlgori
 func (m *ValidateAlgorithmSettingsRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateAlgorithmSettingsRequest) ProtoMessage()    {}
 func (*ValidateAlgorithmSettingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{28}
+	return fileDescriptor0, []int{36}
 }
 
 func (m *ValidateAlgorithmSettingsRequest) GetExperiment() *Experiment {
@@ -1151,7 +1581,7 @@ type ValidateAlgorithmSettingsReply struct {
 func (m *ValidateAlgorithmSettingsReply) Reset()                    { *m = ValidateAlgorithmSettingsReply{} }
 func (m *ValidateAlgorithmSettingsReply) String() string            { return proto.CompactTextString(m) }
 func (*ValidateAlgorithmSettingsReply) ProtoMessage()               {}
-func (*ValidateAlgorithmSettingsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*ValidateAlgorithmSettingsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 type GetEarlyStoppingRulesRequest struct {
 	Experiment       *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty"`
@@ -1162,7 +1592,7 @@ type GetEarlyStoppingRulesRequest struct {
 func (m *GetEarlyStoppingRulesRequest) Reset()                    { *m = GetEarlyStoppingRulesRequest{} }
 func (m *GetEarlyStoppingRulesRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetEarlyStoppingRulesRequest) ProtoMessage()               {}
-func (*GetEarlyStoppingRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*GetEarlyStoppingRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *GetEarlyStoppingRulesRequest) GetExperiment() *Experiment {
 	if m != nil {
@@ -1192,7 +1622,7 @@ type GetEarlyStoppingRulesReply struct {
 func (m *GetEarlyStoppingRulesReply) Reset()                    { *m = GetEarlyStoppingRulesReply{} }
 func (m *GetEarlyStoppingRulesReply) String() string            { return proto.CompactTextString(m) }
 func (*GetEarlyStoppingRulesReply) ProtoMessage()               {}
-func (*GetEarlyStoppingRulesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*GetEarlyStoppingRulesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *GetEarlyStoppingRulesReply) GetEarlyStoppingRules() []*EarlyStoppingRule {
 	if m != nil {
@@ -1210,12 +1640,17 @@ type EarlyStoppingRule struct {
 	// Defines quantity of intermediate results that should be received before applying the rule.
 	// If start step is empty, rule is applied from the first recorded metric.
 	StartStep int32 `protobuf:"varint,4,opt,name=start_step,json=startStep" json:"start_step,omitempty"`
+	// Defines how the reported metric values are aggregated before comparison with the rule value.
+	// If strategy is unknown, the best value is used for the objective metric and the latest value for other metrics.
+	Strategy EarlyStoppingStrategy `protobuf:"varint,5,opt,name=strategy,enum=api.v1.beta1.EarlyStoppingStrategy" json:"strategy,omitempty"`
+	// Defines quantity of the latest reported values that are averaged by the moving average strategy.
+	WindowSize int32 `protobuf:"varint,6,opt,name=window_size,json=windowSize" json:"window_size,omitempty"`
 }
 
 func (m *EarlyStoppingRule) Reset()                    { *m = EarlyStoppingRule{} }
 func (m *EarlyStoppingRule) String() string            { return proto.CompactTextString(m) }
 func (*EarlyStoppingRule) ProtoMessage()               {}
-func (*EarlyStoppingRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*EarlyStoppingRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *EarlyStoppingRule) GetName() string {
 	if m != nil {
@@ -1245,6 +1680,20 @@ func (m *EarlyStoppingRule) GetStartStep() int32 {
 	return 0
 }
 
+func (m *EarlyStoppingRule) GetStrategy() EarlyStoppingStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return EarlyStoppingStrategy_UNKNOWN_STRATEGY
+}
+
+func (m *EarlyStoppingRule) GetWindowSize() int32 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
 type ValidateEarlyStoppingSettingsRequest struct {
 	EarlyStopping *EarlyStoppingSpec `protobuf:"bytes,1,opt,name=early_stopping,json=earlyStopping" json:"early_stopping,omitempty"`
 }
@@ -1253,7 +1702,7 @@ func (m *ValidateEarlyStoppingSettingsRequest) Reset()         { *m = ValidateEa
 func (m *ValidateEarlyStoppingSettingsRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateEarlyStoppingSettingsRequest) ProtoMessage()    {}
 func (*ValidateEarlyStoppingSettingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{33}
+	return fileDescriptor0, []int{41}
 }
 
 func (m *ValidateEarlyStoppingSettingsRequest) GetEarlyStopping() *EarlyStoppingSpec {
@@ -1272,7 +1721,7 @@ func (m *ValidateEarlyStoppingSettingsReply) Reset()         { *m = ValidateEarl
 func (m *ValidateEarlyStoppingSettingsReply) String() string { return proto.CompactTextString(m) }
 func (*ValidateEarlyStoppingSettingsReply) ProtoMessage()    {}
 func (*ValidateEarlyStoppingSettingsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34}
+	return fileDescriptor0, []int{42}
 }
 
 type SetTrialStatusRequest struct {
@@ -1282,7 +1731,7 @@ type SetTrialStatusRequest struct {
 func (m *SetTrialStatusRequest) Reset()                    { *m = SetTrialStatusRequest{} }
 func (m *SetTrialStatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetTrialStatusRequest) ProtoMessage()               {}
-func (*SetTrialStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*SetTrialStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *SetTrialStatusRequest) GetTrialName() string {
 	if m != nil {
@@ -1297,7 +1746,7 @@ type SetTrialStatusReply struct {
 func (m *SetTrialStatusReply) Reset()                    { *m = SetTrialStatusReply{} }
 func (m *SetTrialStatusReply) String() string            { return proto.CompactTextString(m) }
 func (*SetTrialStatusReply) ProtoMessage()               {}
-func (*SetTrialStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*SetTrialStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func init() {
 	proto.RegisterType((*Experiment)(nil), "api.v1.beta1.Experiment")
@@ -1306,6 +1755,8 @@ func init() {
 	proto.RegisterType((*ParameterSpec)(nil), "api.v1.beta1.ParameterSpec")
 	proto.RegisterType((*FeasibleSpace)(nil), "api.v1.beta1.FeasibleSpace")
 	proto.RegisterType((*ObjectiveSpec)(nil), "api.v1.beta1.ObjectiveSpec")
+	proto.RegisterType((*AdditionalObjective)(nil), "api.v1.beta1.AdditionalObjective")
+	proto.RegisterType((*ObjectiveConstraint)(nil), "api.v1.beta1.ObjectiveConstraint")
 	proto.RegisterType((*AlgorithmSpec)(nil), "api.v1.beta1.AlgorithmSpec")
 	proto.RegisterType((*AlgorithmSetting)(nil), "api.v1.beta1.AlgorithmSetting")
 	proto.RegisterType((*EarlyStoppingSpec)(nil), "api.v1.beta1.EarlyStoppingSpec")
@@ -1328,6 +1779,12 @@ func init() {
 	proto.RegisterType((*MetricLog)(nil), "api.v1.beta1.MetricLog")
 	proto.RegisterType((*GetObservationLogRequest)(nil), "api.v1.beta1.GetObservationLogRequest")
 	proto.RegisterType((*GetObservationLogReply)(nil), "api.v1.beta1.GetObservationLogReply")
+	proto.RegisterType((*GetObservationLogsRequest)(nil), "api.v1.beta1.GetObservationLogsRequest")
+	proto.RegisterType((*GetObservationLogsReply)(nil), "api.v1.beta1.GetObservationLogsReply")
+	proto.RegisterType((*TrialObservationLog)(nil), "api.v1.beta1.TrialObservationLog")
+	proto.RegisterType((*GetObservationSummaryRequest)(nil), "api.v1.beta1.GetObservationSummaryRequest")
+	proto.RegisterType((*GetObservationSummaryReply)(nil), "api.v1.beta1.GetObservationSummaryReply")
+	proto.RegisterType((*MetricSummary)(nil), "api.v1.beta1.MetricSummary")
 	proto.RegisterType((*DeleteObservationLogRequest)(nil), "api.v1.beta1.DeleteObservationLogRequest")
 	proto.RegisterType((*DeleteObservationLogReply)(nil), "api.v1.beta1.DeleteObservationLogReply")
 	proto.RegisterType((*GetSuggestionsRequest)(nil), "api.v1.beta1.GetSuggestionsRequest")
@@ -1345,6 +1802,7 @@ func init() {
 	proto.RegisterEnum("api.v1.beta1.ParameterType", ParameterType_name, ParameterType_value)
 	proto.RegisterEnum("api.v1.beta1.ObjectiveType", ObjectiveType_name, ObjectiveType_value)
 	proto.RegisterEnum("api.v1.beta1.ComparisonType", ComparisonType_name, ComparisonType_value)
+	proto.RegisterEnum("api.v1.beta1.EarlyStoppingStrategy", EarlyStoppingStrategy_name, EarlyStoppingStrategy_value)
 	proto.RegisterEnum("api.v1.beta1.TrialStatus_TrialConditionType", TrialStatus_TrialConditionType_name, TrialStatus_TrialConditionType_value)
 }
 
@@ -1366,9 +1824,22 @@ type DBManagerClient interface {
 	// You can see accuracy curve or other metric logs on UI.
 	ReportObservationLog(ctx context.Context, in *ReportObservationLogRequest, opts ...grpc.CallOption) (*ReportObservationLogReply, error)
 	// *
+	// Report a log of Observations for a Trial while metrics are collected.
+	// Each request in the stream carries the next part of the log.
+	// Katib DB manager batches inserts and keeps the received logs even if the stream is broken.
+	// Parts with the source offset are registered once they are received, the same as by ReportObservationLog.
+	StreamObservationLog(ctx context.Context, opts ...grpc.CallOption) (DBManager_StreamObservationLogClient, error)
+	// *
 	// Get all log of Observations for a Trial.
 	GetObservationLog(ctx context.Context, in *GetObservationLogRequest, opts ...grpc.CallOption) (*GetObservationLogReply, error)
 	// *
+	// Get logs of Observations for many Trials and metrics in one call.
+	GetObservationLogs(ctx context.Context, in *GetObservationLogsRequest, opts ...grpc.CallOption) (*GetObservationLogsReply, error)
+	// *
+	// Get summary of Observations for a Trial.
+	// The summary is computed by the DB and consists of min, max, latest value and number of logs for each metric.
+	GetObservationSummary(ctx context.Context, in *GetObservationSummaryRequest, opts ...grpc.CallOption) (*GetObservationSummaryReply, error)
+	// *
 	// Delete all log of Observations for a Trial.
 	DeleteObservationLog(ctx context.Context, in *DeleteObservationLogRequest, opts ...grpc.CallOption) (*DeleteObservationLogReply, error)
 }
@@ -1390,6 +1861,40 @@ func (c *dBManagerClient) ReportObservationLog(ctx context.Context, in *ReportOb
 	return out, nil
 }
 
+func (c *dBManagerClient) StreamObservationLog(ctx context.Context, opts ...grpc.CallOption) (DBManager_StreamObservationLogClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DBManager_serviceDesc.Streams[0], c.cc, "/api.v1.beta1.DBManager/StreamObservationLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dBManagerStreamObservationLogClient{stream}
+	return x, nil
+}
+
+type DBManager_StreamObservationLogClient interface {
+	Send(*ReportObservationLogRequest) error
+	CloseAndRecv() (*ReportObservationLogReply, error)
+	grpc.ClientStream
+}
+
+type dBManagerStreamObservationLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *dBManagerStreamObservationLogClient) Send(m *ReportObservationLogRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dBManagerStreamObservationLogClient) CloseAndRecv() (*ReportObservationLogReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ReportObservationLogReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dBManagerClient) GetObservationLog(ctx context.Context, in *GetObservationLogRequest, opts ...grpc.CallOption) (*GetObservationLogReply, error) {
 	out := new(GetObservationLogReply)
 	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/GetObservationLog", in, out, c.cc, opts...)
@@ -1399,6 +1904,24 @@ func (c *dBManagerClient) GetObservationLog(ctx context.Context, in *GetObservat
 	return out, nil
 }
 
+func (c *dBManagerClient) GetObservationLogs(ctx context.Context, in *GetObservationLogsRequest, opts ...grpc.CallOption) (*GetObservationLogsReply, error) {
+	out := new(GetObservationLogsReply)
+	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/GetObservationLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBManagerClient) GetObservationSummary(ctx context.Context, in *GetObservationSummaryRequest, opts ...grpc.CallOption) (*GetObservationSummaryReply, error) {
+	out := new(GetObservationSummaryReply)
+	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/GetObservationSummary", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dBManagerClient) DeleteObservationLog(ctx context.Context, in *DeleteObservationLogRequest, opts ...grpc.CallOption) (*DeleteObservationLogReply, error) {
 	out := new(DeleteObservationLogReply)
 	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/DeleteObservationLog", in, out, c.cc, opts...)
@@ -1418,9 +1941,22 @@ type DBManagerServer interface {
 	// You can see accuracy curve or other metric logs on UI.
 	ReportObservationLog(context.Context, *ReportObservationLogRequest) (*ReportObservationLogReply, error)
 	// *
+	// Report a log of Observations for a Trial while metrics are collected.
+	// Each request in the stream carries the next part of the log.
+	// Katib DB manager batches inserts and keeps the received logs even if the stream is broken.
+	// Parts with the source offset are registered once they are received, the same as by ReportObservationLog.
+	StreamObservationLog(DBManager_StreamObservationLogServer) error
+	// *
 	// Get all log of Observations for a Trial.
 	GetObservationLog(context.Context, *GetObservationLogRequest) (*GetObservationLogReply, error)
 	// *
+	// Get logs of Observations for many Trials and metrics in one call.
+	GetObservationLogs(context.Context, *GetObservationLogsRequest) (*GetObservationLogsReply, error)
+	// *
+	// Get summary of Observations for a Trial.
+	// The summary is computed by the DB and consists of min, max, latest value and number of logs for each metric.
+	GetObservationSummary(context.Context, *GetObservationSummaryRequest) (*GetObservationSummaryReply, error)
+	// *
 	// Delete all log of Observations for a Trial.
 	DeleteObservationLog(context.Context, *DeleteObservationLogRequest) (*DeleteObservationLogReply, error)
 }
@@ -1447,6 +1983,32 @@ func _DBManager_ReportObservationLog_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DBManager_StreamObservationLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DBManagerServer).StreamObservationLog(&dBManagerStreamObservationLogServer{stream})
+}
+
+type DBManager_StreamObservationLogServer interface {
+	SendAndClose(*ReportObservationLogReply) error
+	Recv() (*ReportObservationLogRequest, error)
+	grpc.ServerStream
+}
+
+type dBManagerStreamObservationLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *dBManagerStreamObservationLogServer) SendAndClose(m *ReportObservationLogReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dBManagerStreamObservationLogServer) Recv() (*ReportObservationLogRequest, error) {
+	m := new(ReportObservationLogRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _DBManager_GetObservationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetObservationLogRequest)
 	if err := dec(in); err != nil {
@@ -1465,6 +2027,42 @@ func _DBManager_GetObservationLog_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DBManager_GetObservationLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObservationLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBManagerServer).GetObservationLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.beta1.DBManager/GetObservationLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBManagerServer).GetObservationLogs(ctx, req.(*GetObservationLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBManager_GetObservationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObservationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBManagerServer).GetObservationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.beta1.DBManager/GetObservationSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBManagerServer).GetObservationSummary(ctx, req.(*GetObservationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DBManager_DeleteObservationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteObservationLogRequest)
 	if err := dec(in); err != nil {
@@ -1495,12 +2093,26 @@ var _DBManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetObservationLog",
 			Handler:    _DBManager_GetObservationLog_Handler,
 		},
+		{
+			MethodName: "GetObservationLogs",
+			Handler:    _DBManager_GetObservationLogs_Handler,
+		},
+		{
+			MethodName: "GetObservationSummary",
+			Handler:    _DBManager_GetObservationSummary_Handler,
+		},
 		{
 			MethodName: "DeleteObservationLog",
 			Handler:    _DBManager_DeleteObservationLog_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamObservationLog",
+			Handler:       _DBManager_StreamObservationLog_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "api.proto",
 }
 
@@ -1734,128 +2346,165 @@ var _EarlyStopping_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdd, 0x72, 0x1b, 0x49,
-	0x15, 0xce, 0x48, 0xb2, 0x1d, 0x1d, 0x59, 0xf2, 0xa4, 0x2d, 0x67, 0x65, 0x65, 0x77, 0xe3, 0xcc,
-	0x86, 0x6c, 0x36, 0x49, 0x99, 0xc4, 0x40, 0x2a, 0x4b, 0x42, 0x81, 0x2c, 0x4f, 0x5c, 0xca, 0xca,
-	0x92, 0xd3, 0x92, 0x97, 0xec, 0x2e, 0x55, 0x53, 0x2d, 0xa9, 0xa3, 0x9d, 0x64, 0xfe, 0x98, 0x69,
-	0xa5, 0x22, 0xb8, 0xa4, 0x72, 0x07, 0x17, 0x5b, 0xc5, 0x15, 0xd7, 0xdc, 0x71, 0xc9, 0x0b, 0x70,
-	0xc5, 0x03, 0xf0, 0x04, 0xf0, 0x00, 0xbc, 0x02, 0x45, 0x75, 0xcf, 0x68, 0xfe, 0x34, 0x92, 0x7f,
-	0x02, 0x7b, 0x37, 0x7d, 0xce, 0x77, 0xba, 0xcf, 0x4f, 0x9f, 0x9f, 0x96, 0xa0, 0x48, 0x1c, 0x7d,
-	0xd7, 0x71, 0x6d, 0x66, 0xa3, 0x75, 0xfe, 0xf9, 0xe6, 0xc1, 0xee, 0x80, 0x32, 0xf2, 0x40, 0xc1,
-	0x00, 0xea, 0x5b, 0x87, 0xba, 0xba, 0x49, 0x2d, 0x86, 0x10, 0x14, 0x2c, 0x62, 0xd2, 0x9a, 0xb4,
-	0x23, 0xdd, 0x2e, 0x62, 0xf1, 0x8d, 0xee, 0x43, 0xc1, 0x73, 0xe8, 0xb0, 0x96, 0xdb, 0x91, 0x6e,
-	0x97, 0xf6, 0x3e, 0xdc, 0x8d, 0x8b, 0xef, 0x46, 0xb2, 0x3d, 0x87, 0x0e, 0xb1, 0x40, 0x2a, 0xef,
-	0x0a, 0x50, 0x49, 0x32, 0x50, 0x1f, 0x36, 0x1c, 0xe2, 0x12, 0x93, 0x32, 0xea, 0x6a, 0x1c, 0xe4,
-	0x89, 0x33, 0x4a, 0x7b, 0x77, 0x97, 0xed, 0xb7, 0x7b, 0x3c, 0x93, 0xe1, 0x2b, 0x0f, 0x57, 0x9c,
-	0xc4, 0x1a, 0x7d, 0x0e, 0x45, 0x7b, 0xf0, 0x8a, 0x0e, 0x99, 0xfe, 0x86, 0x06, 0xfa, 0x5d, 0x4b,
-	0xee, 0xd7, 0x9d, 0xb1, 0x85, 0x7a, 0x11, 0x9a, 0x8b, 0x12, 0x63, 0x6c, 0xbb, 0x3a, 0xfb, 0xd6,
-	0xac, 0xe5, 0xb3, 0x44, 0x1b, 0x33, 0xb6, 0x2f, 0x1a, 0xa2, 0xd1, 0x53, 0xa8, 0x50, 0xe2, 0x1a,
-	0x53, 0xcd, 0x63, 0xb6, 0xe3, 0xe8, 0xd6, 0xb8, 0x56, 0x10, 0xf2, 0xd7, 0x53, 0xa6, 0x70, 0x4c,
-	0x2f, 0x80, 0x88, 0x3d, 0xca, 0x34, 0x4e, 0x42, 0xf7, 0xa1, 0xca, 0xed, 0x31, 0x0c, 0x6a, 0x68,
-	0xcc, 0xd5, 0x89, 0xa1, 0x0d, 0xed, 0x89, 0xc5, 0x6a, 0x2b, 0x3b, 0xd2, 0xed, 0x15, 0x8c, 0x66,
-	0xbc, 0x3e, 0x67, 0x35, 0x39, 0x07, 0xdd, 0x82, 0x0d, 0x93, 0xbc, 0x4d, 0x80, 0x57, 0x05, 0xb8,
-	0x6c, 0x92, 0xb7, 0x31, 0xdc, 0x43, 0x00, 0x8b, 0x78, 0xda, 0xd0, 0xb6, 0x5e, 0xea, 0xe3, 0xda,
-	0x9a, 0xd0, 0xee, 0x83, 0xa4, 0x76, 0x1d, 0xe2, 0x35, 0x05, 0x1b, 0x17, 0xad, 0xd9, 0x67, 0xfd,
-	0x08, 0x2a, 0x49, 0x8f, 0xa3, 0xc7, 0x00, 0xa1, 0xcf, 0x79, 0xc8, 0xf2, 0xf3, 0x7e, 0x4a, 0x48,
-	0xe0, 0x18, 0x5c, 0xf9, 0x8b, 0x04, 0xe5, 0x04, 0x37, 0xf3, 0x7e, 0xed, 0x43, 0x14, 0x56, 0x8d,
-	0x4d, 0x1d, 0x3f, 0x92, 0x95, 0x85, 0xc7, 0xf4, 0xa7, 0x0e, 0xc5, 0x65, 0x27, 0xbe, 0xe4, 0x7b,
-	0xbc, 0xa4, 0xc4, 0xd3, 0x07, 0x06, 0xd5, 0x3c, 0x87, 0x0c, 0x69, 0x76, 0x48, 0x9f, 0x06, 0x98,
-	0x1e, 0x87, 0xe0, 0xf2, 0xcb, 0xf8, 0x52, 0xf9, 0x06, 0xca, 0x09, 0x3e, 0x92, 0x21, 0x6f, 0x92,
-	0xb7, 0x81, 0xae, 0xfc, 0x53, 0x50, 0x74, 0x4b, 0xe8, 0xc7, 0x29, 0xba, 0xc5, 0x0d, 0x32, 0x74,
-	0x8f, 0xd5, 0xf2, 0x3b, 0x79, 0x6e, 0x10, 0xff, 0xe6, 0x34, 0x8f, 0x51, 0x47, 0xdc, 0x8a, 0x22,
-	0x16, 0xdf, 0xca, 0xdf, 0x24, 0x28, 0x27, 0xee, 0x22, 0xfa, 0x21, 0x14, 0x84, 0xb1, 0x52, 0x96,
-	0xb1, 0x21, 0x54, 0x18, 0x2b, 0x80, 0x7c, 0xdb, 0xb1, 0x4d, 0x0c, 0x71, 0xba, 0x84, 0xc5, 0x37,
-	0xda, 0x83, 0xad, 0xf0, 0x4a, 0x6b, 0x26, 0x65, 0xae, 0x3e, 0xd4, 0x84, 0x83, 0xf3, 0xe2, 0xec,
-	0xcd, 0x90, 0x79, 0x24, 0x78, 0x1d, 0xee, 0xef, 0x87, 0xf0, 0x01, 0x19, 0x8d, 0x74, 0xa6, 0xdb,
-	0x16, 0x31, 0xe2, 0x42, 0x5e, 0xad, 0x20, 0xac, 0xd8, 0x8a, 0xd8, 0x91, 0x98, 0xa7, 0xbc, 0x93,
-	0xa0, 0x9c, 0xc8, 0x09, 0xf4, 0x03, 0xa8, 0x84, 0x59, 0xa1, 0xc5, 0xe2, 0x5a, 0x0e, 0xa9, 0xe2,
-	0xc0, 0x23, 0x40, 0x11, 0xcc, 0xa3, 0x8c, 0xe9, 0xd6, 0xd8, 0xab, 0xe5, 0xc4, 0x5d, 0xfa, 0x78,
-	0x51, 0xce, 0xf9, 0x30, 0x7c, 0x85, 0xa4, 0x28, 0x9e, 0xf2, 0x04, 0xe4, 0x34, 0x2c, 0xf3, 0x5e,
-	0x55, 0x61, 0xe5, 0x0d, 0x31, 0x26, 0x34, 0x08, 0x97, 0xbf, 0x50, 0xfe, 0x20, 0xc1, 0x95, 0xb9,
-	0xcc, 0x3c, 0xab, 0x25, 0xcf, 0x97, 0x58, 0xa2, 0x2c, 0xcb, 0xfe, 0xc5, 0xd6, 0xfc, 0x02, 0xaa,
-	0x59, 0xd0, 0x73, 0x58, 0xf4, 0x0f, 0x09, 0x8a, 0x61, 0x36, 0xa3, 0x27, 0xb0, 0x3e, 0x76, 0x89,
-	0xf3, 0xed, 0x2c, 0xf9, 0xfd, 0x2a, 0xbb, 0x9d, 0x54, 0xee, 0x90, 0x23, 0x82, 0xf4, 0x2f, 0x8d,
-	0xa3, 0x05, 0xda, 0x07, 0xb0, 0x1d, 0xea, 0x12, 0x1e, 0x7d, 0x2f, 0xa8, 0xa8, 0xca, 0x82, 0xc2,
-	0xb1, 0xdb, 0x0d, 0x91, 0x38, 0x26, 0x55, 0x6f, 0x02, 0x44, 0x1c, 0xf4, 0x13, 0x28, 0x86, 0xbc,
-	0xa0, 0x7e, 0xa4, 0x2a, 0x51, 0x08, 0xc6, 0x11, 0x52, 0x71, 0xa0, 0x14, 0x53, 0x12, 0x7d, 0x04,
-	0x60, 0x4d, 0x4c, 0xcd, 0x20, 0x53, 0xbf, 0x0c, 0xf1, 0x9a, 0x57, 0xb4, 0x26, 0x66, 0x5b, 0x10,
-	0xd0, 0x75, 0x28, 0xe9, 0x96, 0x33, 0x61, 0x9a, 0xa7, 0xff, 0x86, 0xfa, 0x01, 0x59, 0xc1, 0x20,
-	0x48, 0x3d, 0x4e, 0x41, 0x37, 0x60, 0xdd, 0x9e, 0xb0, 0x08, 0x91, 0x17, 0x88, 0x92, 0x4f, 0x13,
-	0x10, 0xe1, 0xc6, 0x50, 0x15, 0x7e, 0x21, 0x42, 0x65, 0xb4, 0x30, 0x4f, 0x8b, 0xb8, 0x1c, 0x52,
-	0x45, 0xdd, 0xe9, 0xce, 0xb7, 0x35, 0xdf, 0x69, 0xb7, 0x16, 0xd8, 0x78, 0x4a, 0x47, 0xfb, 0x5f,
-	0x57, 0xe0, 0xdf, 0xc2, 0x8a, 0x68, 0x0b, 0x99, 0xd7, 0xe9, 0x6e, 0xa2, 0xb1, 0xa7, 0xa2, 0x22,
-	0xc4, 0xa2, 0x9e, 0x8e, 0x1e, 0xc0, 0xaa, 0xc7, 0x08, 0x9b, 0x78, 0x41, 0x65, 0xdd, 0xce, 0x82,
-	0x0b, 0x00, 0x0e, 0x80, 0xca, 0x7f, 0x72, 0x50, 0x0c, 0xb7, 0x79, 0x9f, 0x5e, 0x4d, 0x60, 0x2b,
-	0xf2, 0x32, 0xf1, 0x3c, 0x7d, 0x6c, 0xf1, 0x09, 0x61, 0xa6, 0xca, 0xbd, 0x05, 0x9a, 0x47, 0x7e,
-	0x69, 0x44, 0x32, 0xb8, 0xea, 0x64, 0x50, 0xd1, 0x63, 0x58, 0x35, 0xc8, 0x80, 0x1a, 0x7e, 0x0d,
-	0x2c, 0xed, 0x7d, 0xb2, 0x68, 0xcf, 0xb6, 0x40, 0xa9, 0x16, 0x73, 0xa7, 0x38, 0x10, 0xa9, 0x7f,
-	0x03, 0xd5, 0xac, 0xa3, 0x50, 0x13, 0x4a, 0x71, 0x6d, 0xfd, 0xd8, 0xdd, 0x58, 0x10, 0xbb, 0x48,
-	0x10, 0xc7, 0xa5, 0xea, 0x9f, 0x43, 0x29, 0x76, 0x26, 0x6f, 0x41, 0xaf, 0xe9, 0x74, 0xd6, 0x94,
-	0x5e, 0xd3, 0x69, 0x76, 0x55, 0xf8, 0x69, 0xee, 0x91, 0xa4, 0xfc, 0x1c, 0x36, 0x33, 0xb6, 0x3f,
-	0x47, 0x69, 0xf9, 0x77, 0x0e, 0x4a, 0xb1, 0xc8, 0xf2, 0x34, 0xf4, 0x18, 0x71, 0x99, 0xc6, 0xf4,
-	0x50, 0xbe, 0x28, 0x28, 0x7d, 0xdd, 0xa4, 0xe8, 0x53, 0xd8, 0x18, 0xda, 0xa6, 0x63, 0x50, 0x3f,
-	0x6b, 0x38, 0xc6, 0xdf, 0xae, 0x12, 0x91, 0x05, 0xf0, 0x19, 0x14, 0x87, 0xb6, 0xe5, 0x37, 0x19,
-	0x11, 0xc4, 0x4a, 0x76, 0x10, 0xc5, 0xa9, 0xbb, 0xc1, 0x60, 0x13, 0xe0, 0x45, 0x47, 0x8c, 0xc4,
-	0xd1, 0x63, 0x28, 0xd9, 0x03, 0x8f, 0xba, 0x6f, 0xfc, 0x12, 0x53, 0xc8, 0xba, 0x9d, 0xdd, 0x08,
-	0x80, 0xe3, 0x68, 0xe5, 0xf7, 0x12, 0xa0, 0xf9, 0xed, 0x51, 0x09, 0xd6, 0x9a, 0x58, 0x6d, 0xf4,
-	0xd5, 0x03, 0xf9, 0x12, 0x5f, 0xe0, 0x93, 0x4e, 0xa7, 0xd5, 0x39, 0x94, 0x25, 0x54, 0x86, 0x62,
-	0xef, 0xa4, 0xd9, 0x54, 0xd5, 0x03, 0xf5, 0x40, 0xce, 0x21, 0x80, 0xd5, 0x2f, 0x5a, 0xed, 0xb6,
-	0x7a, 0x20, 0xe7, 0xf9, 0xf7, 0xd3, 0x46, 0x8b, 0x7f, 0x17, 0xd0, 0x55, 0x40, 0x47, 0x6a, 0x1f,
-	0xb7, 0x9a, 0xbd, 0x93, 0x4e, 0xe3, 0xcb, 0x46, 0xab, 0xdd, 0xd8, 0x6f, 0xab, 0xf2, 0x0a, 0x92,
-	0x61, 0x5d, 0x6d, 0xe0, 0xf6, 0x57, 0xbd, 0x7e, 0xf7, 0xf8, 0x58, 0x3d, 0x90, 0x57, 0xf9, 0xee,
-	0x27, 0x9d, 0x2f, 0x3a, 0xdd, 0x5f, 0x76, 0xe4, 0x35, 0xe5, 0x67, 0x50, 0x8a, 0xa9, 0x8a, 0x76,
-	0x61, 0xcd, 0x6f, 0xcf, 0xb3, 0xbb, 0x53, 0x4d, 0x9a, 0xe5, 0x77, 0x67, 0x3c, 0x03, 0x29, 0x7b,
-	0xb0, 0xea, 0x93, 0xce, 0x11, 0xe2, 0xdf, 0x49, 0x70, 0x0d, 0x53, 0xc7, 0x76, 0x59, 0xec, 0xe4,
-	0xb6, 0x3d, 0xc6, 0xf4, 0xd7, 0x13, 0xea, 0x31, 0x1e, 0x72, 0x7f, 0xdc, 0x8c, 0xed, 0x57, 0x14,
-	0x14, 0xd1, 0x11, 0x55, 0xd8, 0x88, 0xf9, 0x53, 0x33, 0xec, 0x71, 0xf6, 0x3b, 0x21, 0xb5, 0x79,
-	0xc5, 0x4e, 0xac, 0x95, 0x6b, 0xb0, 0x9d, 0xad, 0x84, 0x63, 0x4c, 0x95, 0x67, 0x50, 0x49, 0x92,
-	0xd1, 0x23, 0x28, 0x05, 0x73, 0x8b, 0x61, 0x8f, 0xbd, 0xec, 0xb6, 0xe2, 0x7b, 0x82, 0x6f, 0x02,
-	0xe6, 0xec, 0xd3, 0x53, 0x5e, 0x40, 0x31, 0x64, 0x08, 0xdb, 0x74, 0x93, 0x6a, 0x1e, 0x23, 0xa6,
-	0x13, 0xda, 0xa6, 0x9b, 0xb4, 0xc7, 0x09, 0xe8, 0x1e, 0xac, 0xfa, 0x92, 0x81, 0x49, 0xd9, 0xde,
-	0x0f, 0x30, 0xca, 0x1f, 0x25, 0xa8, 0x1d, 0xd2, 0x8b, 0x79, 0xf1, 0x7a, 0x68, 0x8f, 0xe0, 0xfb,
-	0x01, 0x0a, 0xd4, 0x16, 0x80, 0x64, 0xe2, 0xe5, 0xd3, 0x89, 0xb7, 0x0d, 0x97, 0xa9, 0x35, 0xf2,
-	0x99, 0xfe, 0xd4, 0xb9, 0x46, 0xad, 0x11, 0x67, 0x29, 0x1a, 0x5c, 0xcd, 0xd0, 0xca, 0x31, 0xa6,
-	0x59, 0xa1, 0x93, 0x2e, 0x10, 0xba, 0x27, 0x70, 0xed, 0x80, 0x1a, 0x94, 0xd1, 0x8b, 0x58, 0xce,
-	0x03, 0x9f, 0x2d, 0xcd, 0x03, 0xff, 0x5d, 0x0e, 0xb6, 0x0e, 0x29, 0xeb, 0x4d, 0xc6, 0x63, 0xea,
-	0xf9, 0x83, 0x46, 0xb0, 0xeb, 0x23, 0x00, 0x1a, 0xbe, 0x14, 0x03, 0xb5, 0x6b, 0x8b, 0x5e, 0x92,
-	0x38, 0x86, 0x45, 0x77, 0x61, 0x55, 0x9c, 0x3e, 0x1b, 0xdb, 0x36, 0x33, 0xea, 0x0e, 0x0e, 0x20,
-	0xe8, 0x33, 0xa8, 0xb8, 0xfe, 0x89, 0x9a, 0x35, 0x31, 0x07, 0xd4, 0x15, 0xae, 0x5f, 0xd9, 0xcf,
-	0xd5, 0x24, 0x5c, 0x0e, 0x38, 0x1d, 0xc1, 0x40, 0x3f, 0x86, 0xab, 0xc3, 0x89, 0xeb, 0x52, 0x8b,
-	0x69, 0x29, 0x91, 0x82, 0x98, 0x56, 0xaa, 0x01, 0x17, 0x27, 0xa4, 0xee, 0x43, 0x95, 0xd9, 0x8c,
-	0x18, 0x69, 0x99, 0xe0, 0x09, 0x28, 0x78, 0x09, 0x09, 0xe5, 0xcf, 0x05, 0xd8, 0x4c, 0xfb, 0x84,
-	0x47, 0xf3, 0xf5, 0xa2, 0x1e, 0xe9, 0x27, 0xc7, 0xc3, 0xd4, 0x00, 0x38, 0xbf, 0xc3, 0x79, 0xba,
-	0x65, 0xe2, 0xf1, 0x9c, 0x3b, 0xd7, 0xe3, 0xf9, 0x39, 0x54, 0x93, 0x8f, 0x67, 0xcd, 0x9d, 0x18,
-	0xc1, 0x44, 0xb6, 0xfc, 0x09, 0x8d, 0x27, 0x06, 0xc5, 0x88, 0xa6, 0x49, 0x5e, 0xfd, 0xbb, 0xdc,
-	0xff, 0xb1, 0xff, 0xa6, 0x2e, 0x70, 0x2e, 0x9d, 0xba, 0x5f, 0x87, 0x83, 0x83, 0x6f, 0xc1, 0xfe,
-	0xc5, 0x1c, 0x9d, 0x39, 0x57, 0xbc, 0x47, 0xeb, 0xff, 0x15, 0xec, 0x7c, 0x49, 0x0c, 0x7d, 0x44,
-	0x18, 0x4d, 0x3f, 0x96, 0xde, 0x3f, 0x89, 0x94, 0x1d, 0xf8, 0x78, 0xc9, 0xee, 0x3c, 0x75, 0xff,
-	0x2a, 0xc1, 0x87, 0x87, 0x94, 0xcd, 0x05, 0xf0, 0xfb, 0xce, 0xe0, 0x7b, 0x80, 0x46, 0x03, 0xcd,
-	0x24, 0x16, 0x19, 0xf3, 0xbc, 0x18, 0x8d, 0x5c, 0xea, 0x79, 0x41, 0x01, 0x95, 0x47, 0x83, 0x23,
-	0x9f, 0xd1, 0xf0, 0xe9, 0x8a, 0x0d, 0xf5, 0x05, 0x4a, 0xf3, 0x14, 0x5b, 0x74, 0x75, 0xa5, 0x0b,
-	0x5f, 0x5d, 0xe5, 0x4f, 0xe9, 0xd7, 0x28, 0x27, 0x9f, 0xbd, 0x7b, 0xa3, 0x27, 0x00, 0x7c, 0xb4,
-	0x22, 0xae, 0xee, 0x85, 0x93, 0x54, 0xaa, 0x7c, 0x37, 0x43, 0xbe, 0x98, 0x9c, 0x62, 0xf8, 0xa8,
-	0xab, 0x84, 0x3f, 0x57, 0xac, 0x04, 0x5d, 0xa5, 0xc7, 0xa8, 0xa3, 0x58, 0x70, 0x73, 0x16, 0xe5,
-	0xac, 0x27, 0x6a, 0x18, 0xca, 0xf9, 0xdf, 0xc3, 0xa4, 0x8b, 0xfc, 0x1e, 0xa6, 0xdc, 0x04, 0xe5,
-	0x94, 0xf3, 0xf8, 0xcd, 0x7a, 0x08, 0x5b, 0x3d, 0xca, 0xe2, 0xef, 0x8d, 0xb3, 0x75, 0x9a, 0x2d,
-	0xd8, 0x4c, 0xcb, 0x39, 0xc6, 0xf4, 0xce, 0x49, 0xec, 0x27, 0x2a, 0x31, 0xfb, 0xc9, 0xb0, 0x1e,
-	0x0c, 0x64, 0x5a, 0xff, 0xab, 0x63, 0x55, 0xbe, 0xc4, 0x07, 0xbb, 0x83, 0xee, 0x09, 0x1f, 0xe0,
-	0x24, 0xb4, 0x06, 0xf9, 0x56, 0xa7, 0x2f, 0xe7, 0xd0, 0x3a, 0x5c, 0x3e, 0x68, 0xf5, 0x9a, 0x58,
-	0xed, 0xab, 0x72, 0x1e, 0x6d, 0x40, 0xa9, 0xd9, 0xe8, 0xab, 0x87, 0x5d, 0xdc, 0x6a, 0x36, 0xda,
-	0x72, 0xe1, 0xce, 0xa3, 0xd8, 0xcf, 0x3d, 0xb3, 0x91, 0x72, 0x36, 0xe7, 0x5d, 0xe2, 0xc2, 0x47,
-	0xad, 0x4e, 0xeb, 0xa8, 0xf5, 0x35, 0xdf, 0x93, 0xaf, 0x1a, 0x2f, 0xfc, 0x55, 0xee, 0xce, 0x33,
-	0xa8, 0x24, 0x43, 0xc6, 0x87, 0xc9, 0x99, 0x46, 0xcd, 0xee, 0xd1, 0x71, 0x03, 0xb7, 0x7a, 0x5d,
-	0xbe, 0x4b, 0x11, 0x56, 0xd4, 0xe7, 0x27, 0x8d, 0xb6, 0x2c, 0xa1, 0xcb, 0x50, 0x68, 0xab, 0xbd,
-	0x9e, 0x9c, 0xe3, 0xe7, 0x1c, 0x8a, 0xd1, 0x15, 0xcb, 0xf9, 0xbd, 0xbf, 0xe7, 0xa0, 0x78, 0xb0,
-	0x1f, 0x5c, 0x72, 0xf4, 0x0a, 0xaa, 0x59, 0x43, 0x16, 0xfa, 0x2c, 0x19, 0xa7, 0x25, 0xd3, 0x60,
-	0xfd, 0xd3, 0xb3, 0x40, 0x79, 0xae, 0x10, 0xb8, 0x32, 0x37, 0x76, 0xa0, 0x5b, 0x73, 0xb5, 0x31,
-	0xfb, 0x94, 0x9b, 0xa7, 0xe2, 0xf8, 0x11, 0xaf, 0xa0, 0x9a, 0x35, 0x3a, 0xa4, 0xcd, 0x59, 0x32,
-	0x9c, 0xa4, 0xcd, 0x59, 0x38, 0x89, 0xec, 0xfd, 0x4b, 0x02, 0x88, 0xea, 0x38, 0x7a, 0x01, 0x95,
-	0x64, 0x61, 0x47, 0x9f, 0x2c, 0x2f, 0xfb, 0xfe, 0x71, 0x37, 0x4e, 0xed, 0x0d, 0x68, 0x0a, 0xdb,
-	0x0b, 0x2b, 0x2b, 0xda, 0x4d, 0xca, 0x9f, 0x56, 0xe0, 0xeb, 0xf7, 0xce, 0x8c, 0xe7, 0x36, 0xfe,
-	0x33, 0x07, 0xe5, 0x44, 0xde, 0x21, 0x53, 0x8c, 0x5f, 0xf3, 0xe5, 0x10, 0xdd, 0x99, 0x33, 0x64,
-	0x61, 0xa1, 0xaf, 0xdf, 0x3e, 0x13, 0x96, 0xdb, 0xfe, 0x02, 0x2a, 0xc9, 0x0c, 0x4d, 0x7b, 0x35,
-	0x33, 0xef, 0xd3, 0x5e, 0xcd, 0x48, 0x72, 0xf4, 0x4e, 0x82, 0x8f, 0x96, 0x96, 0x16, 0xb4, 0x97,
-	0xed, 0xaa, 0x65, 0x75, 0xaf, 0x7e, 0xff, 0x5c, 0x32, 0x8e, 0x31, 0x1d, 0xac, 0x8a, 0x7f, 0x60,
-	0x7e, 0xf4, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xa1, 0xa2, 0x49, 0x8e, 0x19, 0x00, 0x00,
+	// 2554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0xf2, 0x4b, 0xe2, 0xa3, 0x48, 0x6d, 0x46, 0x94, 0x42, 0x51, 0x49, 0x2c, 0x6f, 0x12,
+	0x5b, 0x71, 0x0c, 0xc5, 0x51, 0x5b, 0xc3, 0x69, 0x52, 0xa4, 0x14, 0x45, 0x0b, 0x74, 0x28, 0xd2,
+	0x1e, 0x52, 0x8a, 0xed, 0x14, 0x58, 0x2c, 0xc9, 0x11, 0xb3, 0xf6, 0x7e, 0x75, 0x77, 0x69, 0x9b,
+	0x2d, 0xd0, 0x9b, 0x0f, 0x2d, 0x5a, 0xa0, 0xf9, 0x1f, 0x72, 0x6b, 0x6f, 0x3d, 0xf4, 0xff, 0xe8,
+	0xbd, 0x40, 0xfb, 0x07, 0xf4, 0xd8, 0x5b, 0x51, 0x14, 0x33, 0xb3, 0xdc, 0x2f, 0x2e, 0xa9, 0x0f,
+	0xd7, 0xb9, 0xed, 0xbc, 0xf7, 0x7b, 0x6f, 0x66, 0xde, 0xbc, 0x79, 0x1f, 0xb3, 0x90, 0x57, 0x2c,
+	0x75, 0xd7, 0xb2, 0x4d, 0xd7, 0x44, 0x2b, 0xf4, 0xf3, 0xf9, 0xa7, 0xbb, 0x7d, 0xe2, 0x2a, 0x9f,
+	0x56, 0xdf, 0x1b, 0x99, 0xe6, 0x48, 0x23, 0x9f, 0x30, 0x5e, 0x7f, 0x7c, 0xfa, 0xc9, 0x0b, 0x5b,
+	0xb1, 0x2c, 0x62, 0x3b, 0x1c, 0x2d, 0x61, 0x80, 0xc6, 0x4b, 0x8b, 0xd8, 0xaa, 0x4e, 0x0c, 0x17,
+	0x21, 0xc8, 0x18, 0x8a, 0x4e, 0x2a, 0xc2, 0xb6, 0xb0, 0x93, 0xc7, 0xec, 0x1b, 0xdd, 0x86, 0x8c,
+	0x63, 0x91, 0x41, 0x25, 0xb5, 0x2d, 0xec, 0x14, 0xf6, 0xde, 0xd9, 0x0d, 0xab, 0xdf, 0x0d, 0x64,
+	0xbb, 0x16, 0x19, 0x60, 0x86, 0x94, 0x5e, 0x65, 0xa0, 0x14, 0x65, 0xa0, 0x1e, 0xac, 0x5a, 0x8a,
+	0xad, 0xe8, 0xc4, 0x25, 0xb6, 0x4c, 0x41, 0x0e, 0x9b, 0xa3, 0xb0, 0xf7, 0xf1, 0x22, 0x7d, 0xbb,
+	0x0f, 0xa6, 0x32, 0x74, 0xe4, 0xe0, 0x92, 0x15, 0x19, 0xa3, 0xcf, 0x20, 0x6f, 0xf6, 0x9f, 0x92,
+	0x81, 0xab, 0x3e, 0x27, 0xde, 0xfa, 0xb6, 0xa2, 0xfa, 0x3a, 0x53, 0x36, 0x5b, 0x5e, 0x80, 0xa6,
+	0xa2, 0x8a, 0x36, 0x32, 0x6d, 0xd5, 0xfd, 0x56, 0xaf, 0xa4, 0x93, 0x44, 0x6b, 0x53, 0x36, 0x17,
+	0xf5, 0xd1, 0xe8, 0x1e, 0x94, 0x88, 0x62, 0x6b, 0x13, 0xd9, 0x71, 0x4d, 0xcb, 0x52, 0x8d, 0x51,
+	0x25, 0xc3, 0xe4, 0xaf, 0xc6, 0xb6, 0x42, 0x31, 0x5d, 0x0f, 0xc2, 0x74, 0x14, 0x49, 0x98, 0x84,
+	0x6e, 0x43, 0x99, 0xee, 0x47, 0xd3, 0x88, 0x26, 0xbb, 0xb6, 0xaa, 0x68, 0xf2, 0xc0, 0x1c, 0x1b,
+	0x6e, 0x25, 0xbb, 0x2d, 0xec, 0x64, 0x31, 0x9a, 0xf2, 0x7a, 0x94, 0x55, 0xa7, 0x1c, 0x74, 0x1d,
+	0x56, 0x75, 0xe5, 0x65, 0x04, 0x9c, 0x63, 0xe0, 0xa2, 0xae, 0xbc, 0x0c, 0xe1, 0xee, 0x00, 0x18,
+	0x8a, 0x23, 0x0f, 0x4c, 0xe3, 0x54, 0x1d, 0x55, 0x96, 0xd8, 0xea, 0xde, 0x8e, 0xae, 0xae, 0xad,
+	0x38, 0x75, 0xc6, 0xc6, 0x79, 0x63, 0xfa, 0x59, 0x3d, 0x82, 0x52, 0xd4, 0xe2, 0xe8, 0x73, 0x00,
+	0xdf, 0xe6, 0xf4, 0xc8, 0xd2, 0xb3, 0x76, 0x8a, 0x48, 0xe0, 0x10, 0x5c, 0xfa, 0x93, 0x00, 0xc5,
+	0x08, 0x37, 0xd1, 0xbf, 0xf6, 0x21, 0x38, 0x56, 0xd9, 0x9d, 0x58, 0xfc, 0x24, 0x4b, 0x73, 0xa7,
+	0xe9, 0x4d, 0x2c, 0x82, 0x8b, 0x56, 0x78, 0x48, 0x75, 0x9c, 0x12, 0xc5, 0x51, 0xfb, 0x1a, 0x91,
+	0x1d, 0x4b, 0x19, 0x90, 0xe4, 0x23, 0xbd, 0xe7, 0x61, 0xba, 0x14, 0x82, 0x8b, 0xa7, 0xe1, 0xa1,
+	0xf4, 0x0d, 0x14, 0x23, 0x7c, 0x24, 0x42, 0x5a, 0x57, 0x5e, 0x7a, 0x6b, 0xa5, 0x9f, 0x8c, 0xa2,
+	0x1a, 0x95, 0x94, 0x47, 0x51, 0x0d, 0xba, 0x21, 0x4d, 0x75, 0xdc, 0x4a, 0x7a, 0x3b, 0x4d, 0x37,
+	0x44, 0xbf, 0x29, 0xcd, 0x71, 0x89, 0xc5, 0xbc, 0x22, 0x8f, 0xd9, 0xb7, 0xf4, 0xf7, 0x14, 0x14,
+	0x23, 0xbe, 0x88, 0x3e, 0x81, 0x0c, 0xdb, 0xac, 0x90, 0xb4, 0x59, 0x1f, 0xca, 0x36, 0xcb, 0x80,
+	0x54, 0xed, 0xc8, 0x54, 0x34, 0x36, 0xbb, 0x80, 0xd9, 0x37, 0xda, 0x83, 0x75, 0xdf, 0xa5, 0x65,
+	0x9d, 0xb8, 0xb6, 0x3a, 0x90, 0x99, 0x81, 0xd3, 0x6c, 0xee, 0x35, 0x9f, 0x79, 0xc4, 0x78, 0x6d,
+	0x6a, 0xef, 0x3b, 0xf0, 0xb6, 0x32, 0x1c, 0xaa, 0xae, 0x6a, 0x1a, 0x8a, 0x16, 0x16, 0x72, 0x2a,
+	0x19, 0xb6, 0x8b, 0xf5, 0x80, 0x1d, 0x88, 0x39, 0xe8, 0x04, 0x42, 0x0c, 0xd9, 0xd7, 0xec, 0x54,
+	0xb2, 0xcc, 0x2b, 0xae, 0xc5, 0x6e, 0x8f, 0x0f, 0xf5, 0xf7, 0x82, 0xcb, 0xca, 0x2c, 0xd1, 0x41,
+	0x75, 0x28, 0x0c, 0x4c, 0xc3, 0x71, 0x6d, 0x45, 0x35, 0x5c, 0xa7, 0x92, 0x4b, 0xd2, 0xe6, 0xc3,
+	0xeb, 0x3e, 0x12, 0x87, 0xa5, 0xa4, 0x3f, 0x0b, 0xb0, 0x96, 0x30, 0xe5, 0xc5, 0xad, 0x7c, 0x3b,
+	0x64, 0x65, 0x1a, 0xed, 0x78, 0xf8, 0xdc, 0x9d, 0x86, 0xcf, 0xdd, 0x03, 0x73, 0xdc, 0xd7, 0xc8,
+	0x89, 0xa2, 0x8d, 0xc9, 0xe5, 0xcf, 0x40, 0xfa, 0x9d, 0x00, 0x6b, 0x09, 0x7b, 0x42, 0x57, 0xa1,
+	0x10, 0xd6, 0xc0, 0x5d, 0x0f, 0xf4, 0xe0, 0xf0, 0xca, 0x90, 0x7d, 0x4e, 0xe7, 0xf6, 0x7c, 0x90,
+	0x0f, 0xd0, 0x17, 0x00, 0x03, 0x53, 0xb7, 0x14, 0x5b, 0x75, 0x4c, 0x83, 0xcd, 0x5b, 0x8a, 0x07,
+	0xea, 0xba, 0xcf, 0x67, 0x9b, 0x0d, 0xe1, 0xa5, 0x57, 0x02, 0x14, 0x23, 0xc1, 0x0e, 0x7d, 0x08,
+	0x25, 0x3f, 0xdc, 0x85, 0x57, 0x52, 0xf4, 0xa9, 0x6c, 0x31, 0x47, 0x80, 0x02, 0x98, 0x43, 0x5c,
+	0x57, 0x35, 0x46, 0x4e, 0x25, 0xc5, 0x0e, 0xf0, 0xbd, 0x79, 0xc1, 0x94, 0xc3, 0xf0, 0x5b, 0x4a,
+	0x8c, 0xe2, 0x48, 0x5f, 0x80, 0x18, 0x87, 0x25, 0x06, 0x8c, 0x44, 0x1b, 0x48, 0x7f, 0x10, 0xe0,
+	0xad, 0x99, 0x90, 0x7b, 0xde, 0x9d, 0x3c, 0x5c, 0xb0, 0x13, 0x69, 0x51, 0x58, 0x9f, 0xbf, 0x9b,
+	0x9f, 0x43, 0x39, 0x09, 0x7a, 0x81, 0x1d, 0xfd, 0x4d, 0x80, 0xbc, 0x1f, 0xa6, 0xd1, 0x17, 0xb0,
+	0x32, 0xb2, 0x15, 0xeb, 0xdb, 0x69, 0x54, 0xe7, 0xe9, 0x73, 0x33, 0xba, 0xb8, 0x43, 0x8a, 0xe0,
+	0x02, 0xb8, 0x30, 0x0a, 0x06, 0x68, 0x1f, 0xc0, 0xb4, 0x88, 0xad, 0xd0, 0xfb, 0xe1, 0x78, 0xce,
+	0x2d, 0xcd, 0xc9, 0x08, 0xbb, 0x1d, 0x1f, 0x89, 0x43, 0x52, 0xd5, 0x3a, 0x40, 0xc0, 0x41, 0x3f,
+	0x81, 0xbc, 0xcf, 0xf3, 0x12, 0x43, 0x2c, 0xc5, 0xf8, 0x60, 0x1c, 0x20, 0x25, 0x0b, 0x0a, 0xa1,
+	0x45, 0xa2, 0x77, 0x01, 0x8c, 0xb1, 0x2e, 0x6b, 0xca, 0x84, 0xe7, 0x17, 0x9a, 0xcc, 0xf2, 0xc6,
+	0x58, 0x6f, 0x31, 0x02, 0xbd, 0x0f, 0xaa, 0x61, 0x8d, 0x5d, 0xd9, 0x51, 0x7f, 0x45, 0xf8, 0x81,
+	0x64, 0x31, 0x30, 0x52, 0x97, 0x52, 0xd0, 0x35, 0x58, 0x31, 0xc7, 0x6e, 0x80, 0x48, 0x33, 0x44,
+	0x81, 0xd3, 0x18, 0x84, 0x99, 0xd1, 0x5f, 0x0a, 0x75, 0x08, 0x7f, 0x31, 0xb2, 0x1f, 0x1a, 0xf2,
+	0xb8, 0xe8, 0x53, 0x59, 0x42, 0xe9, 0xcc, 0xd6, 0x2b, 0xdc, 0x68, 0xd7, 0xe7, 0xec, 0xf1, 0x8c,
+	0x52, 0xe5, 0xff, 0x9d, 0x5a, 0x7f, 0x0d, 0x59, 0x96, 0xef, 0x13, 0xdd, 0xe9, 0xe3, 0x48, 0xc5,
+	0x16, 0x3b, 0x15, 0x26, 0x16, 0x14, 0x6b, 0xe8, 0x53, 0xc8, 0x39, 0xae, 0xe2, 0x8e, 0x9d, 0x4a,
+	0x3a, 0xc9, 0xa3, 0x38, 0x9c, 0x01, 0xb0, 0x07, 0x94, 0xfe, 0x9b, 0x82, 0xbc, 0xaf, 0xe6, 0x75,
+	0x8a, 0x30, 0x05, 0xd6, 0x03, 0x2b, 0x2b, 0x8e, 0xa3, 0x8e, 0x0c, 0x5a, 0xfa, 0x4d, 0x97, 0x72,
+	0x6b, 0xce, 0xca, 0x03, 0xbb, 0xd4, 0x02, 0x19, 0x5c, 0xb6, 0x12, 0xa8, 0xe8, 0x73, 0xc8, 0x69,
+	0x4a, 0x9f, 0x68, 0x3c, 0xb9, 0x15, 0xf6, 0xde, 0x9f, 0xa7, 0xb3, 0xc5, 0x50, 0x0d, 0xc3, 0xb5,
+	0x27, 0xd8, 0x13, 0xa9, 0x7e, 0x03, 0xe5, 0xa4, 0xa9, 0x68, 0xca, 0x0a, 0xaf, 0x56, 0x48, 0x4a,
+	0x59, 0x09, 0x82, 0x38, 0x2c, 0x55, 0xfd, 0x0c, 0x0a, 0xa1, 0x39, 0x69, 0x6d, 0xf1, 0x8c, 0x4c,
+	0xa6, 0xd5, 0xc6, 0x33, 0x32, 0x49, 0x8e, 0x0a, 0x3f, 0x4d, 0xdd, 0x15, 0xa4, 0x2f, 0x61, 0x2d,
+	0x41, 0xfd, 0x05, 0x42, 0xcb, 0xbf, 0x52, 0x50, 0x08, 0x9d, 0x2c, 0xbd, 0x86, 0x8e, 0xab, 0xd8,
+	0xae, 0xec, 0xaa, 0xbe, 0x7c, 0x9e, 0x51, 0x7a, 0xaa, 0x4e, 0xd0, 0x0d, 0x58, 0xa5, 0xf9, 0x42,
+	0x23, 0xfc, 0xd6, 0xa8, 0xfa, 0x54, 0x5d, 0x29, 0x20, 0x33, 0xe0, 0x7d, 0xc8, 0x0f, 0x4c, 0x83,
+	0xa7, 0x61, 0x2f, 0x0f, 0xdd, 0x9a, 0xeb, 0x4f, 0xbb, 0x5e, 0xc5, 0xea, 0xe1, 0x59, 0x5e, 0x0a,
+	0xc4, 0xd1, 0xe7, 0x50, 0x30, 0xfb, 0x0e, 0xb1, 0x9f, 0xf3, 0x10, 0x93, 0x49, 0xf2, 0xce, 0x4e,
+	0x00, 0xc0, 0x61, 0xb4, 0xf4, 0x7b, 0x01, 0xd0, 0xac, 0x7a, 0x54, 0x80, 0xa5, 0x3a, 0x6e, 0xd4,
+	0x7a, 0x8d, 0x03, 0xf1, 0x0a, 0x1d, 0xe0, 0xe3, 0x76, 0xbb, 0xd9, 0x3e, 0x14, 0x05, 0x54, 0x84,
+	0x7c, 0xf7, 0xb8, 0x5e, 0x6f, 0x34, 0x0e, 0x1a, 0x07, 0x62, 0x0a, 0x01, 0xe4, 0xbe, 0x6a, 0xb6,
+	0x5a, 0x8d, 0x03, 0x31, 0x4d, 0xbf, 0xef, 0xd5, 0x9a, 0xf4, 0x3b, 0x83, 0x36, 0x00, 0x1d, 0x35,
+	0x7a, 0xb8, 0x59, 0xef, 0x1e, 0xb7, 0x6b, 0x27, 0xb5, 0x66, 0xab, 0xb6, 0xdf, 0x6a, 0x88, 0x59,
+	0x24, 0xc2, 0x4a, 0xa3, 0x86, 0x5b, 0x8f, 0xbb, 0xbd, 0xce, 0x83, 0x07, 0x8d, 0x03, 0x31, 0x47,
+	0xb5, 0x1f, 0xb7, 0xbf, 0x6a, 0x77, 0xbe, 0x6e, 0x8b, 0x4b, 0xd2, 0xcf, 0xa0, 0x10, 0x5a, 0x2a,
+	0xda, 0x85, 0x25, 0x9e, 0xd3, 0xa7, 0xbe, 0x53, 0x8e, 0x6e, 0x8b, 0x57, 0x0a, 0x78, 0x0a, 0x92,
+	0xf6, 0x20, 0xc7, 0x49, 0x17, 0x38, 0xe2, 0xef, 0x53, 0xb0, 0x85, 0x89, 0x65, 0xda, 0x6e, 0x68,
+	0xe6, 0x96, 0x39, 0xc2, 0xe4, 0x97, 0x63, 0xe2, 0xb8, 0xf4, 0xc8, 0x79, 0x1f, 0x11, 0xd2, 0x97,
+	0x67, 0x14, 0x96, 0x11, 0x1b, 0xb0, 0x1a, 0xb2, 0xa7, 0xac, 0x99, 0xa3, 0xe4, 0x06, 0x30, 0xa6,
+	0xbc, 0x64, 0x46, 0xc6, 0xe8, 0x1d, 0xc8, 0x53, 0xfd, 0x41, 0x4d, 0x9e, 0xc7, 0x01, 0x81, 0xfa,
+	0x15, 0xf1, 0x1b, 0x3e, 0xbe, 0x10, 0x5e, 0x34, 0x97, 0x02, 0x32, 0x5b, 0xcd, 0x16, 0xf0, 0xa5,
+	0xc9, 0x63, 0x75, 0xc8, 0xfa, 0xa3, 0x3c, 0x5e, 0x66, 0x84, 0x63, 0x75, 0x88, 0xde, 0x87, 0xa2,
+	0x63, 0x8e, 0xed, 0x01, 0x91, 0xcd, 0xd3, 0x53, 0x87, 0xf0, 0x9e, 0x28, 0x8d, 0x57, 0x38, 0xb1,
+	0xc3, 0x68, 0x68, 0x03, 0x72, 0x7c, 0xcc, 0xda, 0xa1, 0x3c, 0xf6, 0x46, 0xd2, 0x16, 0x6c, 0x26,
+	0x5b, 0xc9, 0xd2, 0x26, 0xd2, 0x7d, 0x28, 0x45, 0xc9, 0xe8, 0xae, 0x5f, 0xa0, 0x69, 0xe6, 0xc8,
+	0x49, 0xce, 0x7b, 0xfc, 0xa8, 0xa8, 0x12, 0xaf, 0x72, 0x6b, 0x99, 0x23, 0x47, 0xd2, 0x20, 0xef,
+	0x33, 0x98, 0xf1, 0x55, 0x9d, 0xc8, 0x8e, 0xab, 0xe8, 0x96, 0x6f, 0x7c, 0x55, 0x27, 0x5d, 0x4a,
+	0x40, 0xb7, 0x20, 0xc7, 0x25, 0x3d, 0x9b, 0x27, 0xbb, 0x47, 0x4e, 0xf7, 0x7d, 0x82, 0xf5, 0x1b,
+	0xe9, 0x50, 0xbf, 0xf1, 0xc7, 0x14, 0x54, 0x0e, 0xc9, 0xe5, 0x8e, 0x3e, 0x56, 0x84, 0xa6, 0x66,
+	0x8a, 0xd0, 0x68, 0xb4, 0x48, 0xc7, 0xa3, 0xc5, 0x26, 0x2c, 0x13, 0x63, 0xc8, 0x99, 0xfc, 0x38,
+	0x97, 0x88, 0x31, 0x64, 0xac, 0x88, 0x3b, 0x64, 0xe3, 0xee, 0xe0, 0xeb, 0x65, 0xdb, 0xc9, 0x85,
+	0xf4, 0x76, 0x5d, 0x62, 0x4d, 0xf5, 0x32, 0xe6, 0x92, 0xaf, 0x97, 0xb1, 0x24, 0x28, 0x9a, 0xf6,
+	0x90, 0xd8, 0x72, 0x7f, 0xc2, 0xf9, 0xcb, 0xdb, 0xc2, 0xce, 0x32, 0x2e, 0x30, 0xe2, 0xfe, 0x84,
+	0x62, 0x24, 0x19, 0x36, 0x12, 0x2c, 0x62, 0x69, 0x93, 0x24, 0x5f, 0x17, 0x2e, 0xee, 0xeb, 0xd2,
+	0x5f, 0x05, 0xd8, 0x9c, 0x99, 0xc1, 0x99, 0x1a, 0xfd, 0x2a, 0x14, 0x02, 0xa3, 0x73, 0xcf, 0xc9,
+	0x63, 0xf0, 0xad, 0xce, 0x4a, 0x99, 0x48, 0x33, 0x96, 0x62, 0x88, 0x82, 0x1e, 0x6a, 0xc1, 0xde,
+	0x90, 0xe1, 0x25, 0x1b, 0xde, 0x4e, 0x5a, 0x38, 0xb5, 0xcd, 0xd7, 0xb0, 0xc1, 0x97, 0x1d, 0xb3,
+	0xd0, 0x9c, 0xac, 0xc7, 0x62, 0x6e, 0xcc, 0x4e, 0x65, 0x77, 0x96, 0x48, 0x2b, 0x98, 0xb5, 0x04,
+	0xf0, 0x0f, 0x13, 0x96, 0xa4, 0xdf, 0xc0, 0x3b, 0xd1, 0x0d, 0x77, 0xc7, 0xba, 0xae, 0xd8, 0x93,
+	0x73, 0xde, 0x90, 0x73, 0x1c, 0xd5, 0xc2, 0xc0, 0x27, 0x0d, 0xa1, 0x3a, 0x67, 0x7e, 0x6a, 0xf3,
+	0x7b, 0x20, 0x7a, 0xea, 0x1d, 0x46, 0x56, 0xc9, 0x9c, 0xfa, 0x90, 0x07, 0x82, 0xa9, 0xec, 0xaa,
+	0x1e, 0x1a, 0xaa, 0xc4, 0x91, 0xfe, 0x2d, 0x40, 0x31, 0x02, 0x39, 0xbb, 0xbf, 0x9c, 0x7d, 0xe1,
+	0xf0, 0x5e, 0x41, 0xd2, 0xc1, 0x2b, 0xc8, 0x06, 0x2d, 0xa9, 0x5c, 0xe2, 0xb8, 0x9e, 0x93, 0x79,
+	0x23, 0x9a, 0x87, 0x82, 0x07, 0xac, 0x34, 0xe6, 0x03, 0xb4, 0x03, 0xe2, 0xa9, 0x6a, 0x3b, 0xae,
+	0x1c, 0x0a, 0x78, 0xfc, 0x6a, 0x97, 0x18, 0xbd, 0xe7, 0x47, 0xbd, 0xeb, 0xb0, 0xaa, 0x29, 0x51,
+	0x20, 0xbf, 0xe6, 0x45, 0x4d, 0x09, 0xe3, 0xae, 0x42, 0x81, 0xcf, 0x18, 0x5c, 0xf5, 0x3c, 0x06,
+	0x4e, 0x62, 0x37, 0xfd, 0x09, 0x6c, 0x1d, 0x10, 0x8d, 0xb8, 0xe4, 0x52, 0xe1, 0x2f, 0x72, 0x72,
+	0xa9, 0xf8, 0xc9, 0x6d, 0xc1, 0x66, 0xb2, 0x6e, 0x9a, 0x2f, 0xbe, 0x4b, 0xc1, 0xfa, 0x21, 0x71,
+	0xbb, 0xe3, 0xd1, 0x88, 0x38, 0xbc, 0x81, 0xf2, 0xe6, 0xbc, 0x0b, 0x10, 0xa4, 0x34, 0x2f, 0xba,
+	0x54, 0xe6, 0x3d, 0x7d, 0xe2, 0x10, 0x16, 0x7d, 0x0c, 0x39, 0xb6, 0xb6, 0x69, 0x3b, 0xba, 0x96,
+	0x70, 0xe1, 0xb0, 0x07, 0x41, 0x1f, 0x41, 0xc9, 0xe6, 0x33, 0xca, 0xc6, 0x58, 0xef, 0x13, 0x9b,
+	0x9d, 0x5b, 0x76, 0x3f, 0x55, 0x11, 0x70, 0xd1, 0xe3, 0xb4, 0x19, 0x03, 0xfd, 0x18, 0x36, 0x06,
+	0x63, 0xdb, 0xa6, 0x89, 0x37, 0x26, 0x42, 0x4f, 0x35, 0x8b, 0xcb, 0x1e, 0x17, 0x47, 0xa4, 0x6e,
+	0x43, 0xd9, 0x35, 0x5d, 0x45, 0x8b, 0xcb, 0x78, 0x6f, 0x96, 0x8c, 0x17, 0x91, 0x90, 0xbe, 0xcf,
+	0xc0, 0x5a, 0xdc, 0x26, 0xd4, 0xc9, 0x9f, 0xcd, 0xab, 0xfd, 0xb9, 0xa7, 0xdf, 0x89, 0x35, 0xb6,
+	0xb3, 0x1a, 0x2e, 0xd2, 0x05, 0x44, 0x5e, 0x7b, 0x53, 0x17, 0x7a, 0xed, 0x7d, 0x08, 0xe5, 0xe8,
+	0x6b, 0xaf, 0x6c, 0x8f, 0x35, 0xaf, 0xd3, 0x5c, 0xfc, 0xe6, 0x8b, 0xc7, 0x1a, 0xc1, 0x88, 0xc4,
+	0x49, 0x4e, 0xf5, 0xbb, 0xd4, 0x1b, 0xec, 0x2b, 0x62, 0xee, 0x9d, 0x8a, 0xbb, 0xf7, 0x13, 0xbf,
+	0x21, 0xe2, 0x3b, 0xd8, 0xbf, 0x9c, 0xa1, 0x13, 0xfb, 0xa5, 0xd7, 0x68, 0x69, 0x7e, 0x01, 0xdb,
+	0x27, 0x8a, 0xa6, 0x0e, 0x15, 0x97, 0xc4, 0x1f, 0x81, 0x5e, 0xff, 0x12, 0x49, 0xdb, 0xf0, 0xde,
+	0x02, 0xed, 0xf4, 0xea, 0xfe, 0x45, 0x60, 0x29, 0x61, 0xe6, 0x00, 0x7f, 0xe8, 0x1b, 0x7c, 0x0b,
+	0xd0, 0xb0, 0x2f, 0xeb, 0x8a, 0xa1, 0x8c, 0xe8, 0xbd, 0x18, 0x0e, 0x6d, 0xe2, 0x38, 0x5e, 0xf4,
+	0x15, 0x87, 0xfd, 0x23, 0xce, 0xa8, 0x71, 0xba, 0x64, 0x42, 0x75, 0xce, 0xa2, 0xe9, 0x15, 0x9b,
+	0xe7, 0xba, 0xc2, 0xa5, 0x5d, 0x57, 0xfa, 0x4f, 0xfc, 0x95, 0x8d, 0x92, 0xcf, 0xdf, 0x95, 0xbc,
+	0xde, 0x4b, 0x65, 0xac, 0x40, 0xe4, 0x71, 0x2a, 0x54, 0x20, 0x7e, 0x09, 0xcb, 0x8e, 0x6b, 0x2b,
+	0x2e, 0x19, 0x4d, 0x58, 0x40, 0x2a, 0xc5, 0xbb, 0xfd, 0xe8, 0x83, 0x9c, 0x07, 0xc5, 0xbe, 0x10,
+	0xcd, 0x2c, 0x2f, 0x54, 0x63, 0x68, 0xbe, 0x60, 0xaf, 0x49, 0xde, 0xbf, 0x15, 0xe0, 0x24, 0xfa,
+	0x98, 0x24, 0x19, 0xf0, 0xc1, 0xd4, 0x8f, 0x92, 0x1e, 0xf7, 0x7c, 0x67, 0x99, 0xfd, 0x45, 0x24,
+	0x5c, 0xe6, 0x17, 0x91, 0xf4, 0x01, 0x48, 0x67, 0xcc, 0x47, 0x7d, 0xf7, 0x0e, 0xac, 0x77, 0x89,
+	0x1b, 0x7e, 0xa9, 0x39, 0x57, 0xa6, 0x93, 0xd6, 0x61, 0x2d, 0x2e, 0x67, 0x69, 0x93, 0x9b, 0xc7,
+	0xa1, 0xbf, 0x36, 0xac, 0x6b, 0x16, 0x61, 0xc5, 0x6b, 0x65, 0xe5, 0xde, 0xe3, 0x07, 0x0d, 0xf1,
+	0x0a, 0x6d, 0x89, 0x0f, 0x3a, 0xc7, 0xb4, 0xf5, 0x15, 0xd0, 0x12, 0xa4, 0x9b, 0xed, 0x9e, 0x98,
+	0x42, 0x2b, 0xb0, 0x7c, 0xd0, 0xec, 0xd6, 0x71, 0xa3, 0xd7, 0x10, 0xd3, 0x68, 0x15, 0x0a, 0xf5,
+	0x5a, 0xaf, 0x71, 0xd8, 0xc1, 0xcd, 0x7a, 0xad, 0x25, 0x66, 0x6e, 0xde, 0x0d, 0xfd, 0x01, 0x99,
+	0x36, 0xe3, 0xd3, 0x0e, 0xf9, 0x0a, 0x15, 0x3e, 0x6a, 0xb6, 0x9b, 0x47, 0xcd, 0x27, 0x54, 0x27,
+	0x1d, 0xd5, 0x1e, 0xf1, 0x51, 0xea, 0xe6, 0x7d, 0x28, 0x45, 0x9d, 0x82, 0xb6, 0xe1, 0xd3, 0x15,
+	0xd5, 0x3b, 0x47, 0x0f, 0x6a, 0xb8, 0xd9, 0xed, 0x50, 0x2d, 0x79, 0xc8, 0x36, 0x1e, 0x1e, 0xd7,
+	0x5a, 0xa2, 0x80, 0x96, 0x21, 0xd3, 0x6a, 0x74, 0xbb, 0x62, 0x8a, 0xce, 0x73, 0xc8, 0x9a, 0x7e,
+	0x2c, 0xa6, 0x6f, 0xca, 0xb0, 0x9e, 0xe8, 0x05, 0xa8, 0x0c, 0xe2, 0x54, 0x65, 0xb7, 0x87, 0xe9,
+	0xca, 0x1f, 0x8b, 0x57, 0xe8, 0xe6, 0x8e, 0x9a, 0x6d, 0xbe, 0xcb, 0xa3, 0xda, 0x23, 0xfe, 0x32,
+	0xd0, 0xaa, 0xf5, 0x1a, 0xdd, 0x9e, 0x98, 0x46, 0x08, 0x4a, 0x47, 0x9d, 0x93, 0x66, 0xfb, 0x50,
+	0xae, 0x9d, 0x34, 0x70, 0xed, 0xb0, 0x21, 0x66, 0xf6, 0x7e, 0x9b, 0x85, 0xfc, 0xc1, 0xbe, 0x77,
+	0x4f, 0xd1, 0x53, 0x28, 0x27, 0xb5, 0x97, 0xe8, 0xa3, 0xa8, 0x23, 0x2c, 0x68, 0xd4, 0xab, 0x37,
+	0xce, 0x03, 0xa5, 0xd7, 0x5d, 0x83, 0x72, 0xd7, 0xb5, 0x89, 0xa2, 0xbf, 0xf9, 0xb9, 0x76, 0x04,
+	0xa4, 0xc0, 0x5b, 0x33, 0x3d, 0x03, 0xba, 0x3e, 0x93, 0x4c, 0x92, 0xe7, 0xf9, 0xe0, 0x4c, 0x1c,
+	0xdd, 0xd0, 0x10, 0xd0, 0x0c, 0xc7, 0x41, 0x37, 0xce, 0x90, 0x9d, 0x7a, 0x7f, 0xf5, 0xc3, 0xb3,
+	0x81, 0x74, 0x16, 0x1d, 0xd6, 0xa3, 0xac, 0x69, 0xb1, 0x7c, 0x73, 0x91, 0x7c, 0xb4, 0x61, 0xa8,
+	0xee, 0x9c, 0x0b, 0x4b, 0xa7, 0x7b, 0x0a, 0xe5, 0xa4, 0x02, 0x32, 0x7e, 0x4a, 0x0b, 0x0a, 0xd8,
+	0xea, 0x8d, 0xf3, 0x40, 0x2d, 0x6d, 0xb2, 0xf7, 0x4f, 0x01, 0x20, 0xc8, 0xe6, 0xe8, 0x11, 0x94,
+	0xa2, 0xe9, 0x1d, 0xbd, 0xbf, 0x38, 0xf9, 0xf3, 0xe9, 0xae, 0x9d, 0x59, 0x21, 0xa0, 0x09, 0x6c,
+	0xce, 0xcd, 0xaf, 0x68, 0x37, 0x2a, 0x7f, 0x56, 0x9a, 0xaf, 0xde, 0x3a, 0x37, 0x9e, 0xee, 0xf1,
+	0x1f, 0x29, 0x28, 0x46, 0x6e, 0xb4, 0x77, 0xa0, 0xb3, 0x49, 0x31, 0xe1, 0x40, 0xe7, 0xa6, 0xfb,
+	0xea, 0xce, 0xb9, 0xb0, 0x74, 0xef, 0x8f, 0xa0, 0x14, 0x8d, 0xa2, 0x71, 0xab, 0x26, 0xc6, 0xe6,
+	0xea, 0xb5, 0xc5, 0x20, 0xaa, 0xf9, 0x95, 0x00, 0xef, 0x2e, 0x0c, 0xff, 0x68, 0x2f, 0xd9, 0x54,
+	0x8b, 0x72, 0x53, 0xf5, 0xf6, 0x85, 0x64, 0x2c, 0x6d, 0xd2, 0xcf, 0xb1, 0xbf, 0x9f, 0x3f, 0xfa,
+	0xdf, 0x00, 0x3d, 0x32, 0x6f, 0xb5, 0x65, 0x22, 0x00, 0x00,
 }
@@ -14,14 +14,16 @@ from google.protobuf import descriptor_pb2
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import wrappers_pb2 as google_dot_protobuf_dot_wrappers__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
   name='api.proto',
   package='api.v1.beta1',
   syntax='proto3',
-  serialized_pb=_b('\n\tapi.proto\x12\x0c\x61pi.v1.beta1\"F\n\nExperiment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12*\n\x04spec\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ExperimentSpec\"\x96\x03\n\x0e\x45xperimentSpec\x12\x44\n\x0fparameter_specs\x18\x01 \x01(\x0b\x32+.api.v1.beta1.ExperimentSpec.ParameterSpecs\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12.\n\talgorithm\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12\x37\n\x0e\x65\x61rly_stopping\x18\x04 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpec\x12\x1c\n\x14parallel_trial_count\x18\x05 \x01(\x05\x12\x17\n\x0fmax_trial_count\x18\x06 \x01(\x05\x12+\n\nnas_config\x18\x07 \x01(\x0b\x32\x17.api.v1.beta1.NasConfig\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"\x87\x01\n\rParameterSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x33\n\x0eparameter_type\x18\x02 \x01(\x0e\x32\x1b.api.v1.beta1.ParameterType\x12\x33\n\x0e\x66\x65\x61sible_space\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.FeasibleSpace\"E\n\rFeasibleSpace\x12\x0b\n\x03max\x18\x01 \x01(\t\x12\x0b\n\x03min\x18\x02 \x01(\t\x12\x0c\n\x04list\x18\x03 \x03(\t\x12\x0c\n\x04step\x18\x04 \x01(\t\"\x88\x01\n\rObjectiveSpec\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveType\x12\x0c\n\x04goal\x18\x02 \x01(\x01\x12\x1d\n\x15objective_metric_name\x18\x03 \x01(\t\x12\x1f\n\x17\x61\x64\x64itional_metric_names\x18\x04 \x03(\t\"c\n\rAlgorithmSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12:\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\x1e.api.v1.beta1.AlgorithmSetting\"/\n\x10\x41lgorithmSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"k\n\x11\x45\x61rlyStoppingSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12>\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\".api.v1.beta1.EarlyStoppingSetting\"3\n\x14\x45\x61rlyStoppingSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xae\x01\n\tNasConfig\x12/\n\x0cgraph_config\x18\x01 \x01(\x0b\x32\x19.api.v1.beta1.GraphConfig\x12\x36\n\noperations\x18\x02 \x01(\x0b\x32\".api.v1.beta1.NasConfig.Operations\x1a\x38\n\nOperations\x12*\n\toperation\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.Operation\"L\n\x0bGraphConfig\x12\x12\n\nnum_layers\x18\x01 \x01(\x05\x12\x13\n\x0binput_sizes\x18\x02 \x03(\x05\x12\x14\n\x0coutput_sizes\x18\x03 \x03(\x05\"\xa7\x01\n\tOperation\x12\x16\n\x0eoperation_type\x18\x01 \x01(\t\x12?\n\x0fparameter_specs\x18\x02 \x01(\x0b\x32&.api.v1.beta1.Operation.ParameterSpecs\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"g\n\x05Trial\x12\x0c\n\x04name\x18\x01 \x01(\t\x12%\n\x04spec\x18\x02 \x01(\x0b\x32\x17.api.v1.beta1.TrialSpec\x12)\n\x06status\x18\x03 \x01(\x0b\x32\x19.api.v1.beta1.TrialStatus\"\xbc\x02\n\tTrialSpec\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12K\n\x15parameter_assignments\x18\x03 \x01(\x0b\x32,.api.v1.beta1.TrialSpec.ParameterAssignments\x12\x33\n\x06labels\x18\x04 \x03(\x0b\x32#.api.v1.beta1.TrialSpec.LabelsEntry\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"2\n\x13ParameterAssignment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xba\x02\n\x0bTrialStatus\x12\x12\n\nstart_time\x18\x01 \x01(\t\x12\x17\n\x0f\x63ompletion_time\x18\x02 \x01(\t\x12?\n\tcondition\x18\x03 \x01(\x0e\x32,.api.v1.beta1.TrialStatus.TrialConditionType\x12.\n\x0bobservation\x18\x04 \x01(\x0b\x32\x19.api.v1.beta1.Observation\"\x8c\x01\n\x12TrialConditionType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tSUCCEEDED\x10\x02\x12\n\n\x06KILLED\x10\x03\x12\n\n\x06\x46\x41ILED\x10\x04\x12\x16\n\x12METRICSUNAVAILABLE\x10\x05\x12\x10\n\x0c\x45\x41RLYSTOPPED\x10\x06\x12\x0b\n\x07UNKNOWN\x10\x07\"4\n\x0bObservation\x12%\n\x07metrics\x18\x01 \x03(\x0b\x32\x14.api.v1.beta1.Metric\"%\n\x06Metric\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"h\n\x1bReportObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x35\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"\x1b\n\x19ReportObservationLogReply\">\n\x0eObservationLog\x12,\n\x0bmetric_logs\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.MetricLog\"E\n\tMetricLog\x12\x12\n\ntime_stamp\x18\x01 \x01(\t\x12$\n\x06metric\x18\x02 \x01(\x0b\x32\x14.api.v1.beta1.Metric\"i\n\x18GetObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x13\n\x0bmetric_name\x18\x02 \x01(\t\x12\x12\n\nstart_time\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x04 \x01(\t\"O\n\x16GetObservationLogReply\x12\x35\n\x0fobservation_log\x18\x01 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"1\n\x1b\x44\x65leteObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\"\x1b\n\x19\x44\x65leteObservationLogReply\"\xc4\x01\n\x15GetSuggestionsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x1a\n\x0erequest_number\x18\x03 \x01(\x05\x42\x02\x18\x01\x12\x1e\n\x16\x63urrent_request_number\x18\x04 \x01(\x05\x12\x1c\n\x14total_request_number\x18\x05 \x01(\x05\"\xc3\x03\n\x13GetSuggestionsReply\x12U\n\x15parameter_assignments\x18\x01 \x03(\x0b\x32\x36.api.v1.beta1.GetSuggestionsReply.ParameterAssignments\x12.\n\talgorithm\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12=\n\x14\x65\x61rly_stopping_rules\x18\x03 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\x1a\xe5\x01\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\x12\x12\n\ntrial_name\x18\x02 \x01(\t\x12R\n\x06labels\x18\x03 \x03(\x0b\x32\x42.api.v1.beta1.GetSuggestionsReply.ParameterAssignments.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n ValidateAlgorithmSettingsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\" \n\x1eValidateAlgorithmSettingsReply\"\x8d\x01\n\x1cGetEarlyStoppingRulesRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x1a\n\x12\x64\x62_manager_address\x18\x03 \x01(\t\"[\n\x1aGetEarlyStoppingRulesReply\x12=\n\x14\x65\x61rly_stopping_rules\x18\x01 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\"v\n\x11\x45\x61rlyStoppingRule\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x12\x30\n\ncomparison\x18\x03 \x01(\x0e\x32\x1c.api.v1.beta1.ComparisonType\x12\x12\n\nstart_step\x18\x04 \x01(\x05\"_\n$ValidateEarlyStoppingSettingsRequest\x12\x37\n\x0e\x65\x61rly_stopping\x18\x01 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpec\"$\n\"ValidateEarlyStoppingSettingsReply\"+\n\x15SetTrialStatusRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\"\x15\n\x13SetTrialStatusReply*U\n\rParameterType\x12\x10\n\x0cUNKNOWN_TYPE\x10\x00\x12\n\n\x06\x44OUBLE\x10\x01\x12\x07\n\x03INT\x10\x02\x12\x0c\n\x08\x44ISCRETE\x10\x03\x12\x0f\n\x0b\x43\x41TEGORICAL\x10\x04*8\n\rObjectiveType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08MINIMIZE\x10\x01\x12\x0c\n\x08MAXIMIZE\x10\x02*J\n\x0e\x43omparisonType\x12\x16\n\x12UNKNOWN_COMPARISON\x10\x00\x12\t\n\x05\x45QUAL\x10\x01\x12\x08\n\x04LESS\x10\x02\x12\x0b\n\x07GREATER\x10\x03\x32\xc6\x02\n\tDBManager\x12j\n\x14ReportObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply\x12\x61\n\x11GetObservationLog\x12&.api.v1.beta1.GetObservationLogRequest\x1a$.api.v1.beta1.GetObservationLogReply\x12j\n\x14\x44\x65leteObservationLog\x12).api.v1.beta1.DeleteObservationLogRequest\x1a\'.api.v1.beta1.DeleteObservationLogReply2\xe1\x01\n\nSuggestion\x12X\n\x0eGetSuggestions\x12#.api.v1.beta1.GetSuggestionsRequest\x1a!.api.v1.beta1.GetSuggestionsReply\x12y\n\x19ValidateAlgorithmSettings\x12..api.v1.beta1.ValidateAlgorithmSettingsRequest\x1a,.api.v1.beta1.ValidateAlgorithmSettingsReply2\xe0\x02\n\rEarlyStopping\x12m\n\x15GetEarlyStoppingRules\x12*.api.v1.beta1.GetEarlyStoppingRulesRequest\x1a(.api.v1.beta1.GetEarlyStoppingRulesReply\x12X\n\x0eSetTrialStatus\x12#.api.v1.beta1.SetTrialStatusRequest\x1a!.api.v1.beta1.SetTrialStatusReply\x12\x85\x01\n\x1dValidateEarlyStoppingSettings\x12\x32.api.v1.beta1.ValidateEarlyStoppingSettingsRequest\x1a\x30.api.v1.beta1.ValidateEarlyStoppingSettingsReplyb\x06proto3')
-)
+  serialized_pb=_b('\n\tapi.proto\x12\x0c\x61pi.v1.beta1\x1a\x1egoogle/protobuf/wrappers.proto\"F\n\nExperiment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12*\n\x04spec\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ExperimentSpec\"\x96\x03\n\x0e\x45xperimentSpec\x12\x44\n\x0fparameter_specs\x18\x01 \x01(\x0b\x32+.api.v1.beta1.ExperimentSpec.ParameterSpecs\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12.\n\talgorithm\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12\x37\n\x0e\x65\x61rly_stopping\x18\x04 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpec\x12\x1c\n\x14parallel_trial_count\x18\x05 \x01(\x05\x12\x17\n\x0fmax_trial_count\x18\x06 \x01(\x05\x12+\n\nnas_config\x18\x07 \x01(\x0b\x32\x17.api.v1.beta1.NasConfig\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"\x87\x01\n\rParameterSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x33\n\x0eparameter_type\x18\x02 \x01(\x0e\x32\x1b.api.v1.beta1.ParameterType\x12\x33\n\x0e\x66\x65\x61sible_space\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.FeasibleSpace\"E\n\rFeasibleSpace\x12\x0b\n\x03max\x18\x01 \x01(\t\x12\x0b\n\x03min\x18\x02 \x01(\t\x12\x0c\n\x04list\x18\x03 \x03(\t\x12\x0c\n\x04step\x18\x04 \x01(\t\"\x82\x02\n\rObjectiveSpec\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveType\x12\x0c\n\x04goal\x18\x02 \x01(\x01\x12\x1d\n\x15objective_metric_name\x18\x03 \x01(\t\x12\x1f\n\x17\x61\x64\x64itional_metric_names\x18\x04 \x03(\t\x12@\n\x15\x61\x64\x64itional_objectives\x18\x05 \x03(\x0b\x32!.api.v1.beta1.AdditionalObjective\x12\x36\n\x0b\x63onstraints\x18\x06 \x03(\x0b\x32!.api.v1.beta1.ObjectiveConstraint\"\x8b\x01\n\x13\x41\x64\x64itionalObjective\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveType\x12*\n\x04goal\x18\x02 \x01(\x0b\x32\x1c.google.protobuf.DoubleValue\x12\x1d\n\x15objective_metric_name\x18\x03 \x01(\t\"k\n\x13ObjectiveConstraint\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x12\x30\n\ncomparison\x18\x03 \x01(\x0e\x32\x1c.api.v1.beta1.ComparisonType\"c\n\rAlgorithmSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12:\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\x1e.api.v1.beta1.AlgorithmSetting\"/\n\x10\x41lgorithmSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"k\n\x11\x45\x61rlyStoppingSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12>\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\".api.v1.beta1.EarlyStoppingSetting\"3\n\x14\x45\x61rlyStoppingSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xae\x01\n\tNasConfig\x12/\n\x0cgraph_config\x18\x01 \x01(\x0b\x32\x19.api.v1.beta1.GraphConfig\x12\x36\n\noperations\x18\x02 \x01(\x0b\x32\".api.v1.beta1.NasConfig.Operations\x1a\x38\n\nOperations\x12*\n\toperation\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.Operation\"L\n\x0bGraphConfig\x12\x12\n\nnum_layers\x18\x01 \x01(\x05\x12\x13\n\x0binput_sizes\x18\x02 \x03(\x05\x12\x14\n\x0coutput_sizes\x18\x03 \x03(\x05\"\xa7\x01\n\tOperation\x12\x16\n\x0eoperation_type\x18\x01 \x01(\t\x12?\n\x0fparameter_specs\x18\x02 \x01(\x0b\x32&.api.v1.beta1.Operation.ParameterSpecs\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"g\n\x05Trial\x12\x0c\n\x04name\x18\x01 \x01(\t\x12%\n\x04spec\x18\x02 \x01(\x0b\x32\x17.api.v1.beta1.TrialSpec\x12)\n\x06status\x18\x03 \x01(\x0b\x32\x19.api.v1.beta1.TrialStatus\"\xbc\x02\n\tTrialSpec\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12K\n\x15parameter_assignments\x18\x03 \x01(\x0b\x32,.api.v1.beta1.TrialSpec.ParameterAssignments\x12\x33\n\x06labels\x18\x04 \x03(\x0b\x32#.api.v1.beta1.TrialSpec.LabelsEntry\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"2\n\x13ParameterAssignment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xba\x02\n\x0bTrialStatus\x12\x12\n\nstart_time\x18\x01 \x01(\t\x12\x17\n\x0f\x63ompletion_time\x18\x02 \x01(\t\x12?\n\tcondition\x18\x03 \x01(\x0e\x32,.api.v1.beta1.TrialStatus.TrialConditionType\x12.\n\x0bobservation\x18\x04 \x01(\x0b\x32\x19.api.v1.beta1.Observation\"\x8c\x01\n\x12TrialConditionType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tSUCCEEDED\x10\x02\x12\n\n\x06KILLED\x10\x03\x12\n\n\x06\x46\x41ILED\x10\x04\x12\x16\n\x12METRICSUNAVAILABLE\x10\x05\x12\x10\n\x0c\x45\x41RLYSTOPPED\x10\x06\x12\x0b\n\x07UNKNOWN\x10\x07\"4\n\x0bObservation\x12%\n\x07metrics\x18\x01 \x03(\x0b\x32\x14.api.v1.beta1.Metric\"%\n\x06Metric\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xce\x01\n\x1bReportObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x35\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\x12\x11\n\tnamespace\x18\x03 \x01(\t\x12\x17\n\x0f\x65xperiment_name\x18\x04 \x01(\t\x12\x11\n\ttrial_uid\x18\x05 \x01(\t\x12\x15\n\rsource_offset\x18\x06 \x01(\x03\x12\x0e\n\x06source\x18\x07 \x01(\t\"\x1b\n\x19ReportObservationLogReply\">\n\x0eObservationLog\x12,\n\x0bmetric_logs\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.MetricLog\"S\n\tMetricLog\x12\x12\n\ntime_stamp\x18\x01 \x01(\t\x12$\n\x06metric\x18\x02 \x01(\x0b\x32\x14.api.v1.beta1.Metric\x12\x0c\n\x04step\x18\x03 \x01(\t\"\xb9\x01\n\x18GetObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x13\n\x0bmetric_name\x18\x02 \x01(\t\x12\x12\n\nstart_time\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x04 \x01(\t\x12\x11\n\tnamespace\x18\x05 \x01(\t\x12\x12\n\nstart_step\x18\x06 \x01(\t\x12\x10\n\x08\x65nd_step\x18\x07 \x01(\t\x12\x15\n\rorder_by_step\x18\x08 \x01(\x08\"O\n\x16GetObservationLogReply\x12\x35\n\x0fobservation_log\x18\x01 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"\x7f\n\x19GetObservationLogsRequest\x12\x13\n\x0btrial_names\x18\x01 \x03(\t\x12\x14\n\x0cmetric_names\x18\x02 \x03(\t\x12\x12\n\nstart_time\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x04 \x01(\t\x12\x11\n\tnamespace\x18\x05 \x01(\t\"\\\n\x17GetObservationLogsReply\x12\x41\n\x16trial_observation_logs\x18\x01 \x03(\x0b\x32!.api.v1.beta1.TrialObservationLog\"`\n\x13TrialObservationLog\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x35\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"[\n\x1cGetObservationSummaryRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x14\n\x0cmetric_names\x18\x02 \x03(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\"S\n\x1aGetObservationSummaryReply\x12\x35\n\x10metric_summaries\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.MetricSummary\"\xa5\x01\n\rMetricSummary\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x0b\n\x03min\x18\x02 \x01(\t\x12\x0b\n\x03max\x18\x03 \x01(\t\x12\x0e\n\x06latest\x18\x04 \x01(\t\x12\r\n\x05\x63ount\x18\x05 \x01(\x03\x12\x18\n\x10\x66irst_time_stamp\x18\x06 \x01(\t\x12\x17\n\x0flast_time_stamp\x18\x07 \x01(\t\x12\x13\n\x0blatest_step\x18\x08 \x01(\t\"D\n\x1b\x44\x65leteObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\"\x1b\n\x19\x44\x65leteObservationLogReply\"\xc4\x01\n\x15GetSuggestionsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x1a\n\x0erequest_number\x18\x03 \x01(\x05\x42\x02\x18\x01\x12\x1e\n\x16\x63urrent_request_number\x18\x04 \x01(\x05\x12\x1c\n\x14total_request_number\x18\x05 \x01(\x05\"\xc3\x03\n\x13GetSuggestionsReply\x12U\n\x15parameter_assignments\x18\x01 \x03(\x0b\x32\x36.api.v1.beta1.GetSuggestionsReply.ParameterAssignments\x12.\n\talgorithm\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12=\n\x14\x65\x61rly_stopping_rules\x18\x03 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\x1a\xe5\x01\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\x12\x12\n\ntrial_name\x18\x02 \x01(\t\x12R\n\x06labels\x18\x03 \x03(\x0b\x32\x42.api.v1.beta1.GetSuggestionsReply.ParameterAssignments.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n ValidateAlgorithmSettingsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\" \n\x1eValidateAlgorithmSettingsReply\"\x8d\x01\n\x1cGetEarlyStoppingRulesRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x1a\n\x12\x64\x62_manager_address\x18\x03 \x01(\t\"[\n\x1aGetEarlyStoppingRulesReply\x12=\n\x14\x65\x61rly_stopping_rules\x18\x01 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\"\xc2\x01\n\x11\x45\x61rlyStoppingRule\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x12\x30\n\ncomparison\x18\x03 \x01(\x0e\x32\x1c.api.v1.beta1.ComparisonType\x12\x12\n\nstart_step\x18\x04 \x01(\x05\x12\x35\n\x08strategy\x18\x05 \x01(\x0e\x32#.api.v1.beta1.EarlyStoppingStrategy\x12\x13\n\x0bwindow_size\x18\x06 \x01(\x05\"_\n$ValidateEarlyStoppingSettingsRequest\x12\x37\n\x0e\x65\x61rly_stopping\x18\x01 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpec\"$\n\"ValidateEarlyStoppingSettingsReply\"+\n\x15SetTrialStatusRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\"\x15\n\x13SetTrialStatusReply*U\n\rParameterType\x12\x10\n\x0cUNKNOWN_TYPE\x10\x00\x12\n\n\x06\x44OUBLE\x10\x01\x12\x07\n\x03INT\x10\x02\x12\x0c\n\x08\x44ISCRETE\x10\x03\x12\x0f\n\x0b\x43\x41TEGORICAL\x10\x04*8\n\rObjectiveType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08MINIMIZE\x10\x01\x12\x0c\n\x08MAXIMIZE\x10\x02*J\n\x0e\x43omparisonType\x12\x16\n\x12UNKNOWN_COMPARISON\x10\x00\x12\t\n\x05\x45QUAL\x10\x01\x12\x08\n\x04LESS\x10\x02\x12\x0b\n\x07GREATER\x10\x03*_\n\x15\x45\x61rlyStoppingStrategy\x12\x14\n\x10UNKNOWN_STRATEGY\x10\x00\x12\x07\n\x03MIN\x10\x01\x12\x07\n\x03MAX\x10\x02\x12\n\n\x06LATEST\x10\x03\x12\x12\n\x0eMOVING_AVERAGE\x10\x04\x32\x89\x05\n\tDBManager\x12j\n\x14ReportObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply\x12l\n\x14StreamObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply(\x01\x12\x61\n\x11GetObservationLog\x12&.api.v1.beta1.GetObservationLogRequest\x1a$.api.v1.beta1.GetObservationLogReply\x12\x64\n\x12GetObservationLogs\x12\'.api.v1.beta1.GetObservationLogsRequest\x1a%.api.v1.beta1.GetObservationLogsReply\x12m\n\x15GetObservationSummary\x12*.api.v1.beta1.GetObservationSummaryRequest\x1a(.api.v1.beta1.GetObservationSummaryReply\x12j\n\x14\x44\x65leteObservationLog\x12).api.v1.beta1.DeleteObservationLogRequest\x1a\'.api.v1.beta1.DeleteObservationLogReply2\xe1\x01\n\nSuggestion\x12X\n\x0eGetSuggestions\x12#.api.v1.beta1.GetSuggestionsRequest\x1a!.api.v1.beta1.GetSuggestionsReply\x12y\n\x19ValidateAlgorithmSettings\x12..api.v1.beta1.ValidateAlgorithmSettingsRequest\x1a,.api.v1.beta1.ValidateAlgorithmSettingsReply2\xe0\x02\n\rEarlyStopping\x12m\n\x15GetEarlyStoppingRules\x12*.api.v1.beta1.GetEarlyStoppingRulesRequest\x1a(.api.v1.beta1.GetEarlyStoppingRulesReply\x12X\n\x0eSetTrialStatus\x12#.api.v1.beta1.SetTrialStatusRequest\x1a!.api.v1.beta1.SetTrialStatusReply\x12\x85\x01\n\x1dValidateEarlyStoppingSettings\x12\x32.api.v1.beta1.ValidateEarlyStoppingSettingsRequest\x1a\x30.api.v1.beta1.ValidateEarlyStoppingSettingsReplyb\x06proto3')
+  ,
+  dependencies=[google_dot_protobuf_dot_wrappers__pb2.DESCRIPTOR,])
 
 _PARAMETERTYPE = _descriptor.EnumDescriptor(
   name='ParameterType',
@@ -52,8 +54,8 @@ _PARAMETERTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=4346,
-  serialized_end=4431,
+  serialized_start=5712,
+  serialized_end=5797,
 )
 _sym_db.RegisterEnumDescriptor(_PARAMETERTYPE)
 
@@ -79,8 +81,8 @@ _OBJECTIVETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=4433,
-  serialized_end=4489,
+  serialized_start=5799,
+  serialized_end=5855,
 )
 _sym_db.RegisterEnumDescriptor(_OBJECTIVETYPE)
 
@@ -110,12 +112,47 @@ _COMPARISONTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=4491,
-  serialized_end=4565,
+  serialized_start=5857,
+  serialized_end=5931,
 )
 _sym_db.RegisterEnumDescriptor(_COMPARISONTYPE)
 
 ComparisonType = enum_type_wrapper.EnumTypeWrapper(_COMPARISONTYPE)
+_EARLYSTOPPINGSTRATEGY = _descriptor.EnumDescriptor(
+  name='EarlyStoppingStrategy',
+  full_name='api.v1.beta1.EarlyStoppingStrategy',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='UNKNOWN_STRATEGY', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='MIN', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='MAX', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LATEST', index=3, number=3,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='MOVING_AVERAGE', index=4, number=4,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=5933,
+  serialized_end=6028,
+)
+_sym_db.RegisterEnumDescriptor(_EARLYSTOPPINGSTRATEGY)
+
+EarlyStoppingStrategy = enum_type_wrapper.EnumTypeWrapper(_EARLYSTOPPINGSTRATEGY)
 UNKNOWN_TYPE = 0
 DOUBLE = 1
 INT = 2
@@ -128,6 +165,11 @@ UNKNOWN_COMPARISON = 0
 EQUAL = 1
 LESS = 2
 GREATER = 3
+UNKNOWN_STRATEGY = 0
+MIN = 1
+MAX = 2
+LATEST = 3
+MOVING_AVERAGE = 4
 
 
 _TRIALSTATUS_TRIALCONDITIONTYPE = _descriptor.EnumDescriptor(
@@ -171,8 +213,8 @@ _TRIALSTATUS_TRIALCONDITIONTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=2244,
-  serialized_end=2384,
+  serialized_start=2649,
+  serialized_end=2789,
 )
 _sym_db.RegisterEnumDescriptor(_TRIALSTATUS_TRIALCONDITIONTYPE)
 
@@ -210,8 +252,8 @@ _EXPERIMENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=27,
-  serialized_end=97,
+  serialized_start=59,
+  serialized_end=129,
 )
 
 
@@ -241,8 +283,8 @@ _EXPERIMENTSPEC_PARAMETERSPECS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=441,
-  serialized_end=506,
+  serialized_start=473,
+  serialized_end=538,
 )
 
 _EXPERIMENTSPEC = _descriptor.Descriptor(
@@ -313,8 +355,8 @@ _EXPERIMENTSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=100,
-  serialized_end=506,
+  serialized_start=132,
+  serialized_end=538,
 )
 
 
@@ -358,8 +400,8 @@ _PARAMETERSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=509,
-  serialized_end=644,
+  serialized_start=541,
+  serialized_end=676,
 )
 
 
@@ -410,8 +452,8 @@ _FEASIBLESPACE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=646,
-  serialized_end=715,
+  serialized_start=678,
+  serialized_end=747,
 )
 
 
@@ -450,6 +492,20 @@ _OBJECTIVESPEC = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='additional_objectives', full_name='api.v1.beta1.ObjectiveSpec.additional_objectives', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='constraints', full_name='api.v1.beta1.ObjectiveSpec.constraints', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -462,8 +518,98 @@ _OBJECTIVESPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=718,
-  serialized_end=854,
+  serialized_start=750,
+  serialized_end=1008,
+)
+
+
+_ADDITIONALOBJECTIVE = _descriptor.Descriptor(
+  name='AdditionalObjective',
+  full_name='api.v1.beta1.AdditionalObjective',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='api.v1.beta1.AdditionalObjective.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='goal', full_name='api.v1.beta1.AdditionalObjective.goal', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='objective_metric_name', full_name='api.v1.beta1.AdditionalObjective.objective_metric_name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1011,
+  serialized_end=1150,
+)
+
+
+_OBJECTIVECONSTRAINT = _descriptor.Descriptor(
+  name='ObjectiveConstraint',
+  full_name='api.v1.beta1.ObjectiveConstraint',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='metric_name', full_name='api.v1.beta1.ObjectiveConstraint.metric_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.v1.beta1.ObjectiveConstraint.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='comparison', full_name='api.v1.beta1.ObjectiveConstraint.comparison', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1152,
+  serialized_end=1259,
 )
 
 
@@ -500,8 +646,8 @@ _ALGORITHMSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=856,
-  serialized_end=955,
+  serialized_start=1261,
+  serialized_end=1360,
 )
 
 
@@ -538,8 +684,8 @@ _ALGORITHMSETTING = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=957,
-  serialized_end=1004,
+  serialized_start=1362,
+  serialized_end=1409,
 )
 
 
@@ -576,8 +722,8 @@ _EARLYSTOPPINGSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1006,
-  serialized_end=1113,
+  serialized_start=1411,
+  serialized_end=1518,
 )
 
 
@@ -614,8 +760,8 @@ _EARLYSTOPPINGSETTING = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1115,
-  serialized_end=1166,
+  serialized_start=1520,
+  serialized_end=1571,
 )
 
 
@@ -645,8 +791,8 @@ _NASCONFIG_OPERATIONS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1287,
-  serialized_end=1343,
+  serialized_start=1692,
+  serialized_end=1748,
 )
 
 _NASCONFIG = _descriptor.Descriptor(
@@ -682,8 +828,8 @@ _NASCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1169,
-  serialized_end=1343,
+  serialized_start=1574,
+  serialized_end=1748,
 )
 
 
@@ -727,8 +873,8 @@ _GRAPHCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1345,
-  serialized_end=1421,
+  serialized_start=1750,
+  serialized_end=1826,
 )
 
 
@@ -758,8 +904,8 @@ _OPERATION_PARAMETERSPECS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=441,
-  serialized_end=506,
+  serialized_start=473,
+  serialized_end=538,
 )
 
 _OPERATION = _descriptor.Descriptor(
@@ -795,8 +941,8 @@ _OPERATION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1424,
-  serialized_end=1591,
+  serialized_start=1829,
+  serialized_end=1996,
 )
 
 
@@ -840,8 +986,8 @@ _TRIAL = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1593,
-  serialized_end=1696,
+  serialized_start=1998,
+  serialized_end=2101,
 )
 
 
@@ -871,8 +1017,8 @@ _TRIALSPEC_PARAMETERASSIGNMENTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1890,
-  serialized_end=1968,
+  serialized_start=2295,
+  serialized_end=2373,
 )
 
 _TRIALSPEC_LABELSENTRY = _descriptor.Descriptor(
@@ -908,8 +1054,8 @@ _TRIALSPEC_LABELSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1970,
-  serialized_end=2015,
+  serialized_start=2375,
+  serialized_end=2420,
 )
 
 _TRIALSPEC = _descriptor.Descriptor(
@@ -952,8 +1098,8 @@ _TRIALSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1699,
-  serialized_end=2015,
+  serialized_start=2104,
+  serialized_end=2420,
 )
 
 
@@ -990,8 +1136,8 @@ _PARAMETERASSIGNMENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2017,
-  serialized_end=2067,
+  serialized_start=2422,
+  serialized_end=2472,
 )
 
 
@@ -1043,8 +1189,8 @@ _TRIALSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2070,
-  serialized_end=2384,
+  serialized_start=2475,
+  serialized_end=2789,
 )
 
 
@@ -1074,8 +1220,8 @@ _OBSERVATION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2386,
-  serialized_end=2438,
+  serialized_start=2791,
+  serialized_end=2843,
 )
 
 
@@ -1112,8 +1258,8 @@ _METRIC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2440,
-  serialized_end=2477,
+  serialized_start=2845,
+  serialized_end=2882,
 )
 
 
@@ -1138,6 +1284,41 @@ _REPORTOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.ReportObservationLogRequest.namespace', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='experiment_name', full_name='api.v1.beta1.ReportObservationLogRequest.experiment_name', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_uid', full_name='api.v1.beta1.ReportObservationLogRequest.trial_uid', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='source_offset', full_name='api.v1.beta1.ReportObservationLogRequest.source_offset', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='source', full_name='api.v1.beta1.ReportObservationLogRequest.source', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1150,8 +1331,8 @@ _REPORTOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2479,
-  serialized_end=2583,
+  serialized_start=2885,
+  serialized_end=3091,
 )
 
 
@@ -1174,8 +1355,8 @@ _REPORTOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2585,
-  serialized_end=2612,
+  serialized_start=3093,
+  serialized_end=3120,
 )
 
 
@@ -1205,8 +1386,8 @@ _OBSERVATIONLOG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2614,
-  serialized_end=2676,
+  serialized_start=3122,
+  serialized_end=3184,
 )
 
 
@@ -1231,6 +1412,13 @@ _METRICLOG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='step', full_name='api.v1.beta1.MetricLog.step', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1243,8 +1431,8 @@ _METRICLOG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2678,
-  serialized_end=2747,
+  serialized_start=3186,
+  serialized_end=3269,
 )
 
 
@@ -1283,6 +1471,34 @@ _GETOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.GetObservationLogRequest.namespace', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='start_step', full_name='api.v1.beta1.GetObservationLogRequest.start_step', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='end_step', full_name='api.v1.beta1.GetObservationLogRequest.end_step', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='order_by_step', full_name='api.v1.beta1.GetObservationLogRequest.order_by_step', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1295,8 +1511,8 @@ _GETOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2749,
-  serialized_end=2854,
+  serialized_start=3272,
+  serialized_end=3457,
 )
 
 
@@ -1326,8 +1542,292 @@ _GETOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2856,
-  serialized_end=2935,
+  serialized_start=3459,
+  serialized_end=3538,
+)
+
+
+_GETOBSERVATIONLOGSREQUEST = _descriptor.Descriptor(
+  name='GetObservationLogsRequest',
+  full_name='api.v1.beta1.GetObservationLogsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_names', full_name='api.v1.beta1.GetObservationLogsRequest.trial_names', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='metric_names', full_name='api.v1.beta1.GetObservationLogsRequest.metric_names', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='start_time', full_name='api.v1.beta1.GetObservationLogsRequest.start_time', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='end_time', full_name='api.v1.beta1.GetObservationLogsRequest.end_time', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.GetObservationLogsRequest.namespace', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3540,
+  serialized_end=3667,
+)
+
+
+_GETOBSERVATIONLOGSREPLY = _descriptor.Descriptor(
+  name='GetObservationLogsReply',
+  full_name='api.v1.beta1.GetObservationLogsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_observation_logs', full_name='api.v1.beta1.GetObservationLogsReply.trial_observation_logs', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3669,
+  serialized_end=3761,
+)
+
+
+_TRIALOBSERVATIONLOG = _descriptor.Descriptor(
+  name='TrialObservationLog',
+  full_name='api.v1.beta1.TrialObservationLog',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.TrialObservationLog.trial_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='observation_log', full_name='api.v1.beta1.TrialObservationLog.observation_log', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3763,
+  serialized_end=3859,
+)
+
+
+_GETOBSERVATIONSUMMARYREQUEST = _descriptor.Descriptor(
+  name='GetObservationSummaryRequest',
+  full_name='api.v1.beta1.GetObservationSummaryRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.GetObservationSummaryRequest.trial_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='metric_names', full_name='api.v1.beta1.GetObservationSummaryRequest.metric_names', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.GetObservationSummaryRequest.namespace', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3861,
+  serialized_end=3952,
+)
+
+
+_GETOBSERVATIONSUMMARYREPLY = _descriptor.Descriptor(
+  name='GetObservationSummaryReply',
+  full_name='api.v1.beta1.GetObservationSummaryReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='metric_summaries', full_name='api.v1.beta1.GetObservationSummaryReply.metric_summaries', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3954,
+  serialized_end=4037,
+)
+
+
+_METRICSUMMARY = _descriptor.Descriptor(
+  name='MetricSummary',
+  full_name='api.v1.beta1.MetricSummary',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='metric_name', full_name='api.v1.beta1.MetricSummary.metric_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='min', full_name='api.v1.beta1.MetricSummary.min', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max', full_name='api.v1.beta1.MetricSummary.max', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='latest', full_name='api.v1.beta1.MetricSummary.latest', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='count', full_name='api.v1.beta1.MetricSummary.count', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='first_time_stamp', full_name='api.v1.beta1.MetricSummary.first_time_stamp', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='last_time_stamp', full_name='api.v1.beta1.MetricSummary.last_time_stamp', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='latest_step', full_name='api.v1.beta1.MetricSummary.latest_step', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4040,
+  serialized_end=4205,
 )
 
 
@@ -1345,6 +1845,13 @@ _DELETEOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.DeleteObservationLogRequest.namespace', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1357,8 +1864,8 @@ _DELETEOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2937,
-  serialized_end=2986,
+  serialized_start=4207,
+  serialized_end=4275,
 )
 
 
@@ -1381,8 +1888,8 @@ _DELETEOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2988,
-  serialized_end=3015,
+  serialized_start=4277,
+  serialized_end=4304,
 )
 
 
@@ -1440,8 +1947,8 @@ _GETSUGGESTIONSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3018,
-  serialized_end=3214,
+  serialized_start=4307,
+  serialized_end=4503,
 )
 
 
@@ -1478,8 +1985,8 @@ _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS_LABELSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1970,
-  serialized_end=2015,
+  serialized_start=2375,
+  serialized_end=2420,
 )
 
 _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS = _descriptor.Descriptor(
@@ -1522,8 +2029,8 @@ _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3439,
-  serialized_end=3668,
+  serialized_start=4728,
+  serialized_end=4957,
 )
 
 _GETSUGGESTIONSREPLY = _descriptor.Descriptor(
@@ -1566,8 +2073,8 @@ _GETSUGGESTIONSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3217,
-  serialized_end=3668,
+  serialized_start=4506,
+  serialized_end=4957,
 )
 
 
@@ -1597,8 +2104,8 @@ _VALIDATEALGORITHMSETTINGSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3670,
-  serialized_end=3750,
+  serialized_start=4959,
+  serialized_end=5039,
 )
 
 
@@ -1621,8 +2128,8 @@ _VALIDATEALGORITHMSETTINGSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3752,
-  serialized_end=3784,
+  serialized_start=5041,
+  serialized_end=5073,
 )
 
 
@@ -1666,8 +2173,8 @@ _GETEARLYSTOPPINGRULESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3787,
-  serialized_end=3928,
+  serialized_start=5076,
+  serialized_end=5217,
 )
 
 
@@ -1697,8 +2204,8 @@ _GETEARLYSTOPPINGRULESREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3930,
-  serialized_end=4021,
+  serialized_start=5219,
+  serialized_end=5310,
 )
 
 
@@ -1737,6 +2244,20 @@ _EARLYSTOPPINGRULE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='strategy', full_name='api.v1.beta1.EarlyStoppingRule.strategy', index=4,
+      number=5, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='window_size', full_name='api.v1.beta1.EarlyStoppingRule.window_size', index=5,
+      number=6, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1749,8 +2270,8 @@ _EARLYSTOPPINGRULE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4023,
-  serialized_end=4141,
+  serialized_start=5313,
+  serialized_end=5507,
 )
 
 
@@ -1780,8 +2301,8 @@ _VALIDATEEARLYSTOPPINGSETTINGSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4143,
-  serialized_end=4238,
+  serialized_start=5509,
+  serialized_end=5604,
 )
 
 
@@ -1804,8 +2325,8 @@ _VALIDATEEARLYSTOPPINGSETTINGSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4240,
-  serialized_end=4276,
+  serialized_start=5606,
+  serialized_end=5642,
 )
 
 
@@ -1835,8 +2356,8 @@ _SETTRIALSTATUSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4278,
-  serialized_end=4321,
+  serialized_start=5644,
+  serialized_end=5687,
 )
 
 
@@ -1859,8 +2380,8 @@ _SETTRIALSTATUSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4323,
-  serialized_end=4344,
+  serialized_start=5689,
+  serialized_end=5710,
 )
 
 _EXPERIMENT.fields_by_name['spec'].message_type = _EXPERIMENTSPEC
@@ -1874,6 +2395,11 @@ _EXPERIMENTSPEC.fields_by_name['nas_config'].message_type = _NASCONFIG
 _PARAMETERSPEC.fields_by_name['parameter_type'].enum_type = _PARAMETERTYPE
 _PARAMETERSPEC.fields_by_name['feasible_space'].message_type = _FEASIBLESPACE
 _OBJECTIVESPEC.fields_by_name['type'].enum_type = _OBJECTIVETYPE
+_OBJECTIVESPEC.fields_by_name['additional_objectives'].message_type = _ADDITIONALOBJECTIVE
+_OBJECTIVESPEC.fields_by_name['constraints'].message_type = _OBJECTIVECONSTRAINT
+_ADDITIONALOBJECTIVE.fields_by_name['type'].enum_type = _OBJECTIVETYPE
+_ADDITIONALOBJECTIVE.fields_by_name['goal'].message_type = google_dot_protobuf_dot_wrappers__pb2._DOUBLEVALUE
+_OBJECTIVECONSTRAINT.fields_by_name['comparison'].enum_type = _COMPARISONTYPE
 _ALGORITHMSPEC.fields_by_name['algorithm_settings'].message_type = _ALGORITHMSETTING
 _EARLYSTOPPINGSPEC.fields_by_name['algorithm_settings'].message_type = _EARLYSTOPPINGSETTING
 _NASCONFIG_OPERATIONS.fields_by_name['operation'].message_type = _OPERATION
@@ -1899,6 +2425,9 @@ _REPORTOBSERVATIONLOGREQUEST.fields_by_name['observation_log'].message_type = _O
 _OBSERVATIONLOG.fields_by_name['metric_logs'].message_type = _METRICLOG
 _METRICLOG.fields_by_name['metric'].message_type = _METRIC
 _GETOBSERVATIONLOGREPLY.fields_by_name['observation_log'].message_type = _OBSERVATIONLOG
+_GETOBSERVATIONLOGSREPLY.fields_by_name['trial_observation_logs'].message_type = _TRIALOBSERVATIONLOG
+_TRIALOBSERVATIONLOG.fields_by_name['observation_log'].message_type = _OBSERVATIONLOG
+_GETOBSERVATIONSUMMARYREPLY.fields_by_name['metric_summaries'].message_type = _METRICSUMMARY
 _GETSUGGESTIONSREQUEST.fields_by_name['experiment'].message_type = _EXPERIMENT
 _GETSUGGESTIONSREQUEST.fields_by_name['trials'].message_type = _TRIAL
 _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS_LABELSENTRY.containing_type = _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS
@@ -1913,12 +2442,15 @@ _GETEARLYSTOPPINGRULESREQUEST.fields_by_name['experiment'].message_type = _EXPER
 _GETEARLYSTOPPINGRULESREQUEST.fields_by_name['trials'].message_type = _TRIAL
 _GETEARLYSTOPPINGRULESREPLY.fields_by_name['early_stopping_rules'].message_type = _EARLYSTOPPINGRULE
 _EARLYSTOPPINGRULE.fields_by_name['comparison'].enum_type = _COMPARISONTYPE
+_EARLYSTOPPINGRULE.fields_by_name['strategy'].enum_type = _EARLYSTOPPINGSTRATEGY
 _VALIDATEEARLYSTOPPINGSETTINGSREQUEST.fields_by_name['early_stopping'].message_type = _EARLYSTOPPINGSPEC
 DESCRIPTOR.message_types_by_name['Experiment'] = _EXPERIMENT
 DESCRIPTOR.message_types_by_name['ExperimentSpec'] = _EXPERIMENTSPEC
 DESCRIPTOR.message_types_by_name['ParameterSpec'] = _PARAMETERSPEC
 DESCRIPTOR.message_types_by_name['FeasibleSpace'] = _FEASIBLESPACE
 DESCRIPTOR.message_types_by_name['ObjectiveSpec'] = _OBJECTIVESPEC
+DESCRIPTOR.message_types_by_name['AdditionalObjective'] = _ADDITIONALOBJECTIVE
+DESCRIPTOR.message_types_by_name['ObjectiveConstraint'] = _OBJECTIVECONSTRAINT
 DESCRIPTOR.message_types_by_name['AlgorithmSpec'] = _ALGORITHMSPEC
 DESCRIPTOR.message_types_by_name['AlgorithmSetting'] = _ALGORITHMSETTING
 DESCRIPTOR.message_types_by_name['EarlyStoppingSpec'] = _EARLYSTOPPINGSPEC
@@ -1938,6 +2470,12 @@ DESCRIPTOR.message_types_by_name['ObservationLog'] = _OBSERVATIONLOG
 DESCRIPTOR.message_types_by_name['MetricLog'] = _METRICLOG
 DESCRIPTOR.message_types_by_name['GetObservationLogRequest'] = _GETOBSERVATIONLOGREQUEST
 DESCRIPTOR.message_types_by_name['GetObservationLogReply'] = _GETOBSERVATIONLOGREPLY
+DESCRIPTOR.message_types_by_name['GetObservationLogsRequest'] = _GETOBSERVATIONLOGSREQUEST
+DESCRIPTOR.message_types_by_name['GetObservationLogsReply'] = _GETOBSERVATIONLOGSREPLY
+DESCRIPTOR.message_types_by_name['TrialObservationLog'] = _TRIALOBSERVATIONLOG
+DESCRIPTOR.message_types_by_name['GetObservationSummaryRequest'] = _GETOBSERVATIONSUMMARYREQUEST
+DESCRIPTOR.message_types_by_name['GetObservationSummaryReply'] = _GETOBSERVATIONSUMMARYREPLY
+DESCRIPTOR.message_types_by_name['MetricSummary'] = _METRICSUMMARY
 DESCRIPTOR.message_types_by_name['DeleteObservationLogRequest'] = _DELETEOBSERVATIONLOGREQUEST
 DESCRIPTOR.message_types_by_name['DeleteObservationLogReply'] = _DELETEOBSERVATIONLOGREPLY
 DESCRIPTOR.message_types_by_name['GetSuggestionsRequest'] = _GETSUGGESTIONSREQUEST
@@ -1954,6 +2492,7 @@ DESCRIPTOR.message_types_by_name['SetTrialStatusReply'] = _SETTRIALSTATUSREPLY
 DESCRIPTOR.enum_types_by_name['ParameterType'] = _PARAMETERTYPE
 DESCRIPTOR.enum_types_by_name['ObjectiveType'] = _OBJECTIVETYPE
 DESCRIPTOR.enum_types_by_name['ComparisonType'] = _COMPARISONTYPE
+DESCRIPTOR.enum_types_by_name['EarlyStoppingStrategy'] = _EARLYSTOPPINGSTRATEGY
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 Experiment = _reflection.GeneratedProtocolMessageType('Experiment', (_message.Message,), dict(
@@ -1999,6 +2538,20 @@ ObjectiveSpec = _reflection.GeneratedProtocolMessageType('ObjectiveSpec', (_mess
   ))
 _sym_db.RegisterMessage(ObjectiveSpec)
 
+AdditionalObjective = _reflection.GeneratedProtocolMessageType('AdditionalObjective', (_message.Message,), dict(
+  DESCRIPTOR = _ADDITIONALOBJECTIVE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.AdditionalObjective)
+  ))
+_sym_db.RegisterMessage(AdditionalObjective)
+
+ObjectiveConstraint = _reflection.GeneratedProtocolMessageType('ObjectiveConstraint', (_message.Message,), dict(
+  DESCRIPTOR = _OBJECTIVECONSTRAINT,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ObjectiveConstraint)
+  ))
+_sym_db.RegisterMessage(ObjectiveConstraint)
+
 AlgorithmSpec = _reflection.GeneratedProtocolMessageType('AlgorithmSpec', (_message.Message,), dict(
   DESCRIPTOR = _ALGORITHMSPEC,
   __module__ = 'api_pb2'
@@ -2164,6 +2717,48 @@ GetObservationLogReply = _reflection.GeneratedProtocolMessageType('GetObservatio
   ))
 _sym_db.RegisterMessage(GetObservationLogReply)
 
+GetObservationLogsRequest = _reflection.GeneratedProtocolMessageType('GetObservationLogsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETOBSERVATIONLOGSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.GetObservationLogsRequest)
+  ))
+_sym_db.RegisterMessage(GetObservationLogsRequest)
+
+GetObservationLogsReply = _reflection.GeneratedProtocolMessageType('GetObservationLogsReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETOBSERVATIONLOGSREPLY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.GetObservationLogsReply)
+  ))
+_sym_db.RegisterMessage(GetObservationLogsReply)
+
+TrialObservationLog = _reflection.GeneratedProtocolMessageType('TrialObservationLog', (_message.Message,), dict(
+  DESCRIPTOR = _TRIALOBSERVATIONLOG,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.TrialObservationLog)
+  ))
+_sym_db.RegisterMessage(TrialObservationLog)
+
+GetObservationSummaryRequest = _reflection.GeneratedProtocolMessageType('GetObservationSummaryRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETOBSERVATIONSUMMARYREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.GetObservationSummaryRequest)
+  ))
+_sym_db.RegisterMessage(GetObservationSummaryRequest)
+
+GetObservationSummaryReply = _reflection.GeneratedProtocolMessageType('GetObservationSummaryReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETOBSERVATIONSUMMARYREPLY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.GetObservationSummaryReply)
+  ))
+_sym_db.RegisterMessage(GetObservationSummaryReply)
+
+MetricSummary = _reflection.GeneratedProtocolMessageType('MetricSummary', (_message.Message,), dict(
+  DESCRIPTOR = _METRICSUMMARY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.MetricSummary)
+  ))
+_sym_db.RegisterMessage(MetricSummary)
+
 DeleteObservationLogRequest = _reflection.GeneratedProtocolMessageType('DeleteObservationLogRequest', (_message.Message,), dict(
   DESCRIPTOR = _DELETEOBSERVATIONLOGREQUEST,
   __module__ = 'api_pb2'
@@ -2285,8 +2880,8 @@ _DBMANAGER = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   options=None,
-  serialized_start=4568,
-  serialized_end=4894,
+  serialized_start=6031,
+  serialized_end=6680,
   methods=[
   _descriptor.MethodDescriptor(
     name='ReportObservationLog',
@@ -2297,19 +2892,46 @@ _DBMANAGER = _descriptor.ServiceDescriptor(
     output_type=_REPORTOBSERVATIONLOGREPLY,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='StreamObservationLog',
+    full_name='api.v1.beta1.DBManager.StreamObservationLog',
+    index=1,
+    containing_service=None,
+    input_type=_REPORTOBSERVATIONLOGREQUEST,
+    output_type=_REPORTOBSERVATIONLOGREPLY,
+    options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='GetObservationLog',
     full_name='api.v1.beta1.DBManager.GetObservationLog',
-    index=1,
+    index=2,
     containing_service=None,
     input_type=_GETOBSERVATIONLOGREQUEST,
     output_type=_GETOBSERVATIONLOGREPLY,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='GetObservationLogs',
+    full_name='api.v1.beta1.DBManager.GetObservationLogs',
+    index=3,
+    containing_service=None,
+    input_type=_GETOBSERVATIONLOGSREQUEST,
+    output_type=_GETOBSERVATIONLOGSREPLY,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetObservationSummary',
+    full_name='api.v1.beta1.DBManager.GetObservationSummary',
+    index=4,
+    containing_service=None,
+    input_type=_GETOBSERVATIONSUMMARYREQUEST,
+    output_type=_GETOBSERVATIONSUMMARYREPLY,
+    options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='DeleteObservationLog',
     full_name='api.v1.beta1.DBManager.DeleteObservationLog',
-    index=2,
+    index=5,
     containing_service=None,
     input_type=_DELETEOBSERVATIONLOGREQUEST,
     output_type=_DELETEOBSERVATIONLOGREPLY,
@@ -2327,8 +2949,8 @@ _SUGGESTION = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=1,
   options=None,
-  serialized_start=4897,
-  serialized_end=5122,
+  serialized_start=6683,
+  serialized_end=6908,
   methods=[
   _descriptor.MethodDescriptor(
     name='GetSuggestions',
@@ -2360,8 +2982,8 @@ _EARLYSTOPPING = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=2,
   options=None,
-  serialized_start=5125,
-  serialized_end=5477,
+  serialized_start=6911,
+  serialized_end=7263,
   methods=[
   _descriptor.MethodDescriptor(
     name='GetEarlyStoppingRules',
@@ -2421,11 +3043,26 @@ try:
           request_serializer=ReportObservationLogRequest.SerializeToString,
           response_deserializer=ReportObservationLogReply.FromString,
           )
+      self.StreamObservationLog = channel.stream_unary(
+          '/api.v1.beta1.DBManager/StreamObservationLog',
+          request_serializer=ReportObservationLogRequest.SerializeToString,
+          response_deserializer=ReportObservationLogReply.FromString,
+          )
       self.GetObservationLog = channel.unary_unary(
           '/api.v1.beta1.DBManager/GetObservationLog',
           request_serializer=GetObservationLogRequest.SerializeToString,
           response_deserializer=GetObservationLogReply.FromString,
           )
+      self.GetObservationLogs = channel.unary_unary(
+          '/api.v1.beta1.DBManager/GetObservationLogs',
+          request_serializer=GetObservationLogsRequest.SerializeToString,
+          response_deserializer=GetObservationLogsReply.FromString,
+          )
+      self.GetObservationSummary = channel.unary_unary(
+          '/api.v1.beta1.DBManager/GetObservationSummary',
+          request_serializer=GetObservationSummaryRequest.SerializeToString,
+          response_deserializer=GetObservationSummaryReply.FromString,
+          )
       self.DeleteObservationLog = channel.unary_unary(
           '/api.v1.beta1.DBManager/DeleteObservationLog',
           request_serializer=DeleteObservationLogRequest.SerializeToString,
@@ -2449,6 +3086,18 @@ try:
       context.set_details('Method not implemented!')
       raise NotImplementedError('Method not implemented!')
 
+    def StreamObservationLog(self, request_iterator, context):
+      """*
+      Report a log of Observations for a Trial while metrics are collected.
+      Each request in the stream carries the next part of the log.
+      Katib DB manager batches inserts and keeps the received logs even if the stream is broken.
+      It is used by the Prometheus metrics collector. File and StdOut metrics collectors report
+      the next part of the log by ReportObservationLog with the source offset instead.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
     def GetObservationLog(self, request, context):
       """*
       Get all log of Observations for a Trial.
@@ -2457,6 +3106,23 @@ try:
       context.set_details('Method not implemented!')
       raise NotImplementedError('Method not implemented!')
 
+    def GetObservationLogs(self, request, context):
+      """*
+      Get logs of Observations for many Trials and metrics in one call.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def GetObservationSummary(self, request, context):
+      """*
+      Get summary of Observations for a Trial.
+      The summary is computed by the DB and consists of min, max, latest value and number of logs for each metric.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
     def DeleteObservationLog(self, request, context):
       """*
       Delete all log of Observations for a Trial.
@@ -2473,11 +3139,26 @@ try:
             request_deserializer=ReportObservationLogRequest.FromString,
             response_serializer=ReportObservationLogReply.SerializeToString,
         ),
+        'StreamObservationLog': grpc.stream_unary_rpc_method_handler(
+            servicer.StreamObservationLog,
+            request_deserializer=ReportObservationLogRequest.FromString,
+            response_serializer=ReportObservationLogReply.SerializeToString,
+        ),
         'GetObservationLog': grpc.unary_unary_rpc_method_handler(
             servicer.GetObservationLog,
             request_deserializer=GetObservationLogRequest.FromString,
             response_serializer=GetObservationLogReply.SerializeToString,
         ),
+        'GetObservationLogs': grpc.unary_unary_rpc_method_handler(
+            servicer.GetObservationLogs,
+            request_deserializer=GetObservationLogsRequest.FromString,
+            response_serializer=GetObservationLogsReply.SerializeToString,
+        ),
+        'GetObservationSummary': grpc.unary_unary_rpc_method_handler(
+            servicer.GetObservationSummary,
+            request_deserializer=GetObservationSummaryRequest.FromString,
+            response_serializer=GetObservationSummaryReply.SerializeToString,
+        ),
         'DeleteObservationLog': grpc.unary_unary_rpc_method_handler(
             servicer.DeleteObservationLog,
             request_deserializer=DeleteObservationLogRequest.FromString,
@@ -2645,11 +3326,31 @@ try:
       You can see accuracy curve or other metric logs on UI.
       """
       context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def StreamObservationLog(self, request_iterator, context):
+      """*
+      Report a log of Observations for a Trial while metrics are collected.
+      Each request in the stream carries the next part of the log.
+      Katib DB manager batches inserts and keeps the received logs even if the stream is broken.
+      It is used by the Prometheus metrics collector. File and StdOut metrics collectors report
+      the next part of the log by ReportObservationLog with the source offset instead.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
     def GetObservationLog(self, request, context):
       """*
       Get all log of Observations for a Trial.
       """
       context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def GetObservationLogs(self, request, context):
+      """*
+      Get logs of Observations for many Trials and metrics in one call.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def GetObservationSummary(self, request, context):
+      """*
+      Get summary of Observations for a Trial.
+      The summary is computed by the DB and consists of min, max, latest value and number of logs for each metric.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
     def DeleteObservationLog(self, request, context):
       """*
       Delete all log of Observations for a Trial.
@@ -2675,12 +3376,35 @@ try:
       """
       raise NotImplementedError()
     ReportObservationLog.future = None
+    def StreamObservationLog(self, request_iterator, timeout, metadata=None, with_call=False, protocol_options=None):
+      """*
+      Report a log of Observations for a Trial while metrics are collected.
+      Each request in the stream carries the next part of the log.
+      Katib DB manager batches inserts and keeps the received logs even if the stream is broken.
+      It is used by the Prometheus metrics collector. File and StdOut metrics collectors report
+      the next part of the log by ReportObservationLog with the source offset instead.
+      """
+      raise NotImplementedError()
+    StreamObservationLog.future = None
     def GetObservationLog(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
       """*
       Get all log of Observations for a Trial.
       """
       raise NotImplementedError()
     GetObservationLog.future = None
+    def GetObservationLogs(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """*
+      Get logs of Observations for many Trials and metrics in one call.
+      """
+      raise NotImplementedError()
+    GetObservationLogs.future = None
+    def GetObservationSummary(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """*
+      Get summary of Observations for a Trial.
+      The summary is computed by the DB and consists of min, max, latest value and number of logs for each metric.
+      """
+      raise NotImplementedError()
+    GetObservationSummary.future = None
     def DeleteObservationLog(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
       """*
       Delete all log of Observations for a Trial.
@@ -2698,17 +3422,26 @@ try:
     request_deserializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogRequest.FromString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogRequest.FromString,
+      ('api.v1.beta1.DBManager', 'GetObservationLogs'): GetObservationLogsRequest.FromString,
+      ('api.v1.beta1.DBManager', 'GetObservationSummary'): GetObservationSummaryRequest.FromString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogRequest.FromString,
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): ReportObservationLogRequest.FromString,
     }
     response_serializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogReply.SerializeToString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogReply.SerializeToString,
+      ('api.v1.beta1.DBManager', 'GetObservationLogs'): GetObservationLogsReply.SerializeToString,
+      ('api.v1.beta1.DBManager', 'GetObservationSummary'): GetObservationSummaryReply.SerializeToString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogReply.SerializeToString,
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): ReportObservationLogReply.SerializeToString,
     }
     method_implementations = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): face_utilities.unary_unary_inline(servicer.DeleteObservationLog),
       ('api.v1.beta1.DBManager', 'GetObservationLog'): face_utilities.unary_unary_inline(servicer.GetObservationLog),
+      ('api.v1.beta1.DBManager', 'GetObservationLogs'): face_utilities.unary_unary_inline(servicer.GetObservationLogs),
+      ('api.v1.beta1.DBManager', 'GetObservationSummary'): face_utilities.unary_unary_inline(servicer.GetObservationSummary),
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): face_utilities.unary_unary_inline(servicer.ReportObservationLog),
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): face_utilities.stream_unary_inline(servicer.StreamObservationLog),
     }
     server_options = beta_implementations.server_options(request_deserializers=request_deserializers, response_serializers=response_serializers, thread_pool=pool, thread_pool_size=pool_size, default_timeout=default_timeout, maximum_timeout=maximum_timeout)
     return beta_implementations.server(method_implementations, options=server_options)
@@ -2723,17 +3456,26 @@ try:
     request_serializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogRequest.SerializeToString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogRequest.SerializeToString,
+      ('api.v1.beta1.DBManager', 'GetObservationLogs'): GetObservationLogsRequest.SerializeToString,
+      ('api.v1.beta1.DBManager', 'GetObservationSummary'): GetObservationSummaryRequest.SerializeToString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogRequest.SerializeToString,
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): ReportObservationLogRequest.SerializeToString,
     }
     response_deserializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogReply.FromString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogReply.FromString,
+      ('api.v1.beta1.DBManager', 'GetObservationLogs'): GetObservationLogsReply.FromString,
+      ('api.v1.beta1.DBManager', 'GetObservationSummary'): GetObservationSummaryReply.FromString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogReply.FromString,
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): ReportObservationLogReply.FromString,
     }
     cardinalities = {
       'DeleteObservationLog': cardinality.Cardinality.UNARY_UNARY,
       'GetObservationLog': cardinality.Cardinality.UNARY_UNARY,
+      'GetObservationLogs': cardinality.Cardinality.UNARY_UNARY,
+      'GetObservationSummary': cardinality.Cardinality.UNARY_UNARY,
       'ReportObservationLog': cardinality.Cardinality.UNARY_UNARY,
+      'StreamObservationLog': cardinality.Cardinality.STREAM_UNARY,
     }
     stub_options = beta_implementations.stub_options(host=host, metadata_transformer=metadata_transformer, request_serializers=request_serializers, response_deserializers=response_deserializers, thread_pool=pool, thread_pool_size=pool_size)
     return beta_implementations.dynamic_stub(channel, 'api.v1.beta1.DBManager', cardinalities, options=stub_options)
@@ -20,11 +20,26 @@ class DBManagerStub(object):
         request_serializer=api__pb2.ReportObservationLogRequest.SerializeToString,
         response_deserializer=api__pb2.ReportObservationLogReply.FromString,
         )
+    self.StreamObservationLog = channel.stream_unary(
+        '/api.v1.beta1.DBManager/StreamObservationLog',
+        request_serializer=api__pb2.ReportObservationLogRequest.SerializeToString,
+        response_deserializer=api__pb2.ReportObservationLogReply.FromString,
+        )
     self.GetObservationLog = channel.unary_unary(
         '/api.v1.beta1.DBManager/GetObservationLog',
         request_serializer=api__pb2.GetObservationLogRequest.SerializeToString,
         response_deserializer=api__pb2.GetObservationLogReply.FromString,
         )
+    self.GetObservationLogs = channel.unary_unary(
+        '/api.v1.beta1.DBManager/GetObservationLogs',
+        request_serializer=api__pb2.GetObservationLogsRequest.SerializeToString,
+        response_deserializer=api__pb2.GetObservationLogsReply.FromString,
+        )
+    self.GetObservationSummary = channel.unary_unary(
+        '/api.v1.beta1.DBManager/GetObservationSummary',
+        request_serializer=api__pb2.GetObservationSummaryRequest.SerializeToString,
+        response_deserializer=api__pb2.GetObservationSummaryReply.FromString,
+        )
     self.DeleteObservationLog = channel.unary_unary(
         '/api.v1.beta1.DBManager/DeleteObservationLog',
         request_serializer=api__pb2.DeleteObservationLogRequest.SerializeToString,
@@ -48,6 +63,17 @@ class DBManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def StreamObservationLog(self, request_iterator, context):
+    """*
+    Report a log of Observations for a Trial while metrics are collected.
+    Each request in the stream carries the next part of the log.
+    Katib DB manager batches inserts and keeps the received logs even if the stream is broken.
+    Parts with the source offset are registered once they are received, the same as by ReportObservationLog.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def GetObservationLog(self, request, context):
     """*
     Get all log of Observations for a Trial.
@@ -56,6 +82,23 @@ class DBManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def GetObservationLogs(self, request, context):
+    """*
+    Get logs of Observations for many Trials and metrics in one call.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetObservationSummary(self, request, context):
+    """*
+    Get summary of Observations for a Trial.
+    The summary is computed by the DB and consists of min, max, latest value and number of logs for each metric.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def DeleteObservationLog(self, request, context):
     """*
     Delete all log of Observations for a Trial.
@@ -72,11 +115,26 @@ def add_DBManagerServicer_to_server(servicer, server):
           request_deserializer=api__pb2.ReportObservationLogRequest.FromString,
           response_serializer=api__pb2.ReportObservationLogReply.SerializeToString,
       ),
+      'StreamObservationLog': grpc.stream_unary_rpc_method_handler(
+          servicer.StreamObservationLog,
+          request_deserializer=api__pb2.ReportObservationLogRequest.FromString,
+          response_serializer=api__pb2.ReportObservationLogReply.SerializeToString,
+      ),
       'GetObservationLog': grpc.unary_unary_rpc_method_handler(
           servicer.GetObservationLog,
           request_deserializer=api__pb2.GetObservationLogRequest.FromString,
           response_serializer=api__pb2.GetObservationLogReply.SerializeToString,
       ),
+      'GetObservationLogs': grpc.unary_unary_rpc_method_handler(
+          servicer.GetObservationLogs,
+          request_deserializer=api__pb2.GetObservationLogsRequest.FromString,
+          response_serializer=api__pb2.GetObservationLogsReply.SerializeToString,
+      ),
+      'GetObservationSummary': grpc.unary_unary_rpc_method_handler(
+          servicer.GetObservationSummary,
+          request_deserializer=api__pb2.GetObservationSummaryRequest.FromString,
+          response_serializer=api__pb2.GetObservationSummaryReply.SerializeToString,
+      ),
       'DeleteObservationLog': grpc.unary_unary_rpc_method_handler(
           servicer.DeleteObservationLog,
           request_deserializer=api__pb2.DeleteObservationLogRequest.FromString,
@@ -30,6 +30,7 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective":      schema_apis_controller_common_v1beta1_AdditionalObjective(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSetting":         schema_apis_controller_common_v1beta1_AlgorithmSetting(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec":            schema_apis_controller_common_v1beta1_AlgorithmSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.CollectorSpec":            schema_apis_controller_common_v1beta1_CollectorSpec(ref),
@@ -41,10 +42,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Metric":                   schema_apis_controller_common_v1beta1_Metric(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy":           schema_apis_controller_common_v1beta1_MetricStrategy(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec":     schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveConstraint":      schema_apis_controller_common_v1beta1_ObjectiveConstraint(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec":            schema_apis_controller_common_v1beta1_ObjectiveSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation":              schema_apis_controller_common_v1beta1_Observation(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment":      schema_apis_controller_common_v1beta1_ParameterAssignment(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.SourceSpec":               schema_apis_controller_common_v1beta1_SourceSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.BudgetSpec":          schema_apis_controller_experiments_v1beta1_BudgetSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource":     schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Experiment":          schema_apis_controller_experiments_v1beta1_Experiment(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition": schema_apis_controller_experiments_v1beta1_ExperimentCondition(ref),
@@ -57,6 +60,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Operation":           schema_apis_controller_experiments_v1beta1_Operation(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial":        schema_apis_controller_experiments_v1beta1_OptimalTrial(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec":       schema_apis_controller_experiments_v1beta1_ParameterSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.PlateauSpec":         schema_apis_controller_experiments_v1beta1_PlateauSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec":  schema_apis_controller_experiments_v1beta1_TrialParameterSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialSource":         schema_apis_controller_experiments_v1beta1_TrialSource(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate":       schema_apis_controller_experiments_v1beta1_TrialTemplate(ref),
@@ -74,6 +78,40 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_apis_controller_common_v1beta1_AdditionalObjective(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalObjective is the additional objective of the multi-objective Experiment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type for the objective optimization.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"goal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Goal is the objective goal that should be reached. Experiment goal is reached when one Trial reaches goals of all objectives which have goal.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"objectiveMetricName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectiveMetricName represents metric to optimize.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_AlgorithmSetting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -198,6 +236,20 @@ func schema_apis_controller_common_v1beta1_EarlyStoppingRule(ref common.Referenc
 							Format:      "int32",
 						},
 					},
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Strategy defines how the reported metric values are aggregated before comparison with the rule value, one of min, max, latest or movingAverage. If strategy is empty, the best value is used for the objective metric and the latest value for other metrics.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"windowSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowSize defines quantity of the latest reported values that are averaged by the movingAverage strategy. Rule is applied once WindowSize values are received.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -291,6 +343,13 @@ func schema_apis_controller_common_v1beta1_FileSystemPath(ref common.ReferenceCa
 							Format: "",
 						},
 					},
+					"timestampKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key of the metrics timestamp in JSON, CSV and LOGFMT formats. Defaults to \"timestamp\". In JSON format, it can be GJSON path, e.g. \"meta.time\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -408,6 +467,40 @@ func schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref common.Refer
 	}
 }
 
+func schema_apis_controller_common_v1beta1_ObjectiveConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectiveConstraint is the outcome constraint of the Experiment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metricName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricName contains metric name for the constraint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value contains metric value for the constraint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comparison": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comparison defines correlation between metric value and constraint value. Trial is feasible if metric value satisfies the comparison, e.g. latency < 50.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_ObjectiveSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -465,11 +558,39 @@ func schema_apis_controller_common_v1beta1_ObjectiveSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"additionalObjectives": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalObjectives represents metrics which are optimized together with the primary metric in the multi-objective Experiment. Each objective has its own type and goal. For the multi-objective Experiment status contains the Pareto front of Trials. Experiment defaulter (webhook) adds the objective metrics to AdditionalMetricNames. Additional objectives are supported only by algorithms which sample independently of objective values: random, grid and sobol.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective"),
+									},
+								},
+							},
+						},
+					},
+					"constraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraints represents outcome constraints which Trial metrics must satisfy, e.g. latency less than 50. Infeasible Trials are not considered as optimal Trials. Experiment defaulter (webhook) adds the constraint metrics to AdditionalMetricNames.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveConstraint"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveConstraint"},
 	}
 }
 
@@ -557,6 +678,54 @@ func schema_apis_controller_common_v1beta1_SourceSpec(ref common.ReferenceCallba
 	}
 }
 
+func schema_apis_controller_experiments_v1beta1_BudgetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BudgetSpec describes limits of the time and resources which can be consumed by the Experiment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxDurationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max duration in seconds of the Experiment since it was started.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxTrialRuntimeSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max cumulative runtime in seconds of all Trials. Trial runtime is the time between the Trial start and completion.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxCPUHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max cumulative CPU-hours of all Trials. CPU-hours of the Trial are derived from CPU requests of pods in the Trial run spec and the Trial runtime.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"maxGPUHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max cumulative GPU-hours of all Trials. GPU-hours of the Trial are derived from GPU requests of pods in the Trial run spec and the Trial runtime.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"killActiveTrials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KillActiveTrials indicates that pending and running Trials are killed once the budget is exhausted. Otherwise, new Trials are not created, but active Trials are running until completion.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -831,11 +1000,37 @@ func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.Refere
 							Format:      "",
 						},
 					},
+					"budget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes limits of the time and resources which can be consumed by the experiment. Experiment is succeeded once any of the limits is exceeded.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.BudgetSpec"),
+						},
+					},
+					"plateau": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes the policy to stop the experiment once the objective doesn't improve. Experiment is succeeded once the objective plateau is reached.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.PlateauSpec"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend indicates that the experiment is suspended. New trials are not created and suggestion deployment is scaled to zero while the experiment is suspended. Experiment is resumed once the flag is cleared.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspendPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how active trials are handled while the experiment is suspended. Defaults to KeepRunning.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.BudgetSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.PlateauSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate"},
 	}
 }
 
@@ -864,6 +1059,19 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"suspendTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the Experiment was suspended. It is cleared once the Experiment is resumed. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"suspendedSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total time in seconds which the Experiment was suspended before it was resumed last time. Suspended time is not counted in the Experiment budget.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of observed runtime conditions for this Experiment.",
@@ -885,6 +1093,20 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial"),
 						},
 					},
+					"paretoOptimalTrials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pareto optimal trials of the multi-objective Experiment, which are not dominated by other trials. For the multi-objective Experiment current optimal trial is the best trial by the primary objective.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial"),
+									},
+								},
+							},
+						},
+					},
 					"runningTrialList": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of trial names which are running.",
@@ -990,6 +1212,21 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							},
 						},
 					},
+					"infeasibleTrialList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of trial names which metrics don't satisfy the objective constraints. Infeasible trials are also in the list of their current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"trials": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Trials is the total number of trials owned by the experiment.",
@@ -1046,6 +1283,13 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"trialsInfeasible": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How many trials don't satisfy the objective constraints.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -1288,6 +1532,33 @@ func schema_apis_controller_experiments_v1beta1_ParameterSpec(ref common.Referen
 	}
 }
 
+func schema_apis_controller_experiments_v1beta1_PlateauSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlateauSpec describes when the Experiment objective is considered as converged. Objective plateau is reached if the best objective value of the last completed Trials is not improved by more than Delta over the best value of the previous Trials. For the multi-objective Experiment only the primary objective is considered.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trialCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of the last completed Trials which are compared with the previous Trials.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"delta": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min improvement of the best objective value which is not considered as plateau. Defaults to 0, any improvement of the best objective value is not considered as plateau.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_TrialParameterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1623,6 +1894,13 @@ func schema_apis_controller_suggestions_v1beta1_SuggestionSpec(ref common.Refere
 							Format:      "",
 						},
 					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend indicates that the experiment is suspended. Suggestion deployment is scaled to zero while suggestion is suspended.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1989,6 +2267,20 @@ func schema_apis_controller_trials_v1beta1_TrialSpec(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend indicates that the trial run object is suspended together with the experiment.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspendPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the trial run object is suspended, the run object is suspended or deleted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"metricsCollector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Describes how metrics will be collected",
@@ -2082,6 +2374,19 @@ func schema_apis_controller_trials_v1beta1_TrialStatus(ref common.ReferenceCallb
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"suspendTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the Trial was suspended. It is cleared once the Trial is resumed. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"suspendedSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total time in seconds which the Trial was suspended before it was resumed last time. Suspended time is not counted in the Experiment budget.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of observed runtime conditions for this Trial.",
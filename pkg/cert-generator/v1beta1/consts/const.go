@@ -23,4 +23,8 @@ const (
 	Secret  = "katib-webhook-cert"
 	Webhook = "katib.kubeflow.org"
 	Katib   = "katib"
+
+	DBManagerService = "katib-db-manager"
+	DBManagerClient  = "katib-db-manager-client"
+	DBManagerSecret  = "katib-db-manager-cert"
 )
@@ -22,6 +22,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/hex"
 	"github.com/kubeflow/katib/pkg/cert-generator/v1beta1/consts"
 	"github.com/spf13/cobra"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -38,10 +39,10 @@ import (
 
 // generateOptions contains values for all certificates.
 type generateOptions struct {
-	namespace         string
-	serviceName       string
-	jobName           string
-	fullServiceDomain string
+	namespace            string
+	serviceName          string
+	dbManagerServiceName string
+	jobName              string
 }
 
 // NewGenerateCmd sets up `generate` subcommand.
@@ -63,6 +64,7 @@ func NewGenerateCmd(kubeClient client.Client) *cobra.Command {
 	f.StringVarP(&o.namespace, "namespace", "n", "kubeflow", "set namespace")
 	f.StringVarP(&o.jobName, "jobName", "j", consts.JobName, "set job name")
 	f.StringVarP(&o.serviceName, "serviceName", "s", consts.Service, "set service name")
+	f.StringVarP(&o.dbManagerServiceName, "dbManagerServiceName", "d", consts.DBManagerService, "set db manager service name")
 	return cmd
 }
 
@@ -74,18 +76,51 @@ func (o *generateOptions) run(ctx context.Context, kubeClient client.Client) err
 		return err
 	}
 
-	o.fullServiceDomain = strings.Join([]string{o.serviceName, o.namespace, "svc"}, ".")
-
 	caKeyPair, err := o.createCACert()
 	if err != nil {
 		return err
 	}
-	keyPair, err := o.createCert(caKeyPair)
+	keyPair, err := o.createCert(caKeyPair, o.serviceName, x509.ExtKeyUsageServerAuth)
+	if err != nil {
+		return err
+	}
+	dbManagerKeyPair, err := o.createCert(caKeyPair, o.dbManagerServiceName, x509.ExtKeyUsageServerAuth)
+	if err != nil {
+		return err
+	}
+	dbManagerClientKeyPair, err := o.createCert(caKeyPair, consts.DBManagerClient, x509.ExtKeyUsageClientAuth)
 	if err != nil {
 		return err
 	}
 
-	if err = o.createWebhookCertSecret(ctx, kubeClient, caKeyPair, keyPair); err != nil {
+	if err = o.createCertSecret(ctx, kubeClient, consts.Secret, map[string][]byte{
+		"ca.key":  caKeyPair.keyPem,
+		"ca.crt":  caKeyPair.certPem,
+		"tls.key": keyPair.keyPem,
+		"tls.crt": keyPair.certPem,
+	}); err != nil {
+		return err
+	}
+	dbManagerToken, err := createToken()
+	if err != nil {
+		return err
+	}
+	trialTokenKey, err := createToken()
+	if err != nil {
+		return err
+	}
+	// DB Manager uses tls.crt and tls.key as the server certificate,
+	// clients use ca.crt to verify DB Manager, client.crt and client.key for mTLS and token as the bearer token.
+	// Katib controller signs and DB Manager verifies Trial tokens to push metrics with trial-token.key.
+	if err = o.createCertSecret(ctx, kubeClient, consts.DBManagerSecret, map[string][]byte{
+		"ca.crt":          caKeyPair.certPem,
+		"tls.key":         dbManagerKeyPair.keyPem,
+		"tls.crt":         dbManagerKeyPair.certPem,
+		"client.key":      dbManagerClientKeyPair.keyPem,
+		"client.crt":      dbManagerClientKeyPair.certPem,
+		"token":           dbManagerToken,
+		"trial-token.key": trialTokenKey,
+	}); err != nil {
 		return err
 	}
 	if err = o.injectCert(ctx, kubeClient, caKeyPair); err != nil {
@@ -129,22 +164,29 @@ func (o *generateOptions) createCACert() (*certificates, error) {
 }
 
 // createCert creates public certificate and private key signed with self-signed CA certificate and private key.
-func (o *generateOptions) createCert(caKeyPair *certificates) (*certificates, error) {
+// The certificate is issued for the names of the service in the namespace.
+func (o *generateOptions) createCert(caKeyPair *certificates, serviceName string, extKeyUsage x509.ExtKeyUsage) (*certificates, error) {
+	fullServiceDomain := strings.Join([]string{serviceName, o.namespace, "svc"}, ".")
+	// Every certificate signed by the CA must have the unique serial number.
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
 	now := time.Now()
 	template := &x509.Certificate{
-		SerialNumber: big.NewInt(1),
+		SerialNumber: serialNumber,
 		Subject: pkix.Name{
-			CommonName: o.fullServiceDomain,
+			CommonName: fullServiceDomain,
 		},
 		DNSNames: []string{
-			o.serviceName,
-			strings.Join([]string{o.serviceName, o.namespace}, "."),
-			o.fullServiceDomain,
+			serviceName,
+			strings.Join([]string{serviceName, o.namespace}, "."),
+			fullServiceDomain,
 		},
 		NotBefore:             now,
 		NotAfter:              now.Add(24 * time.Hour * 365 * 10),
 		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		ExtKeyUsage:           []x509.ExtKeyUsage{extKeyUsage},
 		BasicConstraintsValid: false,
 	}
 
@@ -162,8 +204,17 @@ func (o *generateOptions) createCert(caKeyPair *certificates) (*certificates, er
 	return encode(rawKey, der)
 }
 
-// createWebhookCertSecret creates Secret embedded ca.key, ca.crt, tls.key and tls.crt.
-func (o *generateOptions) createWebhookCertSecret(ctx context.Context, kubeClient client.Client, caKeyPair *certificates, keyPair *certificates) error {
+// createToken creates the random bearer token.
+func createToken() ([]byte, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+// createCertSecret creates Secret with the given certificates.
+func (o *generateOptions) createCertSecret(ctx context.Context, kubeClient client.Client, secretName string, data map[string][]byte) error {
 
 	certGeneratorJob := &batchv1.Job{}
 	if err := kubeClient.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: o.jobName}, certGeneratorJob); err != nil {
@@ -174,13 +225,13 @@ func (o *generateOptions) createWebhookCertSecret(ctx context.Context, kubeClien
 	// Add ownerReferences to clean-up secret with cert generator Job.
 	isController := true
 	jobUID := certGeneratorJob.UID
-	webhookCertSecret := &corev1.Secret{
+	certSecret := &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Secret",
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      consts.Secret,
+			Name:      secretName,
 			Namespace: o.namespace,
 			OwnerReferences: []metav1.OwnerReference{
 				{
@@ -193,16 +244,11 @@ func (o *generateOptions) createWebhookCertSecret(ctx context.Context, kubeClien
 			},
 		},
 		Type: corev1.SecretTypeTLS,
-		Data: map[string][]byte{
-			"ca.key":  caKeyPair.keyPem,
-			"ca.crt":  caKeyPair.certPem,
-			"tls.key": keyPair.keyPem,
-			"tls.crt": keyPair.certPem,
-		},
+		Data: data,
 	}
 
 	oldSecret := &corev1.Secret{}
-	err := kubeClient.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: consts.Secret}, oldSecret)
+	err := kubeClient.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: secretName}, oldSecret)
 	switch {
 	case err != nil && !k8serrors.IsNotFound(err):
 		return err
@@ -213,8 +259,8 @@ func (o *generateOptions) createWebhookCertSecret(ctx context.Context, kubeClien
 		}
 	}
 
-	klog.Infof("Creating Secret: %s", consts.Secret)
-	if err = kubeClient.Create(ctx, webhookCertSecret); err != nil {
+	klog.Infof("Creating Secret: %s", secretName)
+	if err = kubeClient.Create(ctx, certSecret); err != nil {
 		return err
 	}
 	return nil
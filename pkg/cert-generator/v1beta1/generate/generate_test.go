@@ -17,6 +17,9 @@ limitations under the License.
 package generate
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"github.com/kubeflow/katib/pkg/cert-generator/v1beta1/consts"
 	admissionregistration "k8s.io/api/admissionregistration/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -179,6 +182,105 @@ func TestGenerate(t *testing.T) {
 
 }
 
+func TestGenerateDBManagerCert(t *testing.T) {
+
+	const testNamespace = "test"
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.JobName, Namespace: testNamespace, UID: "test"},
+		},
+		&admissionregistration.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.Webhook},
+			Webhooks: []admissionregistration.ValidatingWebhook{
+				{Name: strings.Join([]string{"validator.experiment", consts.Webhook}, ".")},
+			},
+		},
+		&admissionregistration.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.Webhook},
+			Webhooks: []admissionregistration.MutatingWebhook{
+				{Name: strings.Join([]string{"defaulter.experiment", consts.Webhook}, ".")},
+				{Name: strings.Join([]string{"mutator.pod", consts.Webhook}, ".")},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.Service, Namespace: testNamespace},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.DBManagerSecret, Namespace: testNamespace},
+		},
+	).Build()
+	cmd := NewGenerateCmd(fakeClient)
+	if err := cmd.Flags().Set("namespace", testNamespace); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: testNamespace, Name: consts.DBManagerSecret}, secret); err != nil {
+		t.Fatalf("Failed to get Secret %s: %v", consts.DBManagerSecret, err)
+	}
+	if _, ok := secret.Data["ca.key"]; ok {
+		t.Errorf("Secret %s must not contain CA private key", consts.DBManagerSecret)
+	}
+	if len(secret.Data["token"]) == 0 {
+		t.Errorf("Secret %s must contain bearer token", consts.DBManagerSecret)
+	}
+	if len(secret.Data["trial-token.key"]) == 0 || string(secret.Data["trial-token.key"]) == string(secret.Data["token"]) {
+		t.Errorf("Secret %s must contain Trial token key which differs from bearer token", consts.DBManagerSecret)
+	}
+	if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].Name != consts.JobName {
+		t.Errorf("Secret %s must be owned by Job %s, got: %v", consts.DBManagerSecret, consts.JobName, secret.OwnerReferences)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(secret.Data["ca.crt"]) {
+		t.Fatalf("Failed to parse ca.crt")
+	}
+	certTests := []struct {
+		testDescription string
+		certFile        string
+		keyFile         string
+		dnsName         string
+		extKeyUsage     x509.ExtKeyUsage
+	}{
+		{
+			testDescription: "Server certificate for katib-db-manager",
+			certFile:        "tls.crt",
+			keyFile:         "tls.key",
+			dnsName:         strings.Join([]string{consts.DBManagerService, testNamespace, "svc"}, "."),
+			extKeyUsage:     x509.ExtKeyUsageServerAuth,
+		},
+		{
+			testDescription: "Client certificate for katib-db-manager",
+			certFile:        "client.crt",
+			keyFile:         "client.key",
+			extKeyUsage:     x509.ExtKeyUsageClientAuth,
+		},
+	}
+	for _, test := range certTests {
+		t.Run(test.testDescription, func(t *testing.T) {
+			keyPair, err := tls.X509KeyPair(secret.Data[test.certFile], secret.Data[test.keyFile])
+			if err != nil {
+				t.Fatalf("Failed to load key pair: %v", err)
+			}
+			cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+			if err != nil {
+				t.Fatalf("Failed to parse certificate: %v", err)
+			}
+			if _, err = cert.Verify(x509.VerifyOptions{
+				DNSName:   test.dnsName,
+				Roots:     roots,
+				KeyUsages: []x509.ExtKeyUsage{test.extKeyUsage},
+			}); err != nil {
+				t.Errorf("Certificate is not signed by ca.crt: %v", err)
+			}
+		})
+	}
+}
+
 func executeGeneratorCommand(kubeResources []client.Object, namespace string) error {
 
 	fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
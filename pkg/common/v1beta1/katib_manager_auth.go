@@ -0,0 +1,329 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	bearerPrefix             = "Bearer "
+	// Health checks are allowed without the token, so probes work without credentials.
+	healthServicePrefix = "/grpc.health.v1.Health/"
+)
+
+// NewDBManagerServerCredentials loads the TLS credentials of Katib DB Manager server.
+// If clientCAFile is set, clients must present a certificate signed by this CA (mTLS).
+// If clientCertOptional is set, clients without certificate are accepted as well, so metrics collectors
+// can connect with Trial tokens. DBManagerServerAuth must require client certificate for other calls then.
+func NewDBManagerServerCredentials(certFile, keyFile, clientCAFile string, clientCertOptional bool) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load server certificate: %v", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		pool, err := loadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		if clientCertOptional {
+			config.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// DBManagerServerAuth authenticates calls of Katib DB Manager.
+// Katib components call DB Manager with the admin bearer token and the client certificate.
+// Metrics collectors call DB Manager with the Trial token, which allows only to report and delete
+// observation logs of the token Trial.
+type DBManagerServerAuth struct {
+	// Token is the admin bearer token. If it is empty, the admin calls don't need the token.
+	Token string
+	// RequireClientCert requires the verified client certificate for the admin calls.
+	RequireClientCert bool
+	// TrialTokenKey is the key to verify Trial tokens. If it is empty, Trial tokens are rejected.
+	TrialTokenKey []byte
+	// CheckTrial is called for the calls with Trial token, e.g. to reject metrics of the completed Trials.
+	// It returns the gRPC status error.
+	CheckTrial func(ctx context.Context, claims *TrialTokenClaims) error
+
+	now func() time.Time
+}
+
+// UnaryServerInterceptor rejects unary calls which are not authenticated or are out of the Trial token scope.
+func (a *DBManagerServerAuth) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+		claims, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if claims != nil {
+			if err = checkTrialTokenScope(req, claims); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streaming calls which are not authenticated.
+// Every message of the call with Trial token must be in the token scope.
+func (a *DBManagerServerAuth) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(srv, ss)
+		}
+		claims, err := a.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		if claims != nil {
+			ss = &trialTokenServerStream{ServerStream: ss, claims: claims}
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authenticate returns the Trial token claims for the call with Trial token and nil for the admin call.
+func (a *DBManagerServerAuth) authenticate(ctx context.Context) (*TrialTokenClaims, error) {
+	var tokens []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(authorizationMetadataKey) {
+			if strings.HasPrefix(value, bearerPrefix) {
+				tokens = append(tokens, strings.TrimPrefix(value, bearerPrefix))
+			}
+		}
+	}
+
+	for _, token := range tokens {
+		if a.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.Token)) == 1 {
+			return nil, a.checkClientCert(ctx)
+		}
+	}
+	if len(tokens) != 0 && len(a.TrialTokenKey) != 0 {
+		now := time.Now
+		if a.now != nil {
+			now = a.now
+		}
+		claims, err := VerifyTrialToken(a.TrialTokenKey, tokens[0], now())
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if a.CheckTrial != nil {
+			if err = a.CheckTrial(ctx, claims); err != nil {
+				return nil, err
+			}
+		}
+		return claims, nil
+	}
+	if a.Token != "" {
+		if len(tokens) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "Bearer token is missing")
+		}
+		return nil, status.Error(codes.Unauthenticated, "Bearer token is invalid")
+	}
+	return nil, a.checkClientCert(ctx)
+}
+
+func (a *DBManagerServerAuth) checkClientCert(ctx context.Context) error {
+	if !a.RequireClientCert {
+		return nil
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) != 0 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "Client certificate is required")
+}
+
+// checkTrialTokenScope returns PermissionDenied error if the request is not allowed with the Trial token.
+// Only observation logs of the token Trial can be reported and deleted.
+func checkTrialTokenScope(req interface{}, claims *TrialTokenClaims) error {
+	switch r := req.(type) {
+	case *api_pb.ReportObservationLogRequest:
+		if r.TrialName != claims.TrialName || r.Namespace != claims.Namespace ||
+			(r.ExperimentName != "" && r.ExperimentName != claims.ExperimentName) ||
+			(r.TrialUid != "" && r.TrialUid != claims.TrialUID) {
+			return status.Errorf(codes.PermissionDenied, "Token is not valid for Trial %s/%s", r.Namespace, r.TrialName)
+		}
+	case *api_pb.DeleteObservationLogRequest:
+		if r.TrialName != claims.TrialName || r.Namespace != claims.Namespace {
+			return status.Errorf(codes.PermissionDenied, "Token is not valid for Trial %s/%s", r.Namespace, r.TrialName)
+		}
+	default:
+		return status.Error(codes.PermissionDenied, "Trial token is valid only to report and delete observation logs")
+	}
+	return nil
+}
+
+// trialTokenServerStream checks that every received message is in the Trial token scope.
+type trialTokenServerStream struct {
+	grpc.ServerStream
+	claims *TrialTokenClaims
+}
+
+func (s *trialTokenServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkTrialTokenScope(m, s.claims)
+}
+
+// tokenCredentials attaches the bearer token to every call.
+// The token is never sent over the insecure connection.
+type tokenCredentials struct {
+	token string
+}
+
+func (c tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationMetadataKey: bearerPrefix + c.token,
+	}, nil
+}
+
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// DBManagerClientOptionsFromEnv returns dial options to connect to the secured Katib DB Manager.
+// TLS is enabled by the KATIB_DB_MANAGER_TLS_CA_FILE env, client certificate for mTLS is set by the
+// KATIB_DB_MANAGER_TLS_CERT_FILE and KATIB_DB_MANAGER_TLS_KEY_FILE envs and the bearer token is read
+// from the KATIB_DB_MANAGER_TOKEN_FILE env. Metrics collectors use the Trial token from the KATIB_TRIAL_TOKEN env
+// as the bearer token instead. The token can be used only with TLS.
+// Without these envs the connection stays insecure.
+func DBManagerClientOptionsFromEnv() ([]grpc.DialOption, error) {
+	var opts []grpc.DialOption
+	caFile := os.Getenv(consts.KatibDBManagerTLSCAFileEnvName)
+	certFile := os.Getenv(consts.KatibDBManagerTLSCertFileEnvName)
+	keyFile := os.Getenv(consts.KatibDBManagerTLSKeyFileEnvName)
+	tokenFile := os.Getenv(consts.KatibDBManagerTokenFileEnvName)
+	trialToken := os.Getenv(consts.KatibTrialTokenEnvName)
+
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config := &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+		if certFile != "" || keyFile != "" {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to load client certificate: %v", err)
+			}
+			config.Certificates = []tls.Certificate{cert}
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	} else if certFile != "" || keyFile != "" {
+		return nil, fmt.Errorf("%s must be set to use client certificate", consts.KatibDBManagerTLSCAFileEnvName)
+	}
+
+	if tokenFile != "" || trialToken != "" {
+		if caFile == "" {
+			return nil, fmt.Errorf("%s must be set to use bearer token, token can't be sent over insecure connection",
+				consts.KatibDBManagerTLSCAFileEnvName)
+		}
+		token := trialToken
+		if tokenFile != "" {
+			var err error
+			if token, err = ReadTokenFile(tokenFile); err != nil {
+				return nil, err
+			}
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{
+			token: token,
+		}))
+	}
+	return opts, nil
+}
+
+// DBManagerCAFromEnv reads the CA certificate to verify Katib DB Manager which is set by the env,
+// so it can be passed to metrics collectors in the Trial namespaces. It returns nil if TLS is not used.
+// Client certificate and bearer token are never passed, metrics collectors use Trial tokens instead.
+func DBManagerCAFromEnv() ([]byte, error) {
+	caFile := os.Getenv(consts.KatibDBManagerTLSCAFileEnvName)
+	if caFile == "" {
+		return nil, nil
+	}
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read %s: %v", caFile, err)
+	}
+	return ca, nil
+}
+
+// GetDBManagerClientSecretName returns the name of the Secret with Katib DB Manager CA certificate for the Trial.
+func GetDBManagerClientSecretName(trialName string) string {
+	return trialName + consts.DBManagerClientSecretSuffix
+}
+
+// ReadTokenFile reads the bearer token from the file. Surrounding whitespaces are trimmed.
+func ReadTokenFile(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read token file: %v", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("Token file %s is empty", path)
+	}
+	return token, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caPem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read CA certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPem) {
+		return nil, fmt.Errorf("Failed to parse CA certificate %s", caFile)
+	}
+	return pool, nil
+}
@@ -0,0 +1,527 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	health_pb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const testToken = "test-token"
+
+type testCerts struct {
+	caFile         string
+	serverCertFile string
+	serverKeyFile  string
+	clientCertFile string
+	clientKeyFile  string
+}
+
+func TestTokenAuth(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := writeTestFile(t, dir, "token", []byte(testToken+"\n"))
+	certs := generateTestCerts(t, dir)
+	creds, err := NewDBManagerServerCredentials(certs.serverCertFile, certs.serverKeyFile, "", false)
+	if err != nil {
+		t.Fatalf("Failed to load server credentials: %v", err)
+	}
+	auth := &DBManagerServerAuth{Token: testToken}
+	addr := startTestServer(t,
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(auth.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor()),
+	)
+
+	testCases := []struct {
+		name         string
+		tokenFile    string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Call with valid token",
+			tokenFile:    tokenFile,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Call with invalid token",
+			tokenFile:    writeTestFile(t, dir, "invalid-token", []byte("invalid")),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Call without token",
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, certs.caFile)
+			t.Setenv(consts.KatibDBManagerTokenFileEnvName, tc.tokenFile)
+			c := newTestClient(t, addr)
+			_, err := c.GetObservationLog(context.Background(), &api_pb.GetObservationLogRequest{TrialName: "test-trial"})
+			if code := status.Code(err); code != tc.expectedCode {
+				t.Errorf("Expected code %v, got %v", tc.expectedCode, err)
+			}
+
+			stream, err := c.StreamObservationLog(context.Background())
+			if err != nil {
+				t.Fatalf("Failed to open stream: %v", err)
+			}
+			_, err = stream.CloseAndRecv()
+			if code := status.Code(err); code != tc.expectedCode {
+				t.Errorf("Expected stream code %v, got %v", tc.expectedCode, err)
+			}
+		})
+	}
+
+	t.Run("Token without TLS", func(t *testing.T) {
+		t.Setenv(consts.KatibDBManagerTokenFileEnvName, tokenFile)
+		if _, err := DBManagerClientOptionsFromEnv(); err == nil {
+			t.Errorf("Expected error for token without TLS")
+		}
+	})
+
+	t.Run("Health check without token", func(t *testing.T) {
+		clientCreds, err := credentials.NewClientTLSFromFile(certs.caFile, "")
+		if err != nil {
+			t.Fatalf("Failed to load client credentials: %v", err)
+		}
+		conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(clientCreds))
+		if err != nil {
+			t.Fatalf("Failed to dial: %v", err)
+		}
+		defer conn.Close()
+		if _, err = health_pb.NewHealthClient(conn).Check(context.Background(), &health_pb.HealthCheckRequest{}); err != nil {
+			t.Errorf("Health check failed: %v", err)
+		}
+	})
+}
+
+func TestMutualTLSAuth(t *testing.T) {
+	certs := generateTestCerts(t, t.TempDir())
+	creds, err := NewDBManagerServerCredentials(certs.serverCertFile, certs.serverKeyFile, certs.caFile, false)
+	if err != nil {
+		t.Fatalf("Failed to load server credentials: %v", err)
+	}
+	addr := startTestServer(t, grpc.Creds(creds))
+
+	t.Run("Call with client certificate", func(t *testing.T) {
+		t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, certs.caFile)
+		t.Setenv(consts.KatibDBManagerTLSCertFileEnvName, certs.clientCertFile)
+		t.Setenv(consts.KatibDBManagerTLSKeyFileEnvName, certs.clientKeyFile)
+		c := newTestClient(t, addr)
+		if _, err := c.GetObservationLog(context.Background(), &api_pb.GetObservationLogRequest{TrialName: "test-trial"}); err != nil {
+			t.Errorf("Call failed: %v", err)
+		}
+	})
+
+	t.Run("Call without client certificate", func(t *testing.T) {
+		t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, certs.caFile)
+		c := newTestClient(t, addr)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if _, err := c.GetObservationLog(ctx, &api_pb.GetObservationLogRequest{TrialName: "test-trial"}); err == nil {
+			t.Errorf("Expected error for call without client certificate")
+		}
+	})
+
+	t.Run("Client certificate without CA", func(t *testing.T) {
+		t.Setenv(consts.KatibDBManagerTLSCertFileEnvName, certs.clientCertFile)
+		t.Setenv(consts.KatibDBManagerTLSKeyFileEnvName, certs.clientKeyFile)
+		if _, err := DBManagerClientOptionsFromEnv(); err == nil {
+			t.Errorf("Expected error for client certificate without CA")
+		}
+	})
+}
+
+func TestTrialTokenAuth(t *testing.T) {
+	dir := t.TempDir()
+	certs := generateTestCerts(t, dir)
+	tokenFile := writeTestFile(t, dir, "token", []byte(testToken))
+	key := []byte("test-key")
+	now := time.Unix(1000, 0)
+	creds, err := NewDBManagerServerCredentials(certs.serverCertFile, certs.serverKeyFile, certs.caFile, true)
+	if err != nil {
+		t.Fatalf("Failed to load server credentials: %v", err)
+	}
+	auth := &DBManagerServerAuth{
+		Token:             testToken,
+		RequireClientCert: true,
+		TrialTokenKey:     key,
+		CheckTrial: func(ctx context.Context, claims *TrialTokenClaims) error {
+			if claims.TrialName == "completed-trial" {
+				return status.Error(codes.PermissionDenied, "Trial is completed")
+			}
+			return nil
+		},
+		now: func() time.Time { return now },
+	}
+	addr := startTestServer(t,
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(auth.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor()),
+	)
+
+	newTrialToken := func(trialName string, expiresAt time.Time) string {
+		token, err := NewTrialToken(key, TrialTokenClaims{
+			Namespace:      "test-ns",
+			ExperimentName: "test-experiment",
+			TrialName:      trialName,
+			TrialUID:       "test-uid",
+			ExpiresAt:      expiresAt.Unix(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to create Trial token: %v", err)
+		}
+		return token
+	}
+	validToken := newTrialToken("test-trial", now.Add(time.Hour))
+	reportRequest := func(namespace, trialName, trialUID string) *api_pb.ReportObservationLogRequest {
+		return &api_pb.ReportObservationLogRequest{
+			Namespace:      namespace,
+			TrialName:      trialName,
+			TrialUid:       trialUID,
+			ObservationLog: &api_pb.ObservationLog{},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		trialToken   string
+		call         func(c api_pb.DBManagerClient) error
+		expectedCode codes.Code
+	}{
+		{
+			name:       "Report logs of the token Trial",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.ReportObservationLog(context.Background(), reportRequest("test-ns", "test-trial", "test-uid"))
+				return err
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:       "Report logs without Trial UID",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.ReportObservationLog(context.Background(), reportRequest("test-ns", "test-trial", ""))
+				return err
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:       "Report logs of another Trial",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.ReportObservationLog(context.Background(), reportRequest("test-ns", "another-trial", ""))
+				return err
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "Report logs of the Trial in another namespace",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.ReportObservationLog(context.Background(), reportRequest("another-ns", "test-trial", ""))
+				return err
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "Report logs of the Trial with another UID",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.ReportObservationLog(context.Background(), reportRequest("test-ns", "test-trial", "another-uid"))
+				return err
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "Delete logs of the token Trial",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.DeleteObservationLog(context.Background(),
+					&api_pb.DeleteObservationLogRequest{Namespace: "test-ns", TrialName: "test-trial"})
+				return err
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:       "Delete logs without namespace",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.DeleteObservationLog(context.Background(), &api_pb.DeleteObservationLogRequest{TrialName: "test-trial"})
+				return err
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "Get logs of the token Trial",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.GetObservationLog(context.Background(),
+					&api_pb.GetObservationLogRequest{Namespace: "test-ns", TrialName: "test-trial"})
+				return err
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "Stream logs of the token Trial",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				return streamTestRequests(c, reportRequest("test-ns", "test-trial", ""), reportRequest("test-ns", "test-trial", ""))
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:       "Stream logs of another Trial",
+			trialToken: validToken,
+			call: func(c api_pb.DBManagerClient) error {
+				return streamTestRequests(c, reportRequest("test-ns", "test-trial", ""), reportRequest("test-ns", "another-trial", ""))
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "Expired Trial token",
+			trialToken: newTrialToken("test-trial", now),
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.ReportObservationLog(context.Background(), reportRequest("test-ns", "test-trial", ""))
+				return err
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:       "Trial token of the completed Trial",
+			trialToken: newTrialToken("completed-trial", now.Add(time.Hour)),
+			call: func(c api_pb.DBManagerClient) error {
+				_, err := c.ReportObservationLog(context.Background(), reportRequest("test-ns", "completed-trial", ""))
+				return err
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, certs.caFile)
+			t.Setenv(consts.KatibTrialTokenEnvName, tc.trialToken)
+			c := newTestClient(t, addr)
+			if code := status.Code(tc.call(c.DBManagerClient)); code != tc.expectedCode {
+				t.Errorf("Expected code %v, got %v", tc.expectedCode, code)
+			}
+		})
+	}
+
+	t.Run("Admin token with client certificate", func(t *testing.T) {
+		t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, certs.caFile)
+		t.Setenv(consts.KatibDBManagerTLSCertFileEnvName, certs.clientCertFile)
+		t.Setenv(consts.KatibDBManagerTLSKeyFileEnvName, certs.clientKeyFile)
+		t.Setenv(consts.KatibDBManagerTokenFileEnvName, tokenFile)
+		c := newTestClient(t, addr)
+		if _, err := c.GetObservationLog(context.Background(), &api_pb.GetObservationLogRequest{TrialName: "test-trial"}); err != nil {
+			t.Errorf("Call failed: %v", err)
+		}
+	})
+
+	t.Run("Admin token without client certificate", func(t *testing.T) {
+		t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, certs.caFile)
+		t.Setenv(consts.KatibDBManagerTokenFileEnvName, tokenFile)
+		c := newTestClient(t, addr)
+		_, err := c.GetObservationLog(context.Background(), &api_pb.GetObservationLogRequest{TrialName: "test-trial"})
+		if code := status.Code(err); code != codes.Unauthenticated {
+			t.Errorf("Expected code %v, got %v", codes.Unauthenticated, err)
+		}
+	})
+
+	t.Run("Trial token without TLS", func(t *testing.T) {
+		t.Setenv(consts.KatibTrialTokenEnvName, validToken)
+		if _, err := DBManagerClientOptionsFromEnv(); err == nil {
+			t.Errorf("Expected error for Trial token without TLS")
+		}
+	})
+}
+
+func TestDBManagerCAFromEnv(t *testing.T) {
+	certs := generateTestCerts(t, t.TempDir())
+
+	t.Run("Insecure connection", func(t *testing.T) {
+		ca, err := DBManagerCAFromEnv()
+		if err != nil || ca != nil {
+			t.Errorf("Expected nil CA, got %v, error %v", ca, err)
+		}
+	})
+
+	t.Run("TLS", func(t *testing.T) {
+		t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, certs.caFile)
+		ca, err := DBManagerCAFromEnv()
+		if err != nil {
+			t.Fatalf("Failed to read CA: %v", err)
+		}
+		expected, _ := ioutil.ReadFile(certs.caFile)
+		if string(ca) != string(expected) {
+			t.Errorf("Expected CA %s, got %s", expected, ca)
+		}
+	})
+}
+
+func streamTestRequests(c api_pb.DBManagerClient, requests ...*api_pb.ReportObservationLogRequest) error {
+	stream, err := c.StreamObservationLog(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, req := range requests {
+		if err = stream.Send(req); err != nil {
+			break
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+type fakeAuthDBManagerServer struct {
+	api_pb.DBManagerServer
+}
+
+func (s *fakeAuthDBManagerServer) GetObservationLog(ctx context.Context, in *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
+	return &api_pb.GetObservationLogReply{}, nil
+}
+
+func (s *fakeAuthDBManagerServer) ReportObservationLog(ctx context.Context, in *api_pb.ReportObservationLogRequest) (*api_pb.ReportObservationLogReply, error) {
+	return &api_pb.ReportObservationLogReply{}, nil
+}
+
+func (s *fakeAuthDBManagerServer) DeleteObservationLog(ctx context.Context, in *api_pb.DeleteObservationLogRequest) (*api_pb.DeleteObservationLogReply, error) {
+	return &api_pb.DeleteObservationLogReply{}, nil
+}
+
+func (s *fakeAuthDBManagerServer) StreamObservationLog(stream api_pb.DBManager_StreamObservationLogServer) error {
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			return stream.SendAndClose(&api_pb.ReportObservationLogReply{})
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func startTestServer(t *testing.T, opts ...grpc.ServerOption) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer(opts...)
+	api_pb.RegisterDBManagerServer(s, &fakeAuthDBManagerServer{})
+	health_pb.RegisterHealthServer(s, health.NewServer())
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+	return listener.Addr().String()
+}
+
+func newTestClient(t *testing.T, addr string) *KatibDBManagerClient {
+	opts, err := DBManagerClientOptionsFromEnv()
+	if err != nil {
+		t.Fatalf("Failed to get client options: %v", err)
+	}
+	c, err := NewKatibDBManagerClient(addr, opts...)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// generateTestCerts creates CA, server certificate for 127.0.0.1 and client certificate in the dir.
+func generateTestCerts(t *testing.T, dir string) *testCerts {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(caDer)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+
+	issue := func(name string, serial int64, extKeyUsage x509.ExtKeyUsage) (string, string) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("Failed to generate key: %v", err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatalf("Failed to create certificate: %v", err)
+		}
+		certFile := writeTestFile(t, dir, name+".crt", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+		keyFile := writeTestFile(t, dir, name+".key", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+		return certFile, keyFile
+	}
+
+	certs := &testCerts{
+		caFile: writeTestFile(t, dir, "ca.crt", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer})),
+	}
+	certs.serverCertFile, certs.serverKeyFile = issue("server", 2, x509.ExtKeyUsageServerAuth)
+	certs.clientCertFile, certs.clientKeyFile = issue("client", 3, x509.ExtKeyUsageClientAuth)
+	return certs
+}
+
+func writeTestFile(t *testing.T, dir, name string, data []byte) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+	return path
+}
@@ -18,17 +18,38 @@ package v1beta1
 
 import (
 	"context"
+	"os"
+	"time"
 
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 )
 
-type katibDBManagerClientAndConn struct {
-	Conn                 *grpc.ClientConn
-	KatibDBManagerClient api_pb.DBManagerClient
+const (
+	// DBManagerKeepaliveTime is the interval of keepalive pings from clients to Katib DB Manager.
+	// DB Manager must permit pings with this interval, otherwise it closes the connection.
+	DBManagerKeepaliveTime = 30 * time.Second
+	// DBManagerKeepaliveTimeout is the time to wait for the keepalive ping ack before the connection is closed.
+	DBManagerKeepaliveTimeout = 10 * time.Second
+	// DBManagerCallTimeout is the deadline of a single call to Katib DB Manager including all retries.
+	DBManagerCallTimeout = 60 * time.Second
+	// DBManagerRetryBackoff is the base of the exponential backoff between retries of unavailable calls.
+	DBManagerRetryBackoff = 100 * time.Millisecond
+)
+
+// KatibDBManagerClient is a client of Katib DB Manager which keeps one long-lived connection.
+// The connection is shared by all calls and re-established by gRPC when it's broken.
+// Every call gets the DBManagerCallTimeout deadline, unless the context already has a deadline.
+// Idempotent calls are retried with the exponential backoff while DB Manager is unavailable.
+type KatibDBManagerClient struct {
+	api_pb.DBManagerClient
+	conn *grpc.ClientConn
 }
 
 // GetDBManagerAddr returns address of Katib DB Manager
@@ -44,41 +65,97 @@ func GetDBManagerAddr() string {
 	return dbManagerIP + ":" + dbManagerPort
 }
 
-func getKatibDBManagerClientAndConn() (*katibDBManagerClientAndConn, error) {
-	addr := GetDBManagerAddr()
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return nil, err
+// GetDBManagerPushURL returns URL of Katib DB Manager HTTP endpoint to push metrics
+// HTTP endpoint uses the same TLS certificate as gRPC server, so HTTPS is used if TLS connection to DB Manager is used.
+func GetDBManagerPushURL() string {
+	dbManagerNS := consts.DefaultKatibDBManagerServiceNamespace
+	dbManagerIP := consts.DefaultKatibDBManagerServiceIP
+	dbManagerPort := consts.DefaultKatibDBManagerServiceHTTPPort
+
+	scheme := "http://"
+	if os.Getenv(consts.KatibDBManagerTLSCAFileEnvName) != "" {
+		scheme = "https://"
 	}
-	kcc := &katibDBManagerClientAndConn{
-		Conn:                 conn,
-		KatibDBManagerClient: api_pb.NewDBManagerClient(conn),
+	if len(dbManagerNS) != 0 {
+		return scheme + dbManagerIP + "." + dbManagerNS + ":" + dbManagerPort + consts.KatibMetricsPushPath
 	}
-	return kcc, nil
-}
 
-func closeKatibDBManagerConnection(kcc *katibDBManagerClientAndConn) {
-	kcc.Conn.Close()
+	return scheme + dbManagerIP + ":" + dbManagerPort + consts.KatibMetricsPushPath
 }
 
-func GetObservationLog(request *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
-	ctx := context.Background()
-	kcc, err := getKatibDBManagerClientAndConn()
+// NewKatibDBManagerClient creates a client of Katib DB Manager with the given address.
+// It doesn't wait for the connection, so DB Manager doesn't have to be ready.
+// Additional dial options are applied after the default ones.
+func NewKatibDBManagerClient(addr string, opts ...grpc.DialOption) (*KatibDBManagerClient, error) {
+	retryOpts := []grpc_retry.CallOption{
+		grpc_retry.WithBackoff(grpc_retry.BackoffExponential(DBManagerRetryBackoff)),
+		grpc_retry.WithMax(consts.DefaultGRPCRetryAttempts),
+		grpc_retry.WithCodes(codes.Unavailable),
+	}
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                DBManagerKeepaliveTime,
+			Timeout:             DBManagerKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		// Deadline interceptor is the outer one, so it limits the call with all retries.
+		grpc.WithChainUnaryInterceptor(
+			deadlineUnaryClientInterceptor(DBManagerCallTimeout),
+			idempotentRetryUnaryClientInterceptor(retryOpts...),
+		),
+	}, opts...)
+	conn, err := grpc.Dial(addr, dialOpts...)
 	if err != nil {
 		return nil, err
 	}
-	defer closeKatibDBManagerConnection(kcc)
-	kc := kcc.KatibDBManagerClient
-	return kc.GetObservationLog(ctx, request)
+	return &KatibDBManagerClient{
+		DBManagerClient: api_pb.NewDBManagerClient(conn),
+		conn:            conn,
+	}, nil
 }
 
-func DeleteObservationLog(request *api_pb.DeleteObservationLogRequest) (*api_pb.DeleteObservationLogReply, error) {
-	ctx := context.Background()
-	kcc, err := getKatibDBManagerClientAndConn()
-	if err != nil {
-		return nil, err
+// Close closes the connection to Katib DB Manager.
+func (c *KatibDBManagerClient) Close() error {
+	return c.conn.Close()
+}
+
+// deadlineUnaryClientInterceptor sets the timeout for calls with the context without deadline.
+func deadlineUnaryClientInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// idempotentRetryUnaryClientInterceptor retries only calls which can be sent again without duplicating logs.
+// DB Manager can register logs before the connection is broken, so reports are retried only with the source offset,
+// since logs are registered once for the offset. StreamObservationLog is not intercepted and never retried.
+func idempotentRetryUnaryClientInterceptor(opts ...grpc_retry.CallOption) grpc.UnaryClientInterceptor {
+	retryInterceptor := grpc_retry.UnaryClientInterceptor(opts...)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		if !isIdempotentRequest(req) {
+			return invoker(ctx, method, req, reply, cc, callOpts...)
+		}
+		return retryInterceptor(ctx, method, req, reply, cc, invoker, callOpts...)
+	}
+}
+
+// isIdempotentRequest checks if the request of Katib DB Manager can be retried.
+func isIdempotentRequest(req interface{}) bool {
+	switch r := req.(type) {
+	case *api_pb.GetObservationLogRequest, *api_pb.GetObservationLogsRequest,
+		*api_pb.GetObservationSummaryRequest, *api_pb.DeleteObservationLogRequest:
+		return true
+	case *api_pb.ReportObservationLogRequest:
+		return r.Source != "" && r.SourceOffset > 0
+	default:
+		return false
 	}
-	defer closeKatibDBManagerConnection(kcc)
-	kc := kcc.KatibDBManagerClient
-	return kc.DeleteObservationLog(ctx, request)
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+type fakeDBManagerServer struct {
+	api_pb.DBManagerServer
+	unavailableCalls int
+	calls            int
+}
+
+func (s *fakeDBManagerServer) DeleteObservationLog(ctx context.Context, in *api_pb.DeleteObservationLogRequest) (*api_pb.DeleteObservationLogReply, error) {
+	s.calls++
+	if s.calls <= s.unavailableCalls {
+		return nil, status.Error(codes.Unavailable, "DB is not ready")
+	}
+	return &api_pb.DeleteObservationLogReply{}, nil
+}
+
+func (s *fakeDBManagerServer) ReportObservationLog(ctx context.Context, in *api_pb.ReportObservationLogRequest) (*api_pb.ReportObservationLogReply, error) {
+	s.calls++
+	if s.calls <= s.unavailableCalls {
+		return nil, status.Error(codes.Unavailable, "connection is broken")
+	}
+	return &api_pb.ReportObservationLogReply{}, nil
+}
+
+func (s *fakeDBManagerServer) GetObservationLog(ctx context.Context, in *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
+	s.calls++
+	return nil, status.Error(codes.NotFound, "Trial is not found")
+}
+
+func TestKatibDBManagerClient(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	fakeServer := &fakeDBManagerServer{}
+	s := grpc.NewServer()
+	api_pb.RegisterDBManagerServer(s, fakeServer)
+	go s.Serve(listener)
+	defer s.Stop()
+
+	c, err := NewKatibDBManagerClient(listener.Addr().String())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer c.Close()
+
+	testCases := []struct {
+		name             string
+		unavailableCalls int
+		call             func() error
+		expectedCode     codes.Code
+		expectedCalls    int
+	}{
+		{
+			name:             "Unavailable calls are retried",
+			unavailableCalls: 2,
+			call: func() error {
+				_, err := c.DeleteObservationLog(context.Background(), &api_pb.DeleteObservationLogRequest{TrialName: "test-trial"})
+				return err
+			},
+			expectedCode:  codes.OK,
+			expectedCalls: 3,
+		},
+		{
+			name:             "Reports with source offset are retried",
+			unavailableCalls: 1,
+			call: func() error {
+				_, err := c.ReportObservationLog(context.Background(), &api_pb.ReportObservationLogRequest{
+					TrialName:    "test-trial",
+					Source:       "test-pod",
+					SourceOffset: 10,
+				})
+				return err
+			},
+			expectedCode:  codes.OK,
+			expectedCalls: 2,
+		},
+		{
+			name:             "Reports without source offset are not retried",
+			unavailableCalls: 1,
+			call: func() error {
+				_, err := c.ReportObservationLog(context.Background(), &api_pb.ReportObservationLogRequest{TrialName: "test-trial"})
+				return err
+			},
+			expectedCode:  codes.Unavailable,
+			expectedCalls: 1,
+		},
+		{
+			name: "Other errors are not retried",
+			call: func() error {
+				_, err := c.GetObservationLog(context.Background(), &api_pb.GetObservationLogRequest{TrialName: "test-trial"})
+				return err
+			},
+			expectedCode:  codes.NotFound,
+			expectedCalls: 1,
+		},
+	}
+	for _, tc := range testCases {
+		fakeServer.calls = 0
+		fakeServer.unavailableCalls = tc.unavailableCalls
+		err := tc.call()
+		if status.Code(err) != tc.expectedCode {
+			t.Errorf("Case %v failed. Expected code %v, got %v", tc.name, tc.expectedCode, status.Code(err))
+		}
+		if fakeServer.calls != tc.expectedCalls {
+			t.Errorf("Case %v failed. Expected %v calls, got %v", tc.name, tc.expectedCalls, fakeServer.calls)
+		}
+	}
+}
+
+func TestDeadlineUnaryClientInterceptor(t *testing.T) {
+	interceptor := deadlineUnaryClientInterceptor(time.Minute)
+	parentDeadline := time.Now().Add(time.Second)
+	parentCtx, cancel := context.WithDeadline(context.Background(), parentDeadline)
+	defer cancel()
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		validate func(deadline time.Time) bool
+	}{
+		{
+			name: "Context without deadline",
+			ctx:  context.Background(),
+			validate: func(deadline time.Time) bool {
+				return time.Until(deadline) > time.Second && time.Until(deadline) <= time.Minute
+			},
+		},
+		{
+			name: "Context with deadline",
+			ctx:  parentCtx,
+			validate: func(deadline time.Time) bool {
+				return deadline.Equal(parentDeadline)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			deadline, ok := ctx.Deadline()
+			if !ok || !tc.validate(deadline) {
+				t.Errorf("Case %v failed. Unexpected deadline %v", tc.name, deadline)
+			}
+			return nil
+		}
+		if err := interceptor(tc.ctx, "/api.v1.beta1.DBManager/GetObservationLog", nil, nil, nil, invoker); err != nil {
+			t.Errorf("Case %v failed. Unexpected error %v", tc.name, err)
+		}
+	}
+}
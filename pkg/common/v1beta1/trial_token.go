@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TrialTokenClaims identifies the Trial which is allowed to report metrics with the Trial token.
+type TrialTokenClaims struct {
+	Namespace      string `json:"namespace"`
+	ExperimentName string `json:"experimentName"`
+	TrialName      string `json:"trialName"`
+	TrialUID       string `json:"trialUid"`
+	// ExpiresAt is the Unix time in seconds after which the token is rejected.
+	ExpiresAt int64 `json:"exp"`
+}
+
+// NewTrialToken returns the Trial token for the claims signed by the key.
+// Token is in the "<base64 claims>.<base64 signature>" format, so it's verified by the key without the storage.
+func NewTrialToken(key []byte, claims TrialTokenClaims) (string, error) {
+	if len(key) == 0 {
+		return "", fmt.Errorf("Trial token key is empty")
+	}
+	if claims.ExpiresAt == 0 {
+		return "", fmt.Errorf("Trial token expiration time is empty")
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	return encodedPayload + "." + base64.RawURLEncoding.EncodeToString(signTrialToken(key, encodedPayload)), nil
+}
+
+// VerifyTrialToken checks the token signature by the key and the token expiration time at now
+// and returns the Trial claims of the token.
+func VerifyTrialToken(key []byte, token string, now time.Time) (*TrialTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Trial token is malformed")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("Trial token is malformed")
+	}
+	if !hmac.Equal(signature, signTrialToken(key, parts[0])) {
+		return nil, fmt.Errorf("Trial token signature is invalid")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("Trial token is malformed")
+	}
+	claims := &TrialTokenClaims{}
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("Trial token is malformed")
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, fmt.Errorf("Trial token is expired")
+	}
+	return claims, nil
+}
+
+func signTrialToken(key []byte, encodedPayload string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(encodedPayload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTrialToken(t *testing.T) {
+	key := []byte("test-key")
+	now := time.Now()
+	claims := TrialTokenClaims{
+		Namespace:      "kubeflow",
+		ExperimentName: "random-experiment",
+		TrialName:      "random-experiment-abc",
+		TrialUID:       "a1b2c3",
+		ExpiresAt:      now.Add(time.Hour).Unix(),
+	}
+	token, err := NewTrialToken(key, claims)
+	if err != nil {
+		t.Fatalf("NewTrialToken failed: %v", err)
+	}
+	otherToken, err := NewTrialToken(key, TrialTokenClaims{Namespace: "kubeflow", TrialName: "other-trial", ExpiresAt: claims.ExpiresAt})
+	if err != nil {
+		t.Fatalf("NewTrialToken failed: %v", err)
+	}
+
+	testCases := []struct {
+		description string
+		key         []byte
+		token       string
+		now         time.Time
+		err         bool
+	}{
+		{
+			description: "Valid token",
+			key:         key,
+			token:       token,
+			now:         now,
+		},
+		{
+			description: "Expired token",
+			key:         key,
+			token:       token,
+			now:         now.Add(time.Hour),
+			err:         true,
+		},
+		{
+			description: "Token is signed by another key",
+			key:         []byte("other-key"),
+			token:       token,
+			err:         true,
+		},
+		{
+			description: "Claims of another token",
+			key:         key,
+			token:       strings.Split(otherToken, ".")[0] + "." + strings.Split(token, ".")[1],
+			err:         true,
+		},
+		{
+			description: "Malformed token",
+			key:         key,
+			token:       "invalid",
+			err:         true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			actual, err := VerifyTrialToken(tc.key, tc.token, tc.now)
+			if tc.err {
+				if err == nil {
+					t.Errorf("Expected error, got claims %v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyTrialToken failed: %v", err)
+			}
+			if *actual != claims {
+				t.Errorf("Expected claims %v, got %v", claims, *actual)
+			}
+		})
+	}
+
+	if _, err = NewTrialToken(nil, claims); err == nil {
+		t.Errorf("Expected error for empty key")
+	}
+	if _, err = NewTrialToken(key, TrialTokenClaims{Namespace: "kubeflow", TrialName: "other-trial"}); err == nil {
+		t.Errorf("Expected error for empty expiration time")
+	}
+}
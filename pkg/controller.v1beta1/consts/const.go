@@ -48,6 +48,11 @@ const (
 	// ConfigTrialResources is the config name which indicates
 	// resources list which can be used as trial template
 	ConfigTrialResources = "trial-resources"
+	// ConfigTrialTokenKeyFile is the config name of the key file to sign Trial tokens.
+	// Trial tokens are injected only if this file is set.
+	ConfigTrialTokenKeyFile = "trial-token-key-file"
+	// ConfigTrialTokenLifetime is the config name of the time after which Trial tokens expire.
+	ConfigTrialTokenLifetime = "trial-token-lifetime"
 
 	// LabelExperimentName is the label of experiment name.
 	LabelExperimentName = "katib.kubeflow.org/experiment"
@@ -56,6 +61,11 @@ const (
 	// LabelDeploymentName is the label of deployment name.
 	LabelDeploymentName = "katib.kubeflow.org/deployment"
 
+	// AnnotationPodLogsCollected is the annotation of the Trial with PodLogs metrics collector
+	// which is set once metrics are collected from logs of the terminated primary container.
+	// Value is the pod name and the restart count of the container which logs are collected.
+	AnnotationPodLogsCollected = "katib.kubeflow.org/pod-logs-collected"
+
 	// ContainerSuggestion is the container name to run Suggestion service.
 	ContainerSuggestion = "suggestion"
 	// ContainerEarlyStopping is the container name to run EarlyStopping service.
@@ -92,6 +102,40 @@ const (
 	DefaultKatibDBManagerServiceIPEnvName = "KATIB_DB_MANAGER_SERVICE_IP"
 	// DefaultKatibDBManagerServicePortEnvName is the env name of Katib DB Manager Port
 	DefaultKatibDBManagerServicePortEnvName = "KATIB_DB_MANAGER_SERVICE_PORT"
+	// KatibDBManagerTLSCAFileEnvName is the env name of the CA certificate file to verify Katib DB Manager.
+	// TLS connection to Katib DB Manager is used only if this env is set.
+	KatibDBManagerTLSCAFileEnvName = "KATIB_DB_MANAGER_TLS_CA_FILE"
+	// KatibDBManagerTLSCertFileEnvName is the env name of the client certificate file for Katib DB Manager mTLS
+	KatibDBManagerTLSCertFileEnvName = "KATIB_DB_MANAGER_TLS_CERT_FILE"
+	// KatibDBManagerTLSKeyFileEnvName is the env name of the client key file for Katib DB Manager mTLS
+	KatibDBManagerTLSKeyFileEnvName = "KATIB_DB_MANAGER_TLS_KEY_FILE"
+	// KatibDBManagerTokenFileEnvName is the env name of the bearer token file for Katib DB Manager
+	KatibDBManagerTokenFileEnvName = "KATIB_DB_MANAGER_TOKEN_FILE"
+
+	// DBManagerClientSecretSuffix is the suffix of the Secret with Katib DB Manager CA certificate for the Trial.
+	// Secret is created in the Trial namespace and mounted to the metrics collector if Katib DB Manager uses TLS.
+	DBManagerClientSecretSuffix = "-db-manager-client"
+	// DBManagerClientVolumeName is the name of the volume with Katib DB Manager CA certificate
+	DBManagerClientVolumeName = "katib-db-manager-client"
+	// DBManagerClientMountPath is the path where Katib DB Manager CA certificate is mounted in the metrics collector
+	DBManagerClientMountPath = "/var/run/secrets/katib/db-manager"
+	// DBManagerClientCAKey is the key of the CA certificate in the Secret for the Trial
+	DBManagerClientCAKey = "ca.crt"
+	// DefaultKatibDBManagerServiceHTTPPortEnvName is the env name of Katib DB Manager HTTP Port
+	DefaultKatibDBManagerServiceHTTPPortEnvName = "KATIB_DB_MANAGER_SERVICE_HTTP_PORT"
+
+	// KatibTrialNameEnvName is the env name of the Trial name in the Trial primary container.
+	KatibTrialNameEnvName = "KATIB_TRIAL_NAME"
+	// KatibTrialTokenEnvName is the env name of the Trial token to push metrics to Katib DB Manager.
+	// Metrics collectors use it as the bearer token to report metrics of the Trial over gRPC.
+	KatibTrialTokenEnvName = "KATIB_TRIAL_TOKEN"
+	// KatibMetricsPushURLEnvName is the env name of Katib DB Manager URL to push metrics.
+	KatibMetricsPushURLEnvName = "KATIB_METRICS_PUSH_URL"
+	// KatibMetricsPushCAFileEnvName is the env name of the CA certificate file to verify Katib DB Manager HTTPS endpoint.
+	// It is set only if Katib DB Manager uses TLS.
+	KatibMetricsPushCAFileEnvName = "KATIB_METRICS_PUSH_CA_FILE"
+	// KatibMetricsPushPath is the HTTP path of Katib DB Manager to push metrics.
+	KatibMetricsPushPath = "/api/v1beta1/observation_log"
 
 	// KatibConfigMapName is the configmap name which includes Katib's configuration.
 	KatibConfigMapName = "katib-config"
@@ -101,6 +145,10 @@ const (
 	LabelMetricsCollectorSidecar = "metrics-collector-sidecar"
 	// LabelEarlyStoppingTag is the name of early stopping config in Katib configmap.
 	LabelEarlyStoppingTag = "early-stopping"
+	// LabelDBManagerTag is the name of DB manager config in Katib configmap.
+	LabelDBManagerTag = "db-manager"
+	// DefaultObservationLogRetentionInterval is the default interval of observation log garbage collection.
+	DefaultObservationLogRetentionInterval = time.Hour
 	// DefaultImagePullPolicy is the default value for image pull policy.
 	DefaultImagePullPolicy = corev1.PullIfNotPresent
 	// DefaultCPULimit is the default value for CPU limit.
@@ -182,6 +230,8 @@ var (
 	DefaultKatibDBManagerServiceIP = env.GetEnvOrDefault(DefaultKatibDBManagerServiceIPEnvName, "katib-db-manager")
 	// DefaultKatibDBManagerServicePort is the default Port of Katib DB Manager
 	DefaultKatibDBManagerServicePort = env.GetEnvOrDefault(DefaultKatibDBManagerServicePortEnvName, "6789")
+	// DefaultKatibDBManagerServiceHTTPPort is the default HTTP Port of Katib DB Manager to push metrics
+	DefaultKatibDBManagerServiceHTTPPort = env.GetEnvOrDefault(DefaultKatibDBManagerServiceHTTPPortEnvName, "6790")
 
 	// List of all valid keys of trial metadata for substitution in Trial template
 	TrialTemplateMetaKeys = []string{
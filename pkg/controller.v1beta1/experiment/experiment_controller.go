@@ -203,7 +203,10 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 		if (util.IsCompletedExperimentRestartable(instance) &&
 			instance.Spec.MaxTrialCount != nil &&
 			*instance.Spec.MaxTrialCount > instance.Status.Trials) ||
-			(instance.Spec.MaxTrialCount == nil && instance.Status.Trials != 0) {
+			// Experiment with exhausted budget or reached plateau can't be restarted.
+			(instance.Spec.MaxTrialCount == nil && instance.Status.Trials != 0 &&
+				!instance.IsCompletedReason(util.ExperimentBudgetExhaustedReason) &&
+				!instance.IsCompletedReason(util.ExperimentPlateauReachedReason)) {
 			logger.Info("Experiment is restarting",
 				"MaxTrialCount", instance.Spec.MaxTrialCount,
 				"ParallelTrialCount", instance.Spec.ParallelTrialCount,
@@ -257,7 +260,9 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 		}
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{
+		RequeueAfter: util.GetBudgetRequeueAfter(instance),
+	}, nil
 }
 
 // ReconcileExperiment is the main reconcile loop.
@@ -270,13 +275,36 @@ func (r *ReconcileExperiment) ReconcileExperiment(instance *experimentsv1beta1.E
 		logger.Error(err, "Trial List error")
 		return err
 	}
+	isCompleted := instance.IsCompleted()
 	if len(trials.Items) > 0 {
 		if err := util.UpdateExperimentStatus(r.collector, instance, trials); err != nil {
 			logger.Error(err, "Update experiment status error")
 			return err
 		}
+	} else if !isCompleted && (instance.Spec.Suspend || instance.IsSuspended()) {
+		util.UpdateExperimentSuspendCondition(instance)
 	}
-	reconcileRequired := !instance.IsCompleted()
+	// Record event once the objective plateau is reached.
+	if !isCompleted && instance.IsCompletedReason(util.ExperimentPlateauReachedReason) {
+		for _, condition := range instance.Status.Conditions {
+			if condition.Type == experimentsv1beta1.ExperimentSucceeded {
+				r.recorder.Event(instance, corev1.EventTypeNormal, util.ExperimentPlateauReachedReason, condition.Message)
+			}
+		}
+	}
+	// Active Trials are killed once the Experiment budget is exhausted.
+	if instance.IsCompletedReason(util.ExperimentBudgetExhaustedReason) && instance.Spec.Budget != nil && instance.Spec.Budget.KillActiveTrials {
+		if err := r.killActiveTrials(instance, trials.Items); err != nil {
+			logger.Error(err, "Kill active trials error")
+			return err
+		}
+	}
+	if err := r.reconcileSuspend(instance, trials.Items); err != nil {
+		logger.Error(err, "Reconcile suspend error")
+		return err
+	}
+	// New Trials are not created while the Experiment is suspended.
+	reconcileRequired := !instance.IsCompleted() && !instance.Spec.Suspend
 	if reconcileRequired {
 		return r.ReconcileTrials(instance, trials.Items)
 	}
@@ -455,6 +483,31 @@ func (r *ReconcileExperiment) deleteTrials(instance *experimentsv1beta1.Experime
 	return nil
 }
 
+// killActiveTrials marks pending and running Trials as killed.
+// Trial controller deletes jobs of the killed Trials, unless Trial resources are retained.
+func (r *ReconcileExperiment) killActiveTrials(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) error {
+	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	var killedNames []string
+	for i := range trials {
+		if trials[i].IsCompleted() {
+			continue
+		}
+		trial := trials[i].DeepCopy()
+		now := metav1.Now()
+		trial.Status.CompletionTime = &now
+		trial.MarkTrialStatusKilled(util.ExperimentBudgetExhaustedReason, "Trial is killed because Experiment budget is exhausted")
+		if err := r.Status().Update(context.TODO(), trial); err != nil {
+			logger.Error(err, "Trial status update error", "Trial name", trial.Name)
+			return err
+		}
+		killedNames = append(killedNames, trial.Name)
+	}
+	if len(killedNames) != 0 {
+		logger.Info("Killed Trials", "trialNames", killedNames)
+	}
+	return nil
+}
+
 // ReconcileSuggestions gets or creates the suggestion if needed.
 func (r *ReconcileExperiment) ReconcileSuggestions(instance *experimentsv1beta1.Experiment, trialList []trialsv1beta1.Trial, addCount int32) ([]suggestionsv1beta1.TrialAssignment, error) {
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
@@ -204,3 +205,55 @@ func (r *ReconcileExperiment) restartSuggestion(instance *experimentsv1beta1.Exp
 	}
 	return nil
 }
+
+// reconcileSuspend propagates suspend of the Experiment to the active Trials and to the Suggestion.
+// Active Trials are suspended only if the suspend policy is SuspendJobs or DeleteJobs,
+// with KeepRunning policy they are running until completion.
+func (r *ReconcileExperiment) reconcileSuspend(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) error {
+	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	suspend := instance.Spec.Suspend && !instance.IsCompleted()
+
+	trialSuspend := suspend && (instance.Spec.SuspendPolicy == commonv1beta1.SuspendPolicySuspendJobs ||
+		instance.Spec.SuspendPolicy == commonv1beta1.SuspendPolicyDeleteJobs)
+	var updatedNames []string
+	for i := range trials {
+		// Suspend policy is kept once the Trial is resumed, so Trial controller can resume the suspended job.
+		if trials[i].IsCompleted() || (trials[i].Spec.Suspend == trialSuspend &&
+			(!trialSuspend || trials[i].Spec.SuspendPolicy == instance.Spec.SuspendPolicy)) {
+			continue
+		}
+		trial := trials[i].DeepCopy()
+		trial.Spec.Suspend = trialSuspend
+		if trialSuspend {
+			trial.Spec.SuspendPolicy = instance.Spec.SuspendPolicy
+		}
+		if err := r.Update(context.TODO(), trial); err != nil {
+			logger.Error(err, "Trial update error", "Trial name", trial.Name)
+			return err
+		}
+		updatedNames = append(updatedNames, trial.Name)
+	}
+	if len(updatedNames) != 0 {
+		logger.Info("Updated Trials suspend", "suspend", trialSuspend, "trialNames", updatedNames)
+	}
+
+	original := &suggestionsv1beta1.Suggestion{}
+	err := r.Get(context.TODO(),
+		types.NamespacedName{Namespace: instance.GetNamespace(), Name: instance.GetName()}, original)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if original.Spec.Suspend == suspend {
+		return nil
+	}
+	suggestion := original.DeepCopy()
+	suggestion.Spec.Suspend = suspend
+	if err := r.UpdateSuggestion(suggestion); err != nil {
+		return err
+	}
+	logger.Info("Updated Suggestion suspend", "suspend", suspend)
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+const (
+	// BudgetCheckInterval is the max interval between checks of the running Experiment budget.
+	BudgetCheckInterval = time.Minute
+)
+
+// budgetUsage is the time and resources which are consumed by the Experiment Trials.
+type budgetUsage struct {
+	trialRuntime time.Duration
+	cpuHours     float64
+	gpuHours     float64
+}
+
+// getBudgetExhaustedMessage returns the message with the exceeded limit of the Experiment budget.
+// It returns empty message if the budget is not exhausted.
+func getBudgetExhaustedMessage(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList, now time.Time) string {
+	budget := instance.Spec.Budget
+	if budget == nil {
+		return ""
+	}
+	if budget.MaxDurationSeconds != nil && instance.Status.StartTime != nil &&
+		getExperimentDuration(instance, now) >= time.Duration(*budget.MaxDurationSeconds)*time.Second {
+		return fmt.Sprintf("Experiment has succeeded because budget is exhausted: max duration of %d seconds has reached",
+			*budget.MaxDurationSeconds)
+	}
+
+	usage := getBudgetUsage(trials, now)
+	if budget.MaxTrialRuntimeSeconds != nil && usage.trialRuntime >= time.Duration(*budget.MaxTrialRuntimeSeconds)*time.Second {
+		return fmt.Sprintf("Experiment has succeeded because budget is exhausted: max trial runtime of %d seconds has reached",
+			*budget.MaxTrialRuntimeSeconds)
+	}
+	if budget.MaxCPUHours != nil && usage.cpuHours >= *budget.MaxCPUHours {
+		return fmt.Sprintf("Experiment has succeeded because budget is exhausted: max %v CPU-hours has reached", *budget.MaxCPUHours)
+	}
+	if budget.MaxGPUHours != nil && usage.gpuHours >= *budget.MaxGPUHours {
+		return fmt.Sprintf("Experiment has succeeded because budget is exhausted: max %v GPU-hours has reached", *budget.MaxGPUHours)
+	}
+	return ""
+}
+
+// GetBudgetRequeueAfter returns the duration after which budget of the running Experiment must be checked again.
+// Budget is consumed by the running Trials without any events, so the Experiment is periodically reconciled.
+// It returns zero if the Experiment doesn't have budget or it is completed.
+func GetBudgetRequeueAfter(instance *experimentsv1beta1.Experiment) time.Duration {
+	if instance.Spec.Budget == nil || instance.IsCompleted() {
+		return 0
+	}
+	requeueAfter := BudgetCheckInterval
+	if instance.Spec.Budget.MaxDurationSeconds != nil && instance.Status.StartTime != nil {
+		untilDeadline := time.Duration(*instance.Spec.Budget.MaxDurationSeconds)*time.Second - getExperimentDuration(instance, time.Now())
+		if untilDeadline > 0 && untilDeadline < requeueAfter {
+			requeueAfter = untilDeadline
+		}
+	}
+	return requeueAfter
+}
+
+func getBudgetUsage(trials *trialsv1beta1.TrialList, now time.Time) budgetUsage {
+	var usage budgetUsage
+	for i := range trials.Items {
+		trial := &trials.Items[i]
+		trialRuntime := getTrialRuntime(trial, now)
+		if trialRuntime <= 0 {
+			continue
+		}
+		usage.trialRuntime += trialRuntime
+		cpu, gpu := getTrialResourceRequests(trial)
+		usage.cpuHours += cpu * trialRuntime.Hours()
+		usage.gpuHours += gpu * trialRuntime.Hours()
+	}
+	return usage
+}
+
+// getExperimentDuration returns the time since the Experiment start excluding the time when the Experiment was suspended.
+func getExperimentDuration(instance *experimentsv1beta1.Experiment, now time.Time) time.Duration {
+	suspended := getSuspendedDuration(instance.Status.SuspendTime, instance.Status.SuspendedSeconds, now)
+	return now.Sub(instance.Status.StartTime.Time) - suspended
+}
+
+// getTrialRuntime returns the time between the Trial start and completion excluding the time when the Trial was suspended.
+// For the active Trial runtime is counted until now.
+func getTrialRuntime(trial *trialsv1beta1.Trial, now time.Time) time.Duration {
+	if trial.Status.StartTime == nil {
+		return 0
+	}
+	if !trial.IsCompleted() {
+		return now.Sub(trial.Status.StartTime.Time) - getSuspendedDuration(trial.Status.SuspendTime, trial.Status.SuspendedSeconds, now)
+	}
+	if trial.Status.CompletionTime == nil || trial.Status.CompletionTime.IsZero() {
+		return 0
+	}
+	completionTime := trial.Status.CompletionTime.Time
+	return completionTime.Sub(trial.Status.StartTime.Time) - getSuspendedDuration(trial.Status.SuspendTime, trial.Status.SuspendedSeconds, completionTime)
+}
+
+// getSuspendedDuration returns the total suspended time of the Experiment or the Trial until the given time.
+// suspendTime is set if the object is suspended now.
+func getSuspendedDuration(suspendTime *metav1.Time, suspendedSeconds int64, until time.Time) time.Duration {
+	suspended := time.Duration(suspendedSeconds) * time.Second
+	if suspendTime != nil && until.After(suspendTime.Time) {
+		suspended += until.Sub(suspendTime.Time)
+	}
+	return suspended
+}
+
+// getTrialResourceRequests returns CPU and GPU requests of all pods in the Trial run spec.
+// Pod templates are found in any Trial job, e.g. in batch Job spec or in TFJob replica specs.
+func getTrialResourceRequests(trial *trialsv1beta1.Trial) (float64, float64) {
+	if trial.Spec.RunSpec == nil {
+		return 0, 0
+	}
+	return getPodTemplatesRequests(trial.Spec.RunSpec.Object)
+}
+
+func getPodTemplatesRequests(obj interface{}) (float64, float64) {
+	var cpu, gpu float64
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		if template, ok := o["template"].(map[string]interface{}); ok {
+			if podSpec, ok := template["spec"].(map[string]interface{}); ok {
+				if _, ok := podSpec["containers"]; ok {
+					replicas := getReplicas(o)
+					podCPU, podGPU := getPodSpecRequests(podSpec)
+					return podCPU * replicas, podGPU * replicas
+				}
+			}
+		}
+		for _, v := range o {
+			c, g := getPodTemplatesRequests(v)
+			cpu += c
+			gpu += g
+		}
+	case []interface{}:
+		for _, v := range o {
+			c, g := getPodTemplatesRequests(v)
+			cpu += c
+			gpu += g
+		}
+	}
+	return cpu, gpu
+}
+
+// getReplicas returns number of pods which are created from the pod template, e.g. replicas of TFJob or parallelism of batch Job.
+func getReplicas(spec map[string]interface{}) float64 {
+	for _, key := range []string{"replicas", "parallelism"} {
+		switch replicas := spec[key].(type) {
+		case int64:
+			return float64(replicas)
+		case float64:
+			return replicas
+		}
+	}
+	return 1
+}
+
+// getPodSpecRequests returns CPU and GPU requests of the pod containers.
+// If the container request is not set, limit is used as Kubernetes does.
+func getPodSpecRequests(podSpecObj map[string]interface{}) (float64, float64) {
+	podSpec := &corev1.PodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecObj, podSpec); err != nil {
+		return 0, 0
+	}
+	var cpu, gpu float64
+	for _, c := range podSpec.Containers {
+		resources := corev1.ResourceList{}
+		for name, quantity := range c.Resources.Limits {
+			resources[name] = quantity
+		}
+		for name, quantity := range c.Resources.Requests {
+			resources[name] = quantity
+		}
+		for name, quantity := range resources {
+			if name == corev1.ResourceCPU {
+				cpu += quantity.AsApproximateFloat64()
+			} else if strings.HasSuffix(string(name), "/gpu") {
+				gpu += quantity.AsApproximateFloat64()
+			}
+		}
+	}
+	return cpu, gpu
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func TestGetBudgetExhaustedMessage(t *testing.T) {
+	now := time.Now()
+	maxDurationSeconds := int64(3600)
+	maxTrialRuntimeSeconds := int64(3 * 3600)
+	maxCPUHours := 5.0
+	maxGPUHours := 2.0
+
+	// Each Trial requests 2 CPUs and 1 GPU.
+	runSpec := newFakeBatchJob(1, "2", "1")
+	trials := &trialsv1beta1.TrialList{
+		Items: []trialsv1beta1.Trial{
+			// Completed Trial with 1 hour runtime.
+			newFakeBudgetTrial("trial-1", runSpec, now.Add(-3*time.Hour), now.Add(-2*time.Hour)),
+			// Running Trial with 30 minutes runtime.
+			newFakeBudgetTrial("trial-2", runSpec, now.Add(-30*time.Minute), time.Time{}),
+		},
+	}
+
+	testCases := []struct {
+		description      string
+		budget           *experimentsv1beta1.BudgetSpec
+		startTime        time.Time
+		suspendTime      *metav1.Time
+		suspendedSeconds int64
+		expectedMessage  string
+	}{
+		{
+			description: "Experiment without budget",
+			startTime:   now.Add(-2 * time.Hour),
+		},
+		{
+			description: "Budget is not exhausted",
+			budget: &experimentsv1beta1.BudgetSpec{
+				MaxDurationSeconds:     &maxDurationSeconds,
+				MaxTrialRuntimeSeconds: &maxTrialRuntimeSeconds,
+				MaxCPUHours:            &maxCPUHours,
+				MaxGPUHours:            &maxGPUHours,
+			},
+			startTime: now.Add(-30 * time.Minute),
+		},
+		{
+			description: "Max duration is reached",
+			budget: &experimentsv1beta1.BudgetSpec{
+				MaxDurationSeconds: &maxDurationSeconds,
+			},
+			startTime:       now.Add(-2 * time.Hour),
+			expectedMessage: "Experiment has succeeded because budget is exhausted: max duration of 3600 seconds has reached",
+		},
+		{
+			description: "Max duration is not reached because Experiment was suspended",
+			budget: &experimentsv1beta1.BudgetSpec{
+				MaxDurationSeconds: &maxDurationSeconds,
+			},
+			startTime:        now.Add(-2 * time.Hour),
+			suspendedSeconds: 5400,
+		},
+		{
+			description: "Max duration is not reached because Experiment is suspended",
+			budget: &experimentsv1beta1.BudgetSpec{
+				MaxDurationSeconds: &maxDurationSeconds,
+			},
+			startTime:   now.Add(-2 * time.Hour),
+			suspendTime: &metav1.Time{Time: now.Add(-90 * time.Minute)},
+		},
+		{
+			description: "Max CPU-hours are reached",
+			budget: &experimentsv1beta1.BudgetSpec{
+				MaxCPUHours: func() *float64 { v := 3.0; return &v }(),
+			},
+			startTime:       now.Add(-2 * time.Hour),
+			expectedMessage: "Experiment has succeeded because budget is exhausted: max 3 CPU-hours has reached",
+		},
+		{
+			description: "Max GPU-hours are reached",
+			budget: &experimentsv1beta1.BudgetSpec{
+				MaxGPUHours: func() *float64 { v := 1.5; return &v }(),
+			},
+			startTime:       now.Add(-2 * time.Hour),
+			expectedMessage: "Experiment has succeeded because budget is exhausted: max 1.5 GPU-hours has reached",
+		},
+	}
+
+	for _, tc := range testCases {
+		startTime := metav1.NewTime(tc.startTime)
+		instance := &experimentsv1beta1.Experiment{
+			Spec: experimentsv1beta1.ExperimentSpec{
+				Budget: tc.budget,
+			},
+			Status: experimentsv1beta1.ExperimentStatus{
+				StartTime:        &startTime,
+				SuspendTime:      tc.suspendTime,
+				SuspendedSeconds: tc.suspendedSeconds,
+			},
+		}
+		message := getBudgetExhaustedMessage(instance, trials, now)
+		if message != tc.expectedMessage {
+			t.Errorf("Case: %v failed. Expected message %q, got %q", tc.description, tc.expectedMessage, message)
+		}
+	}
+}
+
+func TestGetTrialRuntime(t *testing.T) {
+	now := time.Now()
+	runSpec := newFakeBatchJob(1, "1", "")
+
+	testCases := []struct {
+		description     string
+		trial           trialsv1beta1.Trial
+		expectedRuntime time.Duration
+	}{
+		{
+			description:     "Running Trial",
+			trial:           newFakeBudgetTrial("trial", runSpec, now.Add(-2*time.Hour), time.Time{}),
+			expectedRuntime: 2 * time.Hour,
+		},
+		{
+			description: "Running Trial which was suspended",
+			trial: func() trialsv1beta1.Trial {
+				trial := newFakeBudgetTrial("trial", runSpec, now.Add(-2*time.Hour), time.Time{})
+				trial.Status.SuspendedSeconds = 1800
+				return trial
+			}(),
+			expectedRuntime: 90 * time.Minute,
+		},
+		{
+			description: "Suspended Trial",
+			trial: func() trialsv1beta1.Trial {
+				trial := newFakeBudgetTrial("trial", runSpec, now.Add(-2*time.Hour), time.Time{})
+				trial.Status.SuspendTime = &metav1.Time{Time: now.Add(-time.Hour)}
+				trial.Status.SuspendedSeconds = 1800
+				return trial
+			}(),
+			expectedRuntime: 30 * time.Minute,
+		},
+		{
+			description: "Trial is completed while it is suspended",
+			trial: func() trialsv1beta1.Trial {
+				trial := newFakeBudgetTrial("trial", runSpec, now.Add(-3*time.Hour), now.Add(-time.Hour))
+				trial.Status.SuspendTime = &metav1.Time{Time: now.Add(-90 * time.Minute)}
+				return trial
+			}(),
+			expectedRuntime: 90 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		runtime := getTrialRuntime(&tc.trial, now)
+		if runtime != tc.expectedRuntime {
+			t.Errorf("Case: %v failed. Expected runtime %v, got %v", tc.description, tc.expectedRuntime, runtime)
+		}
+	}
+}
+
+func TestGetTrialResourceRequests(t *testing.T) {
+	testCases := []struct {
+		description string
+		runSpec     *unstructured.Unstructured
+		expectedCPU float64
+		expectedGPU float64
+	}{
+		{
+			description: "Batch Job with parallelism",
+			runSpec:     newFakeBatchJob(2, "500m", "1"),
+			expectedCPU: 1,
+			expectedGPU: 2,
+		},
+		{
+			description: "TFJob with replica specs",
+			runSpec: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "kubeflow.org/v1",
+					"kind":       "TFJob",
+					"spec": map[string]interface{}{
+						"tfReplicaSpecs": map[string]interface{}{
+							"PS":     newFakeReplicaSpec(1, "1", ""),
+							"Worker": newFakeReplicaSpec(2, "2", "1"),
+						},
+					},
+				},
+			},
+			expectedCPU: 5,
+			expectedGPU: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		trial := &trialsv1beta1.Trial{
+			Spec: trialsv1beta1.TrialSpec{
+				RunSpec: tc.runSpec,
+			},
+		}
+		cpu, gpu := getTrialResourceRequests(trial)
+		if cpu != tc.expectedCPU || gpu != tc.expectedGPU {
+			t.Errorf("Case: %v failed. Expected CPU %v and GPU %v, got CPU %v and GPU %v",
+				tc.description, tc.expectedCPU, tc.expectedGPU, cpu, gpu)
+		}
+	}
+}
+
+func newFakeBudgetTrial(name string, runSpec *unstructured.Unstructured, startTime, completionTime time.Time) trialsv1beta1.Trial {
+	start := metav1.NewTime(startTime)
+	completion := metav1.NewTime(completionTime)
+	trial := trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			RunSpec: runSpec,
+		},
+		Status: trialsv1beta1.TrialStatus{
+			StartTime:      &start,
+			CompletionTime: &completion,
+		},
+	}
+	if !completionTime.IsZero() {
+		trial.MarkTrialStatusSucceeded("True", "TrialSucceeded", "Trial has succeeded")
+	}
+	return trial
+}
+
+func newFakeBatchJob(parallelism int64, cpu, gpu string) *unstructured.Unstructured {
+	job := newFakeReplicaSpec(parallelism, cpu, gpu)
+	job["parallelism"] = job["replicas"]
+	delete(job, "replicas")
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"spec":       job,
+		},
+	}
+}
+
+// newFakeReplicaSpec returns the spec with pod template, CPU is requested and GPU is limited.
+func newFakeReplicaSpec(replicas int64, cpu, gpu string) map[string]interface{} {
+	resources := map[string]interface{}{
+		"requests": map[string]interface{}{
+			"cpu": cpu,
+		},
+	}
+	if gpu != "" {
+		resources["limits"] = map[string]interface{}{
+			"nvidia.com/gpu": gpu,
+		}
+	}
+	return map[string]interface{}{
+		"replicas": replicas,
+		"template": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":      "training-container",
+						"image":     "docker.io/kubeflowkatib/mxnet-mnist",
+						"resources": resources,
+					},
+				},
+			},
+		},
+	}
+}
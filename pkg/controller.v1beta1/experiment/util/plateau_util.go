@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// completedTrialValue is the objective value of the completed Trial.
+type completedTrialValue struct {
+	trial *trialsv1beta1.Trial
+	value float64
+}
+
+// getPlateauReachedMessage returns the message with the details of the objective plateau.
+// It returns empty message if the Experiment doesn't have plateau policy or plateau is not reached.
+func getPlateauReachedMessage(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) string {
+	plateau := instance.Spec.Plateau
+	if plateau == nil || plateau.TrialCount <= 0 {
+		return ""
+	}
+
+	var values []completedTrialValue
+	for i := range trials.Items {
+		trial := &trials.Items[i]
+		if !trial.IsCompleted() {
+			continue
+		}
+		// Only feasible trials with the objective value are compared.
+		if isFeasible, _ := checkConstraints(*trial, instance.Spec.Objective.Constraints); !isFeasible {
+			continue
+		}
+		valueStr := getObjectiveMetricValue(*trial)
+		if valueStr == consts.UnavailableMetricValue {
+			continue
+		}
+		value, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil {
+			continue
+		}
+		values = append(values, completedTrialValue{trial: trial, value: value})
+	}
+	// Previous trials are required to compare them with the last trials.
+	if len(values) <= int(plateau.TrialCount) {
+		return ""
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return getTrialCompletionTime(values[i].trial).Before(getTrialCompletionTime(values[j].trial))
+	})
+	lastIndex := len(values) - int(plateau.TrialCount)
+	previousBest := getBestValue(instance.Spec.Objective.Type, values[:lastIndex])
+	lastBest := getBestValue(instance.Spec.Objective.Type, values[lastIndex:])
+
+	improvement := lastBest - previousBest
+	if instance.Spec.Objective.Type == commonv1beta1.ObjectiveTypeMinimize {
+		improvement = previousBest - lastBest
+	}
+	if improvement > plateau.Delta {
+		return ""
+	}
+	return fmt.Sprintf("Experiment has succeeded because objective plateau has reached: "+
+		"best value %v has not improved by more than %v over the last %d trials", previousBest, plateau.Delta, plateau.TrialCount)
+}
+
+func getBestValue(objectiveType commonv1beta1.ObjectiveType, values []completedTrialValue) float64 {
+	best := values[0].value
+	for _, v := range values[1:] {
+		if (objectiveType == commonv1beta1.ObjectiveTypeMinimize && v.value < best) ||
+			(objectiveType == commonv1beta1.ObjectiveTypeMaximize && v.value > best) {
+			best = v.value
+		}
+	}
+	return best
+}
+
+// getTrialCompletionTime returns the Trial completion time, or the start time if completion time is not set.
+func getTrialCompletionTime(trial *trialsv1beta1.Trial) time.Time {
+	if trial.Status.CompletionTime != nil && !trial.Status.CompletionTime.IsZero() {
+		return trial.Status.CompletionTime.Time
+	}
+	if trial.Status.StartTime != nil {
+		return trial.Status.StartTime.Time
+	}
+	return trial.CreationTimestamp.Time
+}
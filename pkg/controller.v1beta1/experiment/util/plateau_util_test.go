@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func TestGetPlateauReachedMessage(t *testing.T) {
+	// Accuracy of the completed trials in order of completion.
+	accuracies := []string{"0.7", "0.9", "0.85", "0.91", "0.88"}
+
+	testCases := []struct {
+		description     string
+		objectiveType   commonv1beta1.ObjectiveType
+		plateau         *experimentsv1beta1.PlateauSpec
+		expectedMessage string
+	}{
+		{
+			description:   "Experiment without plateau policy",
+			objectiveType: commonv1beta1.ObjectiveTypeMaximize,
+		},
+		{
+			description:   "Not enough completed trials",
+			objectiveType: commonv1beta1.ObjectiveTypeMaximize,
+			plateau: &experimentsv1beta1.PlateauSpec{
+				TrialCount: 5,
+			},
+		},
+		{
+			description:   "Objective is improved over the last trials",
+			objectiveType: commonv1beta1.ObjectiveTypeMaximize,
+			plateau: &experimentsv1beta1.PlateauSpec{
+				TrialCount: 3,
+			},
+		},
+		{
+			description:   "Objective is improved less than delta over the last trials",
+			objectiveType: commonv1beta1.ObjectiveTypeMaximize,
+			plateau: &experimentsv1beta1.PlateauSpec{
+				TrialCount: 3,
+				Delta:      0.05,
+			},
+			expectedMessage: "Experiment has succeeded because objective plateau has reached: " +
+				"best value 0.9 has not improved by more than 0.05 over the last 3 trials",
+		},
+		{
+			description:   "Objective is not improved over the last trials",
+			objectiveType: commonv1beta1.ObjectiveTypeMaximize,
+			plateau: &experimentsv1beta1.PlateauSpec{
+				TrialCount: 1,
+			},
+			expectedMessage: "Experiment has succeeded because objective plateau has reached: " +
+				"best value 0.91 has not improved by more than 0 over the last 1 trials",
+		},
+		{
+			description:   "Minimized objective is not improved over the last trials",
+			objectiveType: commonv1beta1.ObjectiveTypeMinimize,
+			plateau: &experimentsv1beta1.PlateauSpec{
+				TrialCount: 4,
+			},
+			expectedMessage: "Experiment has succeeded because objective plateau has reached: " +
+				"best value 0.7 has not improved by more than 0 over the last 4 trials",
+		},
+	}
+
+	trials := &trialsv1beta1.TrialList{}
+	startTime := time.Now()
+	for i, accuracy := range accuracies {
+		trial := newFakeTrial("trial", accuracy, "10")
+		completionTime := metav1.NewTime(startTime.Add(time.Duration(i) * time.Minute))
+		trial.Status.CompletionTime = &completionTime
+		trial.MarkTrialStatusSucceeded("True", "TrialSucceeded", "Trial has succeeded")
+		trial.Spec.Objective = &commonv1beta1.ObjectiveSpec{
+			ObjectiveMetricName: "accuracy",
+			MetricStrategies: []commonv1beta1.MetricStrategy{
+				{Name: "accuracy", Value: commonv1beta1.ExtractByLatest},
+			},
+		}
+		trials.Items = append(trials.Items, trial)
+	}
+	// Running trial is not compared.
+	trials.Items = append(trials.Items, newFakeTrial("running-trial", "0.99", "10"))
+	// Trials are compared in order of completion.
+	trials.Items[0], trials.Items[3] = trials.Items[3], trials.Items[0]
+
+	for _, tc := range testCases {
+		instance := &experimentsv1beta1.Experiment{
+			Spec: experimentsv1beta1.ExperimentSpec{
+				Objective: &commonv1beta1.ObjectiveSpec{
+					Type:                tc.objectiveType,
+					ObjectiveMetricName: "accuracy",
+				},
+				Plateau: tc.plateau,
+			},
+		}
+		message := getPlateauReachedMessage(instance, trials)
+		if message != tc.expectedMessage {
+			t.Errorf("Case: %v failed. Expected message %q, got %q", tc.description, tc.expectedMessage, message)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package util
 
 import (
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -38,6 +39,9 @@ const (
 	ExperimentGoalReachedReason          = "ExperimentGoalReached"
 	ExperimentMaxTrialsReachedReason     = "ExperimentMaxTrialsReached"
 	ExperimentSuggestionEndReachedReason = "ExperimentSuggestionEndReached"
+	ExperimentBudgetExhaustedReason      = "ExperimentBudgetExhausted"
+	ExperimentPlateauReachedReason       = "ExperimentPlateauReached"
+	ExperimentSuspendedReason            = "ExperimentSuspended"
 	ExperimentFailedReason               = "ExperimentFailed"
 )
 
@@ -47,7 +51,9 @@ func UpdateExperimentStatus(collector *ExperimentsCollector, instance *experimen
 	isObjectiveGoalReached := updateTrialsSummary(instance, trials)
 
 	if !instance.IsCompleted() {
-		UpdateExperimentStatusCondition(collector, instance, isObjectiveGoalReached, false)
+		budgetExhaustedMessage := getBudgetExhaustedMessage(instance, trials, time.Now())
+		plateauReachedMessage := getPlateauReachedMessage(instance, trials)
+		UpdateExperimentStatusCondition(collector, instance, isObjectiveGoalReached, budgetExhaustedMessage, plateauReachedMessage, false)
 	}
 	return nil
 
@@ -65,6 +71,7 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 	sts.KilledTrialList = nil
 	sts.EarlyStoppedTrialList = nil
 	sts.MetricsUnavailableTrialList = nil
+	sts.InfeasibleTrialList = nil
 	bestTrialIndex := -1
 	isObjectiveGoalReached := false
 	var objectiveValueGoal float64
@@ -91,6 +98,15 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 			sts.PendingTrialList = append(sts.PendingTrialList, trial.Name)
 		}
 
+		// Only trials which satisfy all constraints can be optimal.
+		isFeasible, isObserved := checkConstraints(trial, instance.Spec.Objective.Constraints)
+		if isObserved && !isFeasible {
+			sts.InfeasibleTrialList = append(sts.InfeasibleTrialList, trial.Name)
+		}
+		if !isFeasible {
+			continue
+		}
+
 		objectiveMetricValueStr := getObjectiveMetricValue(trial)
 		if objectiveMetricValueStr == consts.UnavailableMetricValue {
 			continue
@@ -135,35 +151,201 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 	sts.TrialsKilled = int32(len(sts.KilledTrialList))
 	sts.TrialsEarlyStopped = int32(len(sts.EarlyStoppedTrialList))
 	sts.TrialMetricsUnavailable = int32(len(sts.MetricsUnavailableTrialList))
+	sts.TrialsInfeasible = int32(len(sts.InfeasibleTrialList))
 
 	// if best trial is set
 	if bestTrialIndex != -1 {
-		bestTrial := trials.Items[bestTrialIndex]
+		sts.CurrentOptimalTrial = newOptimalTrial(trials.Items[bestTrialIndex])
+	}
+
+	// For the multi-objective Experiment goal is reached once one trial reaches goals of all objectives.
+	sts.ParetoOptimalTrials = nil
+	if len(instance.Spec.Objective.AdditionalObjectives) != 0 {
+		isObjectiveGoalReached = updateParetoOptimalTrials(instance, trials)
+	}
+	return isObjectiveGoalReached
+}
+
+// objective is the objective of the Experiment, it's the primary objective or one of the additional objectives.
+type objective struct {
+	metricName    string
+	objectiveType commonv1beta1.ObjectiveType
+	goal          *float64
+}
+
+func getObjectives(spec *commonv1beta1.ObjectiveSpec) []objective {
+	objectives := []objective{
+		{
+			metricName:    spec.ObjectiveMetricName,
+			objectiveType: spec.Type,
+			goal:          spec.Goal,
+		},
+	}
+	for _, additionalObjective := range spec.AdditionalObjectives {
+		objectives = append(objectives, objective{
+			metricName:    additionalObjective.ObjectiveMetricName,
+			objectiveType: additionalObjective.Type,
+			goal:          additionalObjective.Goal,
+		})
+	}
+	return objectives
+}
 
-		sts.CurrentOptimalTrial.BestTrialName = bestTrial.Name
-		sts.CurrentOptimalTrial.ParameterAssignments = []commonv1beta1.ParameterAssignment{}
-		sts.CurrentOptimalTrial.ParameterAssignments = append(sts.CurrentOptimalTrial.ParameterAssignments, bestTrial.Spec.ParameterAssignments...)
+// updateParetoOptimalTrials sets trials which are not dominated by other trials in all objectives to the Experiment status.
+// It returns true if one trial reaches goals of all objectives which have goal.
+func updateParetoOptimalTrials(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) bool {
+	objectives := getObjectives(instance.Spec.Objective)
+	hasGoal := false
+	for _, o := range objectives {
+		if o.goal != nil {
+			hasGoal = true
+		}
+	}
+
+	isObjectiveGoalReached := false
+	var candidates []trialsv1beta1.Trial
+	var candidateValues [][]float64
+	for _, trial := range trials.Items {
+		if isFeasible, _ := checkConstraints(trial, instance.Spec.Objective.Constraints); !isFeasible {
+			continue
+		}
+		// Only trials with values of all objectives are compared.
+		values, ok := getObjectiveValues(trial, objectives)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, trial)
+		candidateValues = append(candidateValues, values)
+		if hasGoal && isGoalReached(objectives, values) {
+			isObjectiveGoalReached = true
+		}
+	}
 
-		sts.CurrentOptimalTrial.Observation.Metrics = []commonv1beta1.Metric{}
-		sts.CurrentOptimalTrial.Observation.Metrics = append(sts.CurrentOptimalTrial.Observation.Metrics, bestTrial.Status.Observation.Metrics...)
+	for i := range candidates {
+		isDominated := false
+		for j := range candidates {
+			if i != j && dominates(objectives, candidateValues[j], candidateValues[i]) {
+				isDominated = true
+				break
+			}
+		}
+		if !isDominated {
+			instance.Status.ParetoOptimalTrials = append(instance.Status.ParetoOptimalTrials, newOptimalTrial(candidates[i]))
+		}
 	}
 	return isObjectiveGoalReached
 }
 
+func getObjectiveValues(trial trialsv1beta1.Trial, objectives []objective) ([]float64, bool) {
+	values := make([]float64, 0, len(objectives))
+	for _, o := range objectives {
+		valueStr := getMetricValue(trial, o.metricName)
+		if valueStr == consts.UnavailableMetricValue {
+			return nil, false
+		}
+		value, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, value)
+	}
+	return values, true
+}
+
+// isBetter returns true if value a is better than value b for the objective.
+func isBetter(o objective, a, b float64) bool {
+	if o.objectiveType == commonv1beta1.ObjectiveTypeMinimize {
+		return a < b
+	}
+	return a > b
+}
+
+// dominates returns true if values a are not worse than values b in all objectives and better in at least one objective.
+func dominates(objectives []objective, a, b []float64) bool {
+	isBetterInOne := false
+	for i, o := range objectives {
+		if isBetter(o, b[i], a[i]) {
+			return false
+		}
+		if isBetter(o, a[i], b[i]) {
+			isBetterInOne = true
+		}
+	}
+	return isBetterInOne
+}
+
+// isGoalReached returns true if values reach goals of all objectives which have goal.
+func isGoalReached(objectives []objective, values []float64) bool {
+	for i, o := range objectives {
+		if o.goal != nil && isBetter(o, *o.goal, values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkConstraints returns true if the trial metrics satisfy all constraints.
+// The second value is false if the trial satisfies constraints with available metrics,
+// but metrics of other constraints are not reported yet.
+func checkConstraints(trial trialsv1beta1.Trial, constraints []commonv1beta1.ObjectiveConstraint) (bool, bool) {
+	isObserved := true
+	for _, constraint := range constraints {
+		constraintValue, err := strconv.ParseFloat(constraint.Value, 64)
+		if err != nil {
+			return false, true
+		}
+		valueStr := getMetricValue(trial, constraint.MetricName)
+		if valueStr == consts.UnavailableMetricValue {
+			isObserved = false
+			continue
+		}
+		value, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil {
+			isObserved = false
+			continue
+		}
+		if (constraint.Comparison == commonv1beta1.ComparisonTypeEqual && value != constraintValue) ||
+			(constraint.Comparison == commonv1beta1.ComparisonTypeLess && value >= constraintValue) ||
+			(constraint.Comparison == commonv1beta1.ComparisonTypeGreater && value <= constraintValue) {
+			return false, true
+		}
+	}
+	return isObserved, isObserved
+}
+
+func newOptimalTrial(trial trialsv1beta1.Trial) experimentsv1beta1.OptimalTrial {
+	optimalTrial := experimentsv1beta1.OptimalTrial{
+		BestTrialName:        trial.Name,
+		ParameterAssignments: []commonv1beta1.ParameterAssignment{},
+		Observation: commonv1beta1.Observation{
+			Metrics: []commonv1beta1.Metric{},
+		},
+	}
+	optimalTrial.ParameterAssignments = append(optimalTrial.ParameterAssignments, trial.Spec.ParameterAssignments...)
+	if trial.Status.Observation != nil {
+		optimalTrial.Observation.Metrics = append(optimalTrial.Observation.Metrics, trial.Status.Observation.Metrics...)
+	}
+	return optimalTrial
+}
+
 func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
+	return getMetricValue(trial, trial.Spec.Objective.ObjectiveMetricName)
+}
+
+// getMetricValue returns the value of the metric which is extracted by the metric strategy.
+func getMetricValue(trial trialsv1beta1.Trial, metricName string) string {
 	if trial.Status.Observation == nil {
 		return consts.UnavailableMetricValue
 	}
 	var objectiveStrategy commonv1beta1.MetricStrategyType
-	objectiveMetricName := trial.Spec.Objective.ObjectiveMetricName
 	for _, strategy := range trial.Spec.Objective.MetricStrategies {
-		if strategy.Name == objectiveMetricName {
+		if strategy.Name == metricName {
 			objectiveStrategy = strategy.Value
 			break
 		}
 	}
 	for _, metric := range trial.Status.Observation.Metrics {
-		if objectiveMetricName == metric.Name {
+		if metricName == metric.Name {
 			switch objectiveStrategy {
 			case commonv1beta1.ExtractByMin:
 				if metric.Min == consts.UnavailableMetricValue {
@@ -184,7 +366,10 @@ func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
 }
 
 // UpdateExperimentStatusCondition updates the experiment status.
-func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *experimentsv1beta1.Experiment, isObjectiveGoalReached bool, getSuggestionDone bool) {
+// Experiment budget is exhausted if budgetExhaustedMessage is not empty,
+// objective plateau is reached if plateauReachedMessage is not empty.
+func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *experimentsv1beta1.Experiment, isObjectiveGoalReached bool,
+	budgetExhaustedMessage string, plateauReachedMessage string, getSuggestionDone bool) {
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	completedTrialsCount := instance.Status.TrialsSucceeded + instance.Status.TrialsFailed +
 		instance.Status.TrialsKilled + instance.Status.TrialsEarlyStopped + instance.Status.TrialMetricsUnavailable
@@ -221,6 +406,24 @@ func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *
 		return
 	}
 
+	// Then check if budget of the Experiment is exhausted.
+	if budgetExhaustedMessage != "" {
+		instance.MarkExperimentStatusSucceeded(ExperimentBudgetExhaustedReason, budgetExhaustedMessage)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(budgetExhaustedMessage)
+		return
+	}
+
+	// Then check if objective plateau is reached.
+	if plateauReachedMessage != "" {
+		instance.MarkExperimentStatusSucceeded(ExperimentPlateauReachedReason, plateauReachedMessage)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(plateauReachedMessage)
+		return
+	}
+
 	if getSuggestionDone && activeTrialsCount == 0 {
 		msg := "Experiment has succeeded because suggestion service has reached the end"
 		instance.MarkExperimentStatusSucceeded(ExperimentSuggestionEndReachedReason, msg)
@@ -230,6 +433,25 @@ func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *
 		return
 	}
 
+	UpdateExperimentSuspendCondition(instance)
+}
+
+// UpdateExperimentSuspendCondition marks the Experiment as suspended or running according to the spec.suspend field.
+// Suspended time is summed up once the Experiment is resumed, it is not counted in the Experiment budget.
+func UpdateExperimentSuspendCondition(instance *experimentsv1beta1.Experiment) {
+	now := metav1.Now()
+	if instance.Spec.Suspend {
+		if instance.Status.SuspendTime == nil {
+			instance.Status.SuspendTime = &now
+		}
+		msg := "Experiment is suspended"
+		instance.MarkExperimentStatusSuspended(ExperimentSuspendedReason, msg)
+		return
+	}
+	if instance.Status.SuspendTime != nil {
+		instance.Status.SuspendedSeconds += int64(now.Sub(instance.Status.SuspendTime.Time).Seconds())
+		instance.Status.SuspendTime = nil
+	}
 	msg := "Experiment is running"
 	instance.MarkExperimentStatusRunning(ExperimentRunningReason, msg)
 }
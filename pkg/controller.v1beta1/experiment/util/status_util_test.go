@@ -0,0 +1,234 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func TestUpdateTrialsSummary(t *testing.T) {
+	accuracyGoal := 0.9
+	latencyGoal := 20.0
+
+	// Trials with accuracy and latency.
+	trials := &trialsv1beta1.TrialList{
+		Items: []trialsv1beta1.Trial{
+			newFakeTrial("trial-1", "0.8", "10"),
+			newFakeTrial("trial-2", "0.9", "30"),
+			// Dominated by trial-2.
+			newFakeTrial("trial-3", "0.85", "40"),
+			newFakeTrial("trial-4", "0.95", "50"),
+			// Metrics are not reported.
+			newFakeTrial("trial-5", consts.UnavailableMetricValue, "5"),
+		},
+	}
+
+	testCases := []struct {
+		description                 string
+		objective                   *commonv1beta1.ObjectiveSpec
+		expectedOptimalTrial        string
+		expectedParetoOptimalTrials []string
+		expectedInfeasibleTrials    []string
+		expectedGoalReached         bool
+	}{
+		{
+			description: "Single objective Experiment",
+			objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+				Goal:                &accuracyGoal,
+			},
+			expectedOptimalTrial: "trial-4",
+			expectedGoalReached:  true,
+		},
+		{
+			description: "Multi-objective Experiment",
+			objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+				AdditionalObjectives: []commonv1beta1.AdditionalObjective{
+					{
+						Type:                commonv1beta1.ObjectiveTypeMinimize,
+						ObjectiveMetricName: "latency",
+					},
+				},
+			},
+			expectedOptimalTrial:        "trial-4",
+			expectedParetoOptimalTrials: []string{"trial-1", "trial-2", "trial-4"},
+		},
+		{
+			description: "Multi-objective Experiment goals are not reached by one Trial",
+			objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+				Goal:                &accuracyGoal,
+				AdditionalObjectives: []commonv1beta1.AdditionalObjective{
+					{
+						Type:                commonv1beta1.ObjectiveTypeMinimize,
+						ObjectiveMetricName: "latency",
+						Goal:                &latencyGoal,
+					},
+				},
+			},
+			expectedOptimalTrial:        "trial-4",
+			expectedParetoOptimalTrials: []string{"trial-1", "trial-2", "trial-4"},
+		},
+		{
+			description: "Multi-objective Experiment goal is reached",
+			objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+				AdditionalObjectives: []commonv1beta1.AdditionalObjective{
+					{
+						Type:                commonv1beta1.ObjectiveTypeMinimize,
+						ObjectiveMetricName: "latency",
+						Goal:                &latencyGoal,
+					},
+				},
+			},
+			expectedOptimalTrial:        "trial-4",
+			expectedParetoOptimalTrials: []string{"trial-1", "trial-2", "trial-4"},
+			expectedGoalReached:         true,
+		},
+		{
+			description: "Infeasible Trials are not optimal",
+			objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+				Goal:                &accuracyGoal,
+				Constraints: []commonv1beta1.ObjectiveConstraint{
+					{
+						MetricName: "latency",
+						Value:      "45",
+						Comparison: commonv1beta1.ComparisonTypeLess,
+					},
+					// Metrics of trial-5 are not reported, so its feasibility is unknown.
+					{
+						MetricName: "accuracy",
+						Value:      "0.5",
+						Comparison: commonv1beta1.ComparisonTypeGreater,
+					},
+				},
+			},
+			expectedOptimalTrial:     "trial-2",
+			expectedInfeasibleTrials: []string{"trial-4"},
+			expectedGoalReached:      true,
+		},
+		{
+			description: "Infeasible Trials are not Pareto optimal",
+			objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+				AdditionalObjectives: []commonv1beta1.AdditionalObjective{
+					{
+						Type:                commonv1beta1.ObjectiveTypeMinimize,
+						ObjectiveMetricName: "latency",
+					},
+				},
+				Constraints: []commonv1beta1.ObjectiveConstraint{
+					{
+						MetricName: "latency",
+						Value:      "20",
+						Comparison: commonv1beta1.ComparisonTypeLess,
+					},
+				},
+			},
+			expectedOptimalTrial:        "trial-1",
+			expectedParetoOptimalTrials: []string{"trial-1"},
+			expectedInfeasibleTrials:    []string{"trial-2", "trial-3", "trial-4"},
+		},
+	}
+
+	for _, tc := range testCases {
+		instance := &experimentsv1beta1.Experiment{
+			Spec: experimentsv1beta1.ExperimentSpec{
+				Objective: tc.objective,
+			},
+		}
+		trialsCopy := trials.DeepCopy()
+		for i := range trialsCopy.Items {
+			trialsCopy.Items[i].Spec.Objective = tc.objective.DeepCopy()
+			trialsCopy.Items[i].Spec.Objective.MetricStrategies = []commonv1beta1.MetricStrategy{
+				{Name: "accuracy", Value: commonv1beta1.ExtractByLatest},
+				{Name: "latency", Value: commonv1beta1.ExtractByLatest},
+			}
+		}
+
+		isGoalReached := updateTrialsSummary(instance, trialsCopy)
+		if isGoalReached != tc.expectedGoalReached {
+			t.Errorf("Case: %v failed. Expected goal reached %v, got %v", tc.description, tc.expectedGoalReached, isGoalReached)
+		}
+		if instance.Status.CurrentOptimalTrial.BestTrialName != tc.expectedOptimalTrial {
+			t.Errorf("Case: %v failed. Expected optimal trial %v, got %v",
+				tc.description, tc.expectedOptimalTrial, instance.Status.CurrentOptimalTrial.BestTrialName)
+		}
+		var paretoOptimalTrials []string
+		for _, optimalTrial := range instance.Status.ParetoOptimalTrials {
+			paretoOptimalTrials = append(paretoOptimalTrials, optimalTrial.BestTrialName)
+		}
+		if !reflect.DeepEqual(paretoOptimalTrials, tc.expectedParetoOptimalTrials) {
+			t.Errorf("Case: %v failed. Expected Pareto optimal trials %v, got %v",
+				tc.description, tc.expectedParetoOptimalTrials, paretoOptimalTrials)
+		}
+		if !reflect.DeepEqual(instance.Status.InfeasibleTrialList, tc.expectedInfeasibleTrials) {
+			t.Errorf("Case: %v failed. Expected infeasible trials %v, got %v",
+				tc.description, tc.expectedInfeasibleTrials, instance.Status.InfeasibleTrialList)
+		}
+	}
+}
+
+func newFakeTrial(name, accuracy, latency string) trialsv1beta1.Trial {
+	return trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			ParameterAssignments: []commonv1beta1.ParameterAssignment{
+				{
+					Name:  "lr",
+					Value: "0.01",
+				},
+			},
+		},
+		Status: trialsv1beta1.TrialStatus{
+			Observation: &commonv1beta1.Observation{
+				Metrics: []commonv1beta1.Metric{
+					{
+						Name:   "accuracy",
+						Latest: accuracy,
+						Min:    accuracy,
+						Max:    accuracy,
+					},
+					{
+						Name:   "latency",
+						Latest: latency,
+						Min:    latency,
+						Max:    latency,
+					},
+				},
+			},
+		},
+	}
+}
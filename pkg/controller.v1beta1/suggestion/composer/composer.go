@@ -128,6 +128,13 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 		}
 	}
 
+	// Scale deployment to zero while the Experiment is suspended.
+	// Suggestion state is kept in the Suggestion status and in the volume if ResumePolicy = FromVolume.
+	if s.Spec.Suspend {
+		replicas := int32(0)
+		d.Spec.Replicas = &replicas
+	}
+
 	// Attach ServiceAccount if early stopping is used.
 	// For custom service account user should manually add appropriate Role to change Trial status.
 	if s.Spec.EarlyStopping != nil && s.Spec.EarlyStopping.AlgorithmName != "" && suggestionConfigData.ServiceAccountName == "" {
@@ -240,6 +240,12 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 	if foundDeploy, err := r.reconcileDeployment(deploy, suggestionNsName); err != nil {
 		return err
 	} else {
+		// Assignments are not synced while the Suggestion is suspended, deployment is scaled to zero.
+		if instance.Spec.Suspend {
+			msg := "Deployment is scaled to zero because Suggestion is suspended"
+			instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionFalse, SuggestionSuspendedReason, msg)
+			return nil
+		}
 		if !r.checkDeploymentReady(foundDeploy) {
 			// deployment is not ready yet
 			msg := "Deployment is not ready"
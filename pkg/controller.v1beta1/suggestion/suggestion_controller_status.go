@@ -27,6 +27,7 @@ const (
 	SuggestionCreatedReason      = "SuggestionCreated"
 	SuggestionDeploymentReady    = "DeploymentReady"
 	SuggestionDeploymentNotReady = "DeploymentNotReady"
+	SuggestionSuspendedReason    = "SuggestionSuspended"
 	SuggestionRunningReason      = "SuggestionRunning"
 	SuggestionFailedReason       = "SuggestionFailed"
 )
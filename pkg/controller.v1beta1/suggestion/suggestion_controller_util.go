@@ -39,9 +39,25 @@ func (r *ReconcileSuggestion) reconcileDeployment(deploy *appsv1.Deployment, sug
 	} else if err != nil {
 		return nil, err
 	}
+	// Deployment replicas are changed once the Suggestion is suspended or resumed.
+	if getDeploymentReplicas(foundDeploy) != getDeploymentReplicas(deploy) {
+		logger.Info("Updating Deployment replicas", "name", deploy.Name, "replicas", getDeploymentReplicas(deploy))
+		foundDeploy.Spec.Replicas = deploy.Spec.Replicas
+		if err = r.Update(context.TODO(), foundDeploy); err != nil {
+			return nil, err
+		}
+	}
 	return foundDeploy, nil
 }
 
+// getDeploymentReplicas returns number of the Deployment replicas, Kubernetes defaults it to 1.
+func getDeploymentReplicas(deploy *appsv1.Deployment) int32 {
+	if deploy.Spec.Replicas == nil {
+		return 1
+	}
+	return *deploy.Spec.Replicas
+}
+
 func (r *ReconcileSuggestion) reconcileService(service *corev1.Service, suggestionNsName types.NamespacedName) (*corev1.Service, error) {
 	logger := log.WithValues("Suggestion", suggestionNsName)
 	foundService := &corev1.Service{}
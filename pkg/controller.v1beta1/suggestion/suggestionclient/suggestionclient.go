@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
@@ -165,6 +166,8 @@ func (g *General) SyncAssignments(
 					Value:      rule.Value,
 					Comparison: convertComparison(rule.Comparison),
 					StartStep:  int(rule.StartStep),
+					Strategy:   convertEarlyStoppingStrategy(rule.Strategy),
+					WindowSize: int(rule.WindowSize),
 				},
 			)
 		}
@@ -308,6 +311,8 @@ func (g *General) ConvertExperiment(e *experimentsv1beta1.Experiment) *suggestio
 			Type:                  convertObjectiveType(e.Spec.Objective.Type),
 			ObjectiveMetricName:   e.Spec.Objective.ObjectiveMetricName,
 			AdditionalMetricNames: e.Spec.Objective.AdditionalMetricNames,
+			AdditionalObjectives:  convertAdditionalObjectives(e.Spec.Objective.AdditionalObjectives),
+			Constraints:           convertConstraints(e.Spec.Objective.Constraints),
 		},
 		ParameterSpecs: &suggestionapi.ExperimentSpec_ParameterSpecs{
 			Parameters: convertParameters(e.Spec.Parameters),
@@ -352,6 +357,8 @@ func (g *General) ConvertTrials(ts []trialsv1beta1.Trial) []*suggestionapi.Trial
 					Type:                  convertObjectiveType(t.Spec.Objective.Type),
 					ObjectiveMetricName:   t.Spec.Objective.ObjectiveMetricName,
 					AdditionalMetricNames: t.Spec.Objective.AdditionalMetricNames,
+					AdditionalObjectives:  convertAdditionalObjectives(t.Spec.Objective.AdditionalObjectives),
+					Constraints:           convertConstraints(t.Spec.Objective.Constraints),
 				},
 				ParameterAssignments: convertTrialParameterAssignments(
 					t.Spec.ParameterAssignments),
@@ -481,6 +488,49 @@ func convertObjectiveType(typ commonapiv1beta1.ObjectiveType) suggestionapi.Obje
 	}
 }
 
+// convertAdditionalObjectives converts additional objectives of the multi-objective Experiment to the GRPC definition.
+func convertAdditionalObjectives(objectives []commonapiv1beta1.AdditionalObjective) []*suggestionapi.AdditionalObjective {
+	var res []*suggestionapi.AdditionalObjective
+	for _, o := range objectives {
+		objective := &suggestionapi.AdditionalObjective{
+			Type:                convertObjectiveType(o.Type),
+			ObjectiveMetricName: o.ObjectiveMetricName,
+		}
+		// Set Goal if user defines it in the objective
+		if o.Goal != nil {
+			objective.Goal = wrapperspb.Double(*o.Goal)
+		}
+		res = append(res, objective)
+	}
+	return res
+}
+
+// convertConstraints converts outcome constraints of the Experiment to the GRPC definition.
+func convertConstraints(constraints []commonapiv1beta1.ObjectiveConstraint) []*suggestionapi.ObjectiveConstraint {
+	var res []*suggestionapi.ObjectiveConstraint
+	for _, c := range constraints {
+		res = append(res, &suggestionapi.ObjectiveConstraint{
+			MetricName: c.MetricName,
+			Value:      c.Value,
+			Comparison: convertComparisonType(c.Comparison),
+		})
+	}
+	return res
+}
+
+func convertComparisonType(comparison commonapiv1beta1.ComparisonType) suggestionapi.ComparisonType {
+	switch comparison {
+	case commonapiv1beta1.ComparisonTypeEqual:
+		return suggestionapi.ComparisonType_EQUAL
+	case commonapiv1beta1.ComparisonTypeLess:
+		return suggestionapi.ComparisonType_LESS
+	case commonapiv1beta1.ComparisonTypeGreater:
+		return suggestionapi.ComparisonType_GREATER
+	default:
+		return suggestionapi.ComparisonType_UNKNOWN_COMPARISON
+	}
+}
+
 func convertAlgorithmSettings(as []commonapiv1beta1.AlgorithmSetting) []*suggestionapi.AlgorithmSetting {
 	res := make([]*suggestionapi.AlgorithmSetting, 0)
 	for _, s := range as {
@@ -552,3 +602,20 @@ func convertComparison(comparison suggestionapi.ComparisonType) commonapiv1beta1
 		return commonapiv1beta1.ComparisonTypeEqual
 	}
 }
+
+// convertEarlyStoppingStrategy converts early stopping strategy from the GRPC definition to the CRD.
+// Unknown strategy is converted to the empty strategy, so the default aggregation is used.
+func convertEarlyStoppingStrategy(strategy suggestionapi.EarlyStoppingStrategy) commonapiv1beta1.EarlyStoppingStrategyType {
+	switch strategy {
+	case suggestionapi.EarlyStoppingStrategy_MIN:
+		return commonapiv1beta1.EarlyStoppingStrategyMin
+	case suggestionapi.EarlyStoppingStrategy_MAX:
+		return commonapiv1beta1.EarlyStoppingStrategyMax
+	case suggestionapi.EarlyStoppingStrategy_LATEST:
+		return commonapiv1beta1.EarlyStoppingStrategyLatest
+	case suggestionapi.EarlyStoppingStrategy_MOVING_AVERAGE:
+		return commonapiv1beta1.EarlyStoppingStrategyMovingAverage
+	default:
+		return ""
+	}
+}
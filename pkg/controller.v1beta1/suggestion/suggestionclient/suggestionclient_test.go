@@ -24,10 +24,12 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
 	"github.com/onsi/gomega"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,6 +54,11 @@ type k8sMatcher struct {
 }
 
 func (k8s k8sMatcher) Matches(x interface{}) bool {
+	// Well-known wrapper types have unexported fields, which can't be compared by DeepEqual.
+	if expected, ok := k8s.x.(proto.Message); ok {
+		actual, ok := x.(proto.Message)
+		return ok && proto.Equal(expected, actual)
+	}
 	return equality.Semantic.DeepEqual(k8s.x, x)
 }
 
@@ -619,6 +626,46 @@ func TestConvertComparison(t *testing.T) {
 	}
 }
 
+func TestConvertEarlyStoppingStrategy(t *testing.T) {
+	tcs := []struct {
+		InStrategy       suggestionapi.EarlyStoppingStrategy
+		ExpectedStrategy commonapiv1beta1.EarlyStoppingStrategyType
+		TestDescription  string
+	}{
+		{
+			InStrategy:       suggestionapi.EarlyStoppingStrategy_MIN,
+			ExpectedStrategy: commonapiv1beta1.EarlyStoppingStrategyMin,
+			TestDescription:  "Convert min strategy",
+		},
+		{
+			InStrategy:       suggestionapi.EarlyStoppingStrategy_MAX,
+			ExpectedStrategy: commonapiv1beta1.EarlyStoppingStrategyMax,
+			TestDescription:  "Convert max strategy",
+		},
+		{
+			InStrategy:       suggestionapi.EarlyStoppingStrategy_LATEST,
+			ExpectedStrategy: commonapiv1beta1.EarlyStoppingStrategyLatest,
+			TestDescription:  "Convert latest strategy",
+		},
+		{
+			InStrategy:       suggestionapi.EarlyStoppingStrategy_MOVING_AVERAGE,
+			ExpectedStrategy: commonapiv1beta1.EarlyStoppingStrategyMovingAverage,
+			TestDescription:  "Convert moving average strategy",
+		},
+		{
+			InStrategy:       suggestionapi.EarlyStoppingStrategy_UNKNOWN_STRATEGY,
+			ExpectedStrategy: "",
+			TestDescription:  "Convert unknown strategy",
+		},
+	}
+	for _, tc := range tcs {
+		actualStrategy := convertEarlyStoppingStrategy(tc.InStrategy)
+		if actualStrategy != tc.ExpectedStrategy {
+			t.Errorf("Case: %v failed. Expected strategy %v, got %v", tc.TestDescription, tc.ExpectedStrategy, actualStrategy)
+		}
+	}
+}
+
 func newFakeStrategies() []commonv1beta1.MetricStrategy {
 	return []commonv1beta1.MetricStrategy{
 		{Name: "error", Value: commonv1beta1.ExtractByMin},
@@ -658,12 +705,27 @@ func newFakeRequestObservation() *suggestionapi.Observation {
 
 func newFakeObjective() *commonapiv1beta1.ObjectiveSpec {
 	goal := 0.99
+	additionalGoal := 0.1
 
 	return &commonv1beta1.ObjectiveSpec{
 		Type:                  commonv1beta1.ObjectiveTypeMaximize,
 		ObjectiveMetricName:   "metric1-name",
 		AdditionalMetricNames: []string{"metric2-name"},
 		Goal:                  &goal,
+		AdditionalObjectives: []commonv1beta1.AdditionalObjective{
+			{
+				Type:                commonv1beta1.ObjectiveTypeMinimize,
+				Goal:                &additionalGoal,
+				ObjectiveMetricName: "metric2-name",
+			},
+		},
+		Constraints: []commonv1beta1.ObjectiveConstraint{
+			{
+				MetricName: "metric2-name",
+				Value:      "0.5",
+				Comparison: commonv1beta1.ComparisonTypeLess,
+			},
+		},
 	}
 }
 
@@ -889,6 +951,20 @@ func newFakeRequest() *suggestionapi.GetSuggestionsRequest {
 		ObjectiveMetricName:   "metric1-name",
 		AdditionalMetricNames: []string{"metric2-name"},
 		Goal:                  0.99,
+		AdditionalObjectives: []*suggestionapi.AdditionalObjective{
+			{
+				Type:                suggestionapi.ObjectiveType_MINIMIZE,
+				Goal:                wrapperspb.Double(0.1),
+				ObjectiveMetricName: "metric2-name",
+			},
+		},
+		Constraints: []*suggestionapi.ObjectiveConstraint{
+			{
+				MetricName: "metric2-name",
+				Value:      "0.5",
+				Comparison: suggestionapi.ComparisonType_LESS,
+			},
+		},
 	}
 
 	return &suggestionapi.GetSuggestionsRequest{
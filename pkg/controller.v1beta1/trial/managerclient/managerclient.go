@@ -17,63 +17,52 @@ limitations under the License.
 package managerclient
 
 import (
+	"context"
+
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
-	common "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 )
 
 // ManagerClient is the interface for katib manager client in trial controller.
 type ManagerClient interface {
-	GetTrialObservationLog(
-		instance *trialsv1beta1.Trial) (*api_pb.GetObservationLogReply, error)
+	GetTrialObservationSummary(
+		instance *trialsv1beta1.Trial) (*api_pb.GetObservationSummaryReply, error)
 	DeleteTrialObservationLog(
 		instance *trialsv1beta1.Trial) (*api_pb.DeleteObservationLogReply, error)
+	ReportTrialObservationLog(
+		instance *trialsv1beta1.Trial, observationLog *api_pb.ObservationLog, source string, sourceOffset int64) (*api_pb.ReportObservationLogReply, error)
 }
 
 // DefaultClient implements the Client interface.
 type DefaultClient struct {
+	dbManagerClient api_pb.DBManagerClient
 }
 
-// New creates a new ManagerClient.
-func New() ManagerClient {
-	return &DefaultClient{}
+// New creates a new ManagerClient, which sends requests with the given Katib DB Manager client.
+func New(dbManagerClient api_pb.DBManagerClient) ManagerClient {
+	return &DefaultClient{
+		dbManagerClient: dbManagerClient,
+	}
 }
 
-func (d *DefaultClient) GetTrialObservationLog(
-	instance *trialsv1beta1.Trial) (*api_pb.GetObservationLogReply, error) {
-	// read GetObservationLog call and update observation field
-	objectiveMetricName := instance.Spec.Objective.ObjectiveMetricName
-	request := &api_pb.GetObservationLogRequest{
-		TrialName:  instance.Name,
-		MetricName: objectiveMetricName,
+func (d *DefaultClient) GetTrialObservationSummary(
+	instance *trialsv1beta1.Trial) (*api_pb.GetObservationSummaryReply, error) {
+	// summarize objective and additional metrics in one call
+	metricNames := append([]string{instance.Spec.Objective.ObjectiveMetricName},
+		instance.Spec.Objective.AdditionalMetricNames...)
+	request := &api_pb.GetObservationSummaryRequest{
+		TrialName:   instance.Name,
+		MetricNames: metricNames,
+		Namespace:   instance.Namespace,
 	}
-	reply, err := common.GetObservationLog(request)
+	reply, err := d.dbManagerClient.GetObservationSummary(context.Background(), request)
 	if err != nil {
 		return nil, err
 	}
-	if reply.ObservationLog == nil {
-		reply.ObservationLog = &api_pb.ObservationLog{}
-	}
-	if reply.ObservationLog.MetricLogs == nil {
-		reply.ObservationLog.MetricLogs = []*api_pb.MetricLog{}
-	}
-	// fetch additional metrics if exists
-	metricLogs := reply.ObservationLog.MetricLogs
-	for _, metricName := range instance.Spec.Objective.AdditionalMetricNames {
-		request := &api_pb.GetObservationLogRequest{
-			TrialName: instance.Name, MetricName: metricName,
-		}
-		reply, err := common.GetObservationLog(request)
-		if err != nil {
-			return nil, err
-		}
-		if reply.ObservationLog == nil || reply.ObservationLog.MetricLogs == nil {
-			continue
-		}
-		metricLogs = append(metricLogs, reply.ObservationLog.MetricLogs...)
+	if reply.MetricSummaries == nil {
+		reply.MetricSummaries = []*api_pb.MetricSummary{}
 	}
-	reply.ObservationLog.MetricLogs = metricLogs
-
 	return reply, nil
 }
 
@@ -81,10 +70,27 @@ func (d *DefaultClient) DeleteTrialObservationLog(
 	instance *trialsv1beta1.Trial) (*api_pb.DeleteObservationLogReply, error) {
 	request := &api_pb.DeleteObservationLogRequest{
 		TrialName: instance.Name,
+		Namespace: instance.Namespace,
 	}
-	reply, err := common.DeleteObservationLog(request)
+	reply, err := d.dbManagerClient.DeleteObservationLog(context.Background(), request)
 	if err != nil {
 		return nil, err
 	}
 	return reply, nil
 }
+
+// ReportTrialObservationLog reports logs of the Trial which are collected by Katib controller.
+// Logs are registered once for the source offset.
+func (d *DefaultClient) ReportTrialObservationLog(
+	instance *trialsv1beta1.Trial, observationLog *api_pb.ObservationLog, source string, sourceOffset int64) (*api_pb.ReportObservationLogReply, error) {
+	request := &api_pb.ReportObservationLogRequest{
+		TrialName:      instance.Name,
+		Namespace:      instance.Namespace,
+		ExperimentName: instance.Labels[consts.LabelExperimentName],
+		TrialUid:       string(instance.UID),
+		ObservationLog: observationLog,
+		Source:         source,
+		SourceOffset:   sourceOffset,
+	}
+	return d.dbManagerClient.ReportObservationLog(context.Background(), request)
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/trial/managerclient"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	podlogsmc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/podlogs-metricscollector"
+)
+
+const (
+	// podLogsPollInterval is the interval between checks of the primary container of the Trial with PodLogs metrics collector.
+	podLogsPollInterval = 5 * time.Second
+)
+
+// podLogsCollector collects metrics of Trials with PodLogs metrics collector from logs of the primary container.
+// Logs are streamed with Kubernetes API while Trial is running, so the Trial pod is not mutated.
+type podLogsCollector struct {
+	kubeClient    kubernetes.Interface
+	managerClient managerclient.ManagerClient
+	flushInterval time.Duration
+	pollInterval  time.Duration
+
+	mu          sync.Mutex
+	collections map[types.NamespacedName]*podLogsCollection
+}
+
+// podLogsCollection is the running collection of the Trial metrics.
+type podLogsCollection struct {
+	uid    types.UID
+	cancel context.CancelFunc
+	// collected is the logs source of the terminated primary container once its logs are collected.
+	collected string
+	// jobSucceeded is set once the Trial job is succeeded, so the primary pod is not created anymore.
+	jobSucceeded bool
+	// unavailable is the message why metrics can't be collected, e.g. the primary pod is deleted.
+	unavailable string
+}
+
+func newPodLogsCollector(kubeClient kubernetes.Interface, managerClient managerclient.ManagerClient) *podLogsCollector {
+	return &podLogsCollector{
+		kubeClient:    kubeClient,
+		managerClient: managerClient,
+		flushInterval: mccommon.DefaultFlushInterval,
+		pollInterval:  podLogsPollInterval,
+		collections:   map[types.NamespacedName]*podLogsCollection{},
+	}
+}
+
+// ensureCollecting starts collecting metrics of the Trial if they are not collected yet.
+func (c *podLogsCollector) ensureCollecting(instance *trialsv1beta1.Trial) {
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if collection, ok := c.collections[key]; ok {
+		if collection.uid == instance.UID {
+			return
+		}
+		// Trial is recreated with the same name.
+		collection.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	collection := &podLogsCollection{
+		uid:    instance.UID,
+		cancel: cancel,
+	}
+	c.collections[key] = collection
+	go c.collect(ctx, instance.DeepCopy(), collection)
+}
+
+// getCollected returns the logs source of the terminated primary container if metrics of the Trial are collected from its logs.
+func (c *podLogsCollector) getCollected(instance *trialsv1beta1.Trial) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if collection := c.getCollection(instance); collection != nil {
+		return collection.collected
+	}
+	return ""
+}
+
+// getUnavailable returns the message why metrics of the Trial can't be collected, it's empty if metrics are collected yet.
+func (c *podLogsCollector) getUnavailable(instance *trialsv1beta1.Trial) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if collection := c.getCollection(instance); collection != nil {
+		return collection.unavailable
+	}
+	return ""
+}
+
+// setJobSucceeded marks the Trial job as succeeded, so metrics are unavailable if the primary container doesn't exist.
+func (c *podLogsCollector) setJobSucceeded(instance *trialsv1beta1.Trial) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if collection := c.getCollection(instance); collection != nil {
+		collection.jobSucceeded = true
+	}
+}
+
+func (c *podLogsCollector) getCollection(instance *trialsv1beta1.Trial) *podLogsCollection {
+	collection, ok := c.collections[types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}]
+	if !ok || collection.uid != instance.UID {
+		return nil
+	}
+	return collection
+}
+
+// stopCollecting stops collecting metrics of the Trial once it is completed or deleted.
+func (c *podLogsCollector) stopCollecting(key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if collection, ok := c.collections[key]; ok {
+		collection.cancel()
+		delete(c.collections, key)
+	}
+}
+
+// collect follows logs of the Trial primary container and reports metrics until the container is terminated.
+// Once the logs stream is broken, logs are followed again from the offset of the reported logs.
+func (c *podLogsCollector) collect(ctx context.Context, instance *trialsv1beta1.Trial, collection *podLogsCollection) {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	// Logs are collected from the beginning of the container logs,
+	// so logs which were collected before Katib controller is restarted are deleted.
+	for {
+		_, err := c.managerClient.DeleteTrialObservationLog(instance)
+		if err == nil {
+			break
+		}
+		logger.Error(err, "Delete observation log error")
+		if !c.wait(ctx) {
+			return
+		}
+	}
+
+	metrics := append([]string{instance.Spec.Objective.ObjectiveMetricName}, instance.Spec.Objective.AdditionalMetricNames...)
+	var filters []string
+	if source := instance.Spec.MetricsCollector.Source; source != nil && source.Filter != nil {
+		filters = source.Filter.MetricsFormat
+	}
+
+	var follower *podlogsmc.Follower
+	logsSource := ""
+	for {
+		pod, containerStatus, err := c.getPrimaryContainer(ctx, instance)
+		if err != nil {
+			logger.Error(err, "Get primary pod error")
+		} else if containerStatus != nil && (containerStatus.State.Running != nil || containerStatus.State.Terminated != nil) {
+			// Offsets of the logs are valid only for the same container run.
+			containerSource := fmt.Sprintf("%s/%d", pod.Name, containerStatus.RestartCount)
+			if containerSource != logsSource {
+				logsSource = containerSource
+				follower = podlogsmc.NewFollower(metrics, filters)
+			}
+			send := func(mlogs []*api_pb.MetricLog, offset int64) error {
+				_, err := c.managerClient.ReportTrialObservationLog(instance, &api_pb.ObservationLog{MetricLogs: mlogs}, logsSource, offset)
+				return err
+			}
+			// Logs of the terminated container are completed once the stream is finished.
+			isTerminated := containerStatus.State.Terminated != nil
+			if err = c.followLogs(ctx, pod, instance.Spec.PrimaryContainerName, follower, send); err != nil {
+				logger.Error(err, "Follow primary container logs error", "Pod", pod.Name)
+				// Logs of the terminated container are removed with the container, e.g. once the node is deleted.
+				if isTerminated && errors.IsNotFound(err) {
+					logger.Info("Metrics are unavailable, primary container logs are not found", "Pod", pod.Name)
+					c.setUnavailable(collection, fmt.Sprintf("Logs of the primary container in pod %s are not found", pod.Name))
+					return
+				}
+			} else if isTerminated {
+				if err = follower.Finish(send); err == nil {
+					c.mu.Lock()
+					collection.collected = logsSource
+					c.mu.Unlock()
+					logger.Info("Metrics are collected from primary container logs", "Pod", pod.Name)
+					return
+				}
+				logger.Error(err, "Report observation log error")
+			}
+		} else if err == nil && c.isJobSucceeded(collection) {
+			// Primary pod of the succeeded job is deleted before its logs are collected.
+			logger.Info("Metrics are unavailable, primary container of the succeeded job is not found")
+			c.setUnavailable(collection, "Primary container of the succeeded Trial job is not found")
+			return
+		}
+		if !c.wait(ctx) {
+			return
+		}
+	}
+}
+
+func (c *podLogsCollector) isJobSucceeded(collection *podLogsCollection) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return collection.jobSucceeded
+}
+
+func (c *podLogsCollector) setUnavailable(collection *podLogsCollection, message string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	collection.unavailable = message
+}
+
+// getPrimaryContainer returns the latest primary pod of the Trial job and the status of the primary container.
+// Pod is nil if it's not created yet, container status is nil if the container is not created yet.
+func (c *podLogsCollector) getPrimaryContainer(ctx context.Context, instance *trialsv1beta1.Trial) (*corev1.Pod, *corev1.ContainerStatus, error) {
+	pods, err := c.kubeClient.CoreV1().Pods(instance.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(instance.Spec.PrimaryPodLabels).String(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var primaryPod *corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !isOwnedByTrialJob(pod, instance) {
+			continue
+		}
+		// Failed pods of the job are replaced with the new pods.
+		if primaryPod == nil || primaryPod.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			primaryPod = pod
+		}
+	}
+	if primaryPod == nil {
+		return nil, nil, nil
+	}
+	for i := range primaryPod.Status.ContainerStatuses {
+		if primaryPod.Status.ContainerStatuses[i].Name == instance.Spec.PrimaryContainerName {
+			return primaryPod, &primaryPod.Status.ContainerStatuses[i], nil
+		}
+	}
+	return primaryPod, nil, nil
+}
+
+// followLogs streams logs of the container from the beginning and passes them to the follower.
+func (c *podLogsCollector) followLogs(ctx context.Context, pod *corev1.Pod, containerName string, follower *podlogsmc.Follower,
+	send func([]*api_pb.MetricLog, int64) error) error {
+	stream, err := c.kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: containerName,
+		Follow:    true,
+		// Timestamps of the log lines are used as timestamps of the metrics.
+		Timestamps: true,
+	}).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	return follower.Follow(stream, c.flushInterval, send)
+}
+
+// wait returns false if collecting is stopped before the poll interval.
+func (c *podLogsCollector) wait(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(c.pollInterval):
+		return true
+	}
+}
+
+// isPodLogsCollected returns true if metrics of the Trial are collected and it's persisted in the Trial annotation.
+// Metrics are not collected again once Katib controller is restarted, since logs of the pod may be deleted.
+func isPodLogsCollected(instance *trialsv1beta1.Trial) bool {
+	_, ok := instance.GetAnnotations()[consts.AnnotationPodLogsCollected]
+	return ok
+}
+
+// isPodLogsCollector returns true if metrics of the Trial are collected with PodLogs metrics collector.
+func isPodLogsCollector(instance *trialsv1beta1.Trial) bool {
+	return instance.Spec.MetricsCollector.Collector != nil &&
+		instance.Spec.MetricsCollector.Collector.Kind == commonv1beta1.PodLogsCollector
+}
+
+// isOwnedByTrialJob returns true if the pod is created by the Trial job, e.g. by the batch Job or TFJob of the Trial.
+func isOwnedByTrialJob(pod *corev1.Pod, instance *trialsv1beta1.Trial) bool {
+	if instance.Spec.RunSpec == nil {
+		return false
+	}
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == instance.Spec.RunSpec.GetKind() && owner.Name == instance.Spec.RunSpec.GetName() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	managerclientmock "github.com/kubeflow/katib/pkg/mock/v1beta1/trial/managerclient"
+)
+
+func TestGetPrimaryContainer(t *testing.T) {
+	instance := newFakePodLogsTrial()
+	terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}
+
+	testCases := []struct {
+		description       string
+		pods              []runtime.Object
+		expectedPod       string
+		expectedContainer bool
+	}{
+		{
+			description: "Pod is not created",
+		},
+		{
+			description: "Pod of another job is skipped",
+			pods: []runtime.Object{
+				newFakePodLogsPod("another-pod", "another-job", time.Time{}, terminated),
+			},
+		},
+		{
+			description: "Latest pod of the job is returned",
+			pods: []runtime.Object{
+				newFakePodLogsPod("old-pod", batchJobName, time.Unix(1, 0), terminated),
+				newFakePodLogsPod("new-pod", batchJobName, time.Unix(2, 0), terminated),
+			},
+			expectedPod:       "new-pod",
+			expectedContainer: true,
+		},
+		{
+			description: "Container is not created",
+			pods: []runtime.Object{
+				newFakePodLogsPod("pod", batchJobName, time.Time{}),
+			},
+			expectedPod: "pod",
+		},
+	}
+
+	for _, tc := range testCases {
+		c := newPodLogsCollector(fake.NewSimpleClientset(tc.pods...), nil)
+		pod, containerStatus, err := c.getPrimaryContainer(context.TODO(), instance)
+		if err != nil {
+			t.Errorf("Case: %v failed: %v", tc.description, err)
+			continue
+		}
+		podName := ""
+		if pod != nil {
+			podName = pod.Name
+		}
+		if podName != tc.expectedPod {
+			t.Errorf("Case: %v failed. Expected pod %q, got %q", tc.description, tc.expectedPod, podName)
+		}
+		if (containerStatus != nil) != tc.expectedContainer {
+			t.Errorf("Case: %v failed. Expected container status %v, got %v", tc.description, tc.expectedContainer, containerStatus)
+		}
+	}
+}
+
+func TestPodLogsCollect(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockManagerClient := managerclientmock.NewMockManagerClient(mockCtrl)
+
+	instance := newFakePodLogsTrial()
+	pod := newFakePodLogsPod("pod", batchJobName, time.Time{},
+		corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}})
+	c := newPodLogsCollector(fake.NewSimpleClientset(pod), mockManagerClient)
+
+	// Fake client returns "fake logs" which don't contain the objective metric.
+	expectedLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+				Metric: &api_pb.Metric{
+					Name:  objectiveMetric,
+					Value: consts.UnavailableMetricValue,
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		mockManagerClient.EXPECT().DeleteTrialObservationLog(gomock.Any()).Return(nil, nil),
+		mockManagerClient.EXPECT().ReportTrialObservationLog(gomock.Any(), gomock.Eq(expectedLog), "pod/0", int64(len("fake logs")+1)).
+			Return(nil, nil),
+	)
+
+	collection := &podLogsCollection{}
+	c.collect(context.TODO(), instance, collection)
+	if collection.collected != "pod/0" {
+		t.Errorf("Logs of the terminated container are not collected, got logs source %q", collection.collected)
+	}
+}
+
+func TestPodLogsCollectUnavailable(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockManagerClient := managerclientmock.NewMockManagerClient(mockCtrl)
+
+	// Primary pod of the succeeded job is deleted.
+	instance := newFakePodLogsTrial()
+	c := newPodLogsCollector(fake.NewSimpleClientset(), mockManagerClient)
+	mockManagerClient.EXPECT().DeleteTrialObservationLog(gomock.Any()).Return(nil, nil)
+
+	collection := &podLogsCollection{jobSucceeded: true}
+	c.collect(context.TODO(), instance, collection)
+	if collection.collected != "" || collection.unavailable == "" {
+		t.Errorf("Metrics must be unavailable if primary pod of the succeeded job is not found, got collected %q and unavailable %q",
+			collection.collected, collection.unavailable)
+	}
+}
+
+func TestIsPodLogsCollected(t *testing.T) {
+	instance := newFakePodLogsTrial()
+	if isPodLogsCollected(instance) {
+		t.Errorf("Logs must not be collected without annotation")
+	}
+	instance.SetAnnotations(map[string]string{consts.AnnotationPodLogsCollected: "pod/0"})
+	if !isPodLogsCollected(instance) {
+		t.Errorf("Logs must be collected with annotation %v", consts.AnnotationPodLogsCollected)
+	}
+}
+
+func newFakePodLogsTrial() *trialsv1beta1.Trial {
+	instance := newFakeTrialBatchJob()
+	instance.Spec.MetricsCollector = commonv1beta1.MetricsCollectorSpec{
+		Collector: &commonv1beta1.CollectorSpec{
+			Kind: commonv1beta1.PodLogsCollector,
+		},
+	}
+	return instance
+}
+
+func newFakePodLogsPod(name, jobName string, creationTime time.Time, containerStates ...corev1.ContainerState) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(creationTime),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "batch/v1",
+					Kind:       "Job",
+					Name:       jobName,
+				},
+			},
+		},
+	}
+	for _, state := range containerStates {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:  "training-container",
+			State: state,
+		})
+	}
+	return pod
+}
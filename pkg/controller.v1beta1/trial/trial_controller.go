@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -42,7 +43,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/trial/managerclient"
 	trialutil "github.com/kubeflow/katib/pkg/controller.v1beta1/trial/util"
@@ -62,17 +65,34 @@ var (
 // Add creates a new Trial Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
-	return add(mgr, newReconciler(mgr))
+	dbManagerOpts, err := katibmanagerv1beta1.DBManagerClientOptionsFromEnv()
+	if err != nil {
+		log.Error(err, "Load Katib DB Manager client credentials error")
+		return err
+	}
+	dbManagerClient, err := katibmanagerv1beta1.NewKatibDBManagerClient(katibmanagerv1beta1.GetDBManagerAddr(), dbManagerOpts...)
+	if err != nil {
+		log.Error(err, "Create Katib DB Manager client error")
+		return err
+	}
+	dbManagerCA, err := katibmanagerv1beta1.DBManagerCAFromEnv()
+	if err != nil {
+		log.Error(err, "Load Katib DB Manager CA certificate for metrics collectors error")
+		return err
+	}
+	return add(mgr, newReconciler(mgr, managerclient.New(dbManagerClient), dbManagerCA))
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager, managerClient managerclient.ManagerClient, dbManagerCA []byte) reconcile.Reconciler {
 	r := &ReconcileTrial{
 		Client:        mgr.GetClient(),
 		scheme:        mgr.GetScheme(),
-		ManagerClient: managerclient.New(),
+		ManagerClient: managerClient,
 		recorder:      mgr.GetEventRecorderFor(ControllerName),
 		collector:     trialutil.NewTrialsCollector(mgr.GetCache(), metrics.Registry),
+		podLogs:       newPodLogsCollector(kubernetes.NewForConfigOrDie(mgr.GetConfig()), managerClient),
+		dbManagerCA:   dbManagerCA,
 	}
 	r.updateStatusHandler = r.updateStatus
 	return r
@@ -144,6 +164,11 @@ type ReconcileTrial struct {
 	updateStatusHandler updateStatusFunc
 	// collector is a wrapper for experiment metrics.
 	collector *trialutil.TrialsCollector
+	// podLogs collects metrics of Trials with PodLogs metrics collector.
+	podLogs *podLogsCollector
+	// dbManagerCA is the CA certificate to verify Katib DB Manager for metrics collectors.
+	// It is nil if Katib DB Manager doesn't use TLS.
+	dbManagerCA []byte
 }
 
 // Reconcile reads that state of the cluster for a Trial object and makes changes based on the state read
@@ -159,6 +184,7 @@ func (r *ReconcileTrial) Reconcile(ctx context.Context, request reconcile.Reques
 		if errors.IsNotFound(err) {
 			// Object not found, return.  Created objects are automatically garbage collected.
 			// For additional cleanup logic use finalizers.
+			r.podLogs.stopCollecting(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -222,12 +248,34 @@ func (r *ReconcileTrial) reconcileTrial(instance *trialsv1beta1.Trial) error {
 		return err
 	}
 
+	updateTrialSuspendTime(instance, metav1.Now())
+
+	// Job of the suspended Trial is deleted and created again once the Trial is resumed.
+	if instance.Spec.Suspend && instance.Spec.SuspendPolicy == commonv1beta1.SuspendPolicyDeleteJobs && !instance.IsCompleted() {
+		return r.deleteSuspendedJob(instance, desiredJob)
+	}
+	if instance.Spec.Suspend && instance.Spec.SuspendPolicy == commonv1beta1.SuspendPolicySuspendJobs {
+		if err = trialutil.SetJobSuspend(desiredJob, true); err != nil {
+			logger.Error(err, "Set job suspend error")
+			return err
+		}
+	}
+
 	deployedJob, err := r.reconcileJob(instance, desiredJob)
 	if err != nil {
 		logger.Error(err, "Reconcile job error")
 		return err
 	}
 
+	// Metrics of the Trial with PodLogs metrics collector are collected by the controller.
+	if isPodLogsCollector(instance) {
+		if instance.IsCompleted() || isPodLogsCollected(instance) {
+			r.podLogs.stopCollecting(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+		} else if deployedJob != nil {
+			r.podLogs.ensureCollecting(instance)
+		}
+	}
+
 	// Job already exists.
 	// If Trial is EarlyStopped we need to verify/update observation logs.
 	// In that case, Trial's job will be deleted even if metrics are not available.
@@ -242,6 +290,11 @@ func (r *ReconcileTrial) reconcileTrial(instance *trialsv1beta1.Trial) error {
 			return nil
 		}
 
+		// Logs of the primary container must be collected before observation is updated.
+		if jobStatus.Condition == trialutil.JobSucceeded && isPodLogsCollector(instance) && !isPodLogsCollected(instance) && !instance.IsCompleted() {
+			return r.reconcilePodLogsCollected(instance, deployedJob.GetName())
+		}
+
 		// If Job status is succeeded or Trial is early stopped, update Trial observation.
 		if jobStatus.Condition == trialutil.JobSucceeded || instance.IsEarlyStopped() {
 			if err = r.UpdateTrialStatusObservation(instance); err != nil {
@@ -282,6 +335,11 @@ func (r *ReconcileTrial) reconcileJob(instance *trialsv1beta1.Trial, desiredJob
 				return nil, nil
 			}
 
+			if err = r.reconcileDBManagerClientSecret(instance); err != nil {
+				logger.Error(err, "Reconcile Katib DB Manager client Secret error")
+				return nil, err
+			}
+
 			logger.Info("Creating Job", "kind", kind,
 				"name", desiredJob.GetName())
 			err = r.Create(context.TODO(), desiredJob)
@@ -310,11 +368,74 @@ func (r *ReconcileTrial) reconcileJob(instance *trialsv1beta1.Trial, desiredJob
 				return nil, nil
 			}
 		}
+		// Deployed Job is suspended or resumed once the Trial suspend is changed.
+		if !instance.IsCompleted() && instance.Spec.SuspendPolicy == commonv1beta1.SuspendPolicySuspendJobs &&
+			trialutil.GetJobSuspend(deployedJob) != trialutil.GetJobSuspend(desiredJob) {
+			suspend := trialutil.GetJobSuspend(desiredJob)
+			if err = trialutil.SetJobSuspend(deployedJob, suspend); err != nil {
+				logger.Error(err, "Set job suspend error")
+				return nil, err
+			}
+			if err = r.Update(context.TODO(), deployedJob); err != nil {
+				logger.Error(err, "Update job error")
+				return nil, err
+			}
+			if suspend {
+				eventMsg := fmt.Sprintf("Job %s has been suspended", deployedJob.GetName())
+				r.recorder.Eventf(instance, corev1.EventTypeNormal, JobSuspendedReason, eventMsg)
+			} else {
+				eventMsg := fmt.Sprintf("Job %s has been resumed", deployedJob.GetName())
+				r.recorder.Eventf(instance, corev1.EventTypeNormal, JobResumedReason, eventMsg)
+			}
+		}
 	}
 
 	return deployedJob, nil
 }
 
+// deleteSuspendedJob deletes Job of the suspended Trial.
+// Observation logs are deleted with the Job, since Trial is started from scratch once it is resumed.
+func (r *ReconcileTrial) deleteSuspendedJob(instance *trialsv1beta1.Trial, desiredJob *unstructured.Unstructured) error {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	if isPodLogsCollector(instance) {
+		r.podLogs.stopCollecting(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	}
+
+	deployedJob := &unstructured.Unstructured{}
+	deployedJob.SetGroupVersionKind(desiredJob.GroupVersionKind())
+	err := r.Get(context.TODO(), types.NamespacedName{Name: desiredJob.GetName(), Namespace: desiredJob.GetNamespace()}, deployedJob)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		logger.Error(err, "Job Get error")
+		return err
+	}
+	if !deployedJob.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+	if err = r.Delete(context.TODO(), deployedJob, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
+		logger.Error(err, "Delete job error")
+		return err
+	}
+	if _, err = r.DeleteTrialObservationLog(instance); err != nil {
+		logger.Error(err, "Delete trial observation log error")
+		return err
+	}
+	// Logs of the primary container are collected again once the Trial is resumed.
+	if isPodLogsCollected(instance) {
+		original := instance.DeepCopy()
+		delete(instance.Annotations, consts.AnnotationPodLogsCollected)
+		if err = r.Patch(context.TODO(), instance, client.MergeFrom(original)); err != nil {
+			logger.Error(err, "Patch Trial annotations error")
+			return err
+		}
+	}
+	eventMsg := fmt.Sprintf("Job %s has been deleted because Trial is suspended", deployedJob.GetName())
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, JobDeletedReason, eventMsg)
+	return nil
+}
+
 func (r *ReconcileTrial) getDesiredJobSpec(instance *trialsv1beta1.Trial) (*unstructured.Unstructured, error) {
 
 	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
@@ -37,6 +37,8 @@ const (
 	JobMetricsUnavailableReason = "MetricsUnavailable"
 	JobFailedReason             = "JobFailed"
 	JobRunningReason            = "JobRunning"
+	JobSuspendedReason          = "JobSuspended"
+	JobResumedReason            = "JobResumed"
 )
 
 type updateStatusFunc func(instance *trialsv1beta1.Trial) error
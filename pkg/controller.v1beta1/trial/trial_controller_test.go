@@ -27,9 +27,11 @@ import (
 	"github.com/spf13/viper"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -103,6 +105,7 @@ func TestReconcileBatchJob(t *testing.T) {
 		ManagerClient: mockManagerClient,
 		recorder:      mgr.GetEventRecorderFor(ControllerName),
 		collector:     trialutil.NewTrialsCollector(mgr.GetCache(), prometheus.NewRegistry()),
+		podLogs:       newPodLogsCollector(fake.NewSimpleClientset(), mockManagerClient),
 	}
 
 	r.updateStatusHandler = func(instance *trialsv1beta1.Trial) error {
@@ -140,45 +143,36 @@ func TestReconcileBatchJob(t *testing.T) {
 		g.Expect(mgr.Start(ctx)).NotTo(gomega.HaveOccurred())
 	}()
 
-	// Result for GetTrialObservationLog with some metrics.
-	observationLogAvailable := &api_pb.GetObservationLogReply{
-		ObservationLog: &api_pb.ObservationLog{
-			MetricLogs: []*api_pb.MetricLog{
-				{
-					TimeStamp: "2020-08-10T14:47:38+08:00",
-					Metric: &api_pb.Metric{
-						Name:  objectiveMetric,
-						Value: "0.99",
-					},
-				},
-				{
-					TimeStamp: "2020-08-10T14:50:38+08:00",
-					Metric: &api_pb.Metric{
-						Name:  objectiveMetric,
-						Value: "0.11",
-					},
-				},
+	// Result for GetTrialObservationSummary with some metrics.
+	observationSummaryAvailable := &api_pb.GetObservationSummaryReply{
+		MetricSummaries: []*api_pb.MetricSummary{
+			{
+				MetricName:     objectiveMetric,
+				Min:            "0.11",
+				Max:            "0.99",
+				Latest:         "0.11",
+				Count:          2,
+				FirstTimeStamp: "2020-08-10T06:47:38Z",
+				LastTimeStamp:  "2020-08-10T06:50:38Z",
 			},
 		},
 	}
-	// Empty result for GetTrialObservationLog.
+	// Empty result for GetTrialObservationSummary.
 	// If objective metrics are not parsed, metrics collector reports "unavailable" value to DB.
-	observationLogUnavailable := &api_pb.GetObservationLogReply{
-		ObservationLog: &api_pb.ObservationLog{
-			MetricLogs: []*api_pb.MetricLog{
-				{
-					Metric: &api_pb.Metric{
-						Name:  objectiveMetric,
-						Value: consts.UnavailableMetricValue,
-					},
-					TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
-				},
+	observationSummaryUnavailable := &api_pb.GetObservationSummaryReply{
+		MetricSummaries: []*api_pb.MetricSummary{
+			{
+				MetricName:     objectiveMetric,
+				Latest:         consts.UnavailableMetricValue,
+				Count:          1,
+				FirstTimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+				LastTimeStamp:  time.Time{}.UTC().Format(time.RFC3339),
 			},
 		},
 	}
 
-	mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLogAvailable, nil).Times(1)
-	mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLogUnavailable, nil).MinTimes(1)
+	mockManagerClient.EXPECT().GetTrialObservationSummary(gomock.Any()).Return(observationSummaryAvailable, nil).Times(1)
+	mockManagerClient.EXPECT().GetTrialObservationSummary(gomock.Any()).Return(observationSummaryUnavailable, nil).MinTimes(1)
 	mockManagerClient.EXPECT().DeleteTrialObservationLog(gomock.Any()).Return(nil, nil).AnyTimes()
 
 	// Test 1 -  Trial run with "Failed" BatchJob.
@@ -259,7 +253,7 @@ func TestReconcileBatchJob(t *testing.T) {
 	g.Expect(c.Create(ctx, trial)).NotTo(gomega.HaveOccurred())
 
 	// Expect that Trial status is succeeded and metrics are properly populated
-	// Metrics available because GetTrialObservationLog returns values
+	// Metrics available because GetTrialObservationSummary returns values
 	g.Eventually(func() bool {
 		if err = c.Get(ctx, trialKey, trial); err != nil {
 			return false
@@ -285,7 +279,7 @@ func TestReconcileBatchJob(t *testing.T) {
 	g.Expect(c.Create(ctx, trial)).NotTo(gomega.HaveOccurred())
 
 	// Expect that Trial status is succeeded with "false" status and "metrics unavailable" reason.
-	// Metrics unavailable because GetTrialObservationLog returns "unavailable".
+	// Metrics unavailable because GetTrialObservationSummary returns "unavailable".
 	g.Eventually(func() bool {
 		if err = c.Get(ctx, trialKey, trial); err != nil {
 			return false
@@ -312,60 +306,101 @@ func TestReconcileBatchJob(t *testing.T) {
 
 func TestGetObjectiveMetricValue(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
-	metricLogs := []*api_pb.MetricLog{
-		{TimeStamp: "2020-04-13T14:47:38+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.03"}},
-		{TimeStamp: "2020-04-13T14:47:39+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.02"}},
-		{TimeStamp: "2020-04-13T14:47:40+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.01"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.05"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.06"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.07"}},
-		{TimeStamp: "2020-04-12T14:47:42+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.1"}},
-		{TimeStamp: "2020-04-13T14:47:38+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.7"}},
-		{TimeStamp: "2020-04-13T14:47:39+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.71"}},
-		{TimeStamp: "2020-04-13T14:47:40+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.72"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.68"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.69"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.67"}},
-		{TimeStamp: "2020-04-12T14:47:42+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.6"}},
-	}
-
-	getMetricsFromLogs := func(strategies []commonv1beta1.MetricStrategy) (*commonv1beta1.Metric, *commonv1beta1.Metric, error) {
-		observation, err := getMetrics(metricLogs, strategies)
-		if err != nil {
-			return nil, nil, err
-		}
-		var errMetric, accMetric *commonv1beta1.Metric
-		for index, metric := range observation.Metrics {
-			if metric.Name == "error" {
-				errMetric = &observation.Metrics[index]
-			} else if metric.Name == objectiveMetric {
-				accMetric = &observation.Metrics[index]
-			}
-		}
-		return errMetric, accMetric, nil
+	metricSummaries := []*api_pb.MetricSummary{
+		{MetricName: "error", Min: "0.01", Max: "0.1", Latest: "0.07", Count: 7},
+		{MetricName: objectiveMetric, Min: "0.6", Max: "0.72", Latest: "0.67", Count: 7},
+		// Metric without numeric values
+		{MetricName: "recall", Latest: consts.UnavailableMetricValue, Count: 1},
+		// Metric with only NaN values, which are not aggregated as numbers
+		{MetricName: "loss", Latest: "NaN", Count: 3},
+		// Metric which is not in the strategies
+		{MetricName: "not-accuracy", Min: "1.15", Max: "1.15", Latest: "1.15", Count: 1},
 	}
 
 	metricStrategies := []commonv1beta1.MetricStrategy{
 		{Name: "error", Value: commonv1beta1.ExtractByMin},
 		{Name: objectiveMetric, Value: commonv1beta1.ExtractByMax},
+		{Name: "recall", Value: commonv1beta1.ExtractByLatest},
+		{Name: "loss", Value: commonv1beta1.ExtractByMin},
+		{Name: "precision", Value: commonv1beta1.ExtractByLatest},
+	}
+	observation := getMetrics(metricSummaries, metricStrategies)
+	g.Expect(observation.Metrics).To(gomega.Equal([]commonv1beta1.Metric{
+		{Name: "error", Min: "0.01", Max: "0.1", Latest: "0.07"},
+		{Name: objectiveMetric, Min: "0.6", Max: "0.72", Latest: "0.67"},
+		{
+			Name:   "recall",
+			Min:    consts.UnavailableMetricValue,
+			Max:    consts.UnavailableMetricValue,
+			Latest: consts.UnavailableMetricValue,
+		},
+		{
+			Name:   "loss",
+			Min:    consts.UnavailableMetricValue,
+			Max:    consts.UnavailableMetricValue,
+			Latest: "NaN",
+		},
+		{
+			Name:   "precision",
+			Min:    consts.UnavailableMetricValue,
+			Max:    consts.UnavailableMetricValue,
+			Latest: consts.UnavailableMetricValue,
+		},
+	}))
+}
+
+func TestUpdateTrialSuspendTime(t *testing.T) {
+	now := metav1.Now()
+	suspendTime := metav1.NewTime(now.Add(-time.Hour))
+	completionTime := metav1.NewTime(now.Add(-30 * time.Minute))
+
+	testCases := []struct {
+		description              string
+		trial                    *trialsv1beta1.Trial
+		expectedSuspendTime      *metav1.Time
+		expectedSuspendedSeconds int64
+	}{
+		{
+			description: "Trial is suspended",
+			trial: func() *trialsv1beta1.Trial {
+				trial := newFakeTrialBatchJob()
+				trial.Spec.Suspend = true
+				return trial
+			}(),
+			expectedSuspendTime: &now,
+		},
+		{
+			description: "Trial is resumed",
+			trial: func() *trialsv1beta1.Trial {
+				trial := newFakeTrialBatchJob()
+				trial.Status.SuspendTime = &suspendTime
+				trial.Status.SuspendedSeconds = 60
+				return trial
+			}(),
+			expectedSuspendedSeconds: 3660,
+		},
+		{
+			description: "Trial is completed while it is suspended",
+			trial: func() *trialsv1beta1.Trial {
+				trial := newFakeTrialBatchJob()
+				trial.Spec.Suspend = true
+				trial.Status.SuspendTime = &suspendTime
+				trial.Status.CompletionTime = &completionTime
+				trial.MarkTrialStatusSucceeded(corev1.ConditionTrue, TrialSucceededReason, "Trial has succeeded")
+				return trial
+			}(),
+			expectedSuspendedSeconds: 1800,
+		},
 	}
-	errMetric, accMetric, err := getMetricsFromLogs(metricStrategies)
-	g.Expect(err).ShouldNot(gomega.HaveOccurred())
-	g.Expect(errMetric.Latest).To(gomega.Equal("0.07"))
-	g.Expect(errMetric.Max).To(gomega.Equal("0.1"))
-	g.Expect(errMetric.Min).To(gomega.Equal("0.01"))
-	g.Expect(accMetric.Latest).To(gomega.Equal("0.67"))
-	g.Expect(accMetric.Max).To(gomega.Equal("0.72"))
-	g.Expect(accMetric.Min).To(gomega.Equal("0.6"))
-
-	invalidLogs := []*api_pb.MetricLog{
-		// Add one other metric to test correct parsing
-		{TimeStamp: "2020-08-10T14:47:42+08:00", Metric: &api_pb.Metric{Name: "not-accuracy", Value: "1.15"}},
-		// Add metric with invalid timestamp
-		{TimeStamp: "2020-08-10T14:47:42", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.77"}},
+
+	for _, tc := range testCases {
+		updateTrialSuspendTime(tc.trial, now)
+		if !equality.Semantic.DeepEqual(tc.trial.Status.SuspendTime, tc.expectedSuspendTime) ||
+			tc.trial.Status.SuspendedSeconds != tc.expectedSuspendedSeconds {
+			t.Errorf("Case: %v failed. Expected suspend time %v and suspended seconds %v, got %v and %v", tc.description,
+				tc.expectedSuspendTime, tc.expectedSuspendedSeconds, tc.trial.Status.SuspendTime, tc.trial.Status.SuspendedSeconds)
+		}
 	}
-	_, err = getMetrics(invalidLogs, metricStrategies)
-	g.Expect(err).To(gomega.HaveOccurred())
 }
 
 func newFakeTrialBatchJob() *trialsv1beta1.Trial {
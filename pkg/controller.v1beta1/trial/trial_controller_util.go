@@ -19,17 +19,19 @@ package trial
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	trialutil "github.com/kubeflow/katib/pkg/controller.v1beta1/trial/util"
 )
@@ -122,19 +124,14 @@ func (r *ReconcileTrial) UpdateTrialStatusCondition(instance *trialsv1beta1.Tria
 }
 
 func (r *ReconcileTrial) UpdateTrialStatusObservation(instance *trialsv1beta1.Trial) error {
-	reply, err := r.GetTrialObservationLog(instance)
+	reply, err := r.GetTrialObservationSummary(instance)
 	if err != nil {
-		log.Error(err, "Get trial observation log error")
+		log.Error(err, "Get trial observation summary error")
 		return err
 	}
 	metricStrategies := instance.Spec.Objective.MetricStrategies
-	if len(reply.ObservationLog.MetricLogs) != 0 {
-		observation, err := getMetrics(reply.ObservationLog.MetricLogs, metricStrategies)
-		if err != nil {
-			log.Error(err, "Get metrics from logs error")
-			return err
-		}
-		instance.Status.Observation = observation
+	if len(reply.MetricSummaries) != 0 {
+		instance.Status.Observation = getMetrics(reply.MetricSummaries, metricStrategies)
 	}
 	return nil
 }
@@ -162,6 +159,91 @@ func (r *ReconcileTrial) updateFinalizers(instance *trialsv1beta1.Trial, finaliz
 	}
 }
 
+// reconcilePodLogsCollected persists in the Trial annotation that logs of the primary container are collected.
+// If the logs can't be collected, e.g. the primary pod is deleted, Trial metrics are marked as unavailable.
+// Otherwise, it returns errMetricsNotReported to wait until the logs are collected.
+func (r *ReconcileTrial) reconcilePodLogsCollected(instance *trialsv1beta1.Trial, deployedJobName string) error {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	if collected := r.podLogs.getCollected(instance); collected != "" {
+		original := instance.DeepCopy()
+		annotations := instance.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[consts.AnnotationPodLogsCollected] = collected
+		instance.SetAnnotations(annotations)
+		// Trial is reconciled again once it is patched, then observation is updated from the collected logs.
+		if err := r.Patch(context.TODO(), instance, client.MergeFrom(original)); err != nil {
+			logger.Error(err, "Patch Trial annotations error")
+			return err
+		}
+		return nil
+	}
+	if msg := r.podLogs.getUnavailable(instance); msg != "" {
+		logger.Info("Trial status changed to Metrics Unavailable")
+		instance.MarkTrialStatusMetricsUnavailable(TrialMetricsUnavailableReason, msg)
+		now := metav1.Now()
+		instance.Status.CompletionTime = &now
+
+		eventMsg := fmt.Sprintf("Metrics are not available for Job %v", deployedJobName)
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, JobMetricsUnavailableReason, eventMsg)
+		r.collector.IncreaseTrialsMetricsUnavailableCount(instance.Namespace)
+		return nil
+	}
+	r.podLogs.setJobSucceeded(instance)
+	logger.Info("Trial job is succeeded but primary container logs are not collected, reconcile requeued")
+	return errMetricsNotReported
+}
+
+// reconcileDBManagerClientSecret creates the Secret with Katib DB Manager CA certificate in the Trial namespace,
+// since the metrics collector can't mount the Secret from Katib namespace. Secret is deleted with the Trial.
+// Metrics collectors and training code of the Trial with None metrics collector authenticate with the Trial token,
+// so the Secret has only the CA certificate to verify Katib DB Manager.
+func (r *ReconcileTrial) reconcileDBManagerClientSecret(instance *trialsv1beta1.Trial) error {
+	if r.dbManagerCA == nil || instance.Spec.MetricsCollector.Collector.Kind == commonv1beta1.PodLogsCollector {
+		return nil
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      katibmanagerv1beta1.GetDBManagerClientSecretName(instance.Name),
+			Namespace: instance.Namespace,
+		},
+		Data: map[string][]byte{
+			consts.DBManagerClientCAKey: r.dbManagerCA,
+		},
+	}
+	if err := controllerutil.SetControllerReference(instance, secret, r.scheme); err != nil {
+		return err
+	}
+	if err := r.Create(context.TODO(), secret); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// updateTrialSuspendTime records the time when the Trial is suspended and sums up the suspended time once
+// the Trial is resumed or completed. Suspended time is not counted in the trial runtime of the Experiment budget.
+func updateTrialSuspendTime(instance *trialsv1beta1.Trial, now metav1.Time) {
+	if instance.Spec.Suspend && !instance.IsCompleted() {
+		if instance.Status.SuspendTime == nil {
+			instance.Status.SuspendTime = &now
+		}
+		return
+	}
+	if instance.Status.SuspendTime == nil {
+		return
+	}
+	resumeTime := now
+	if instance.IsCompleted() && instance.Status.CompletionTime != nil && !instance.Status.CompletionTime.IsZero() &&
+		instance.Status.CompletionTime.Before(&now) {
+		resumeTime = *instance.Status.CompletionTime
+	}
+	if resumeTime.After(instance.Status.SuspendTime.Time) {
+		instance.Status.SuspendedSeconds += int64(resumeTime.Sub(instance.Status.SuspendTime.Time).Seconds())
+	}
+	instance.Status.SuspendTime = nil
+}
+
 func isTrialObservationAvailable(instance *trialsv1beta1.Trial) bool {
 	objectiveMetricName := instance.Spec.Objective.ObjectiveMetricName
 	if instance.Status.Observation != nil && instance.Status.Observation.Metrics != nil {
@@ -174,58 +256,35 @@ func isTrialObservationAvailable(instance *trialsv1beta1.Trial) bool {
 	return false
 }
 
-func getMetrics(metricLogs []*api_pb.MetricLog, strategies []commonv1beta1.MetricStrategy) (*commonv1beta1.Observation, error) {
-	metrics := make(map[string]*commonv1beta1.Metric)
-	timestamps := make(map[string]*time.Time)
+func getMetrics(metricSummaries []*api_pb.MetricSummary, strategies []commonv1beta1.MetricStrategy) *commonv1beta1.Observation {
+	summaries := make(map[string]*api_pb.MetricSummary)
+	for _, summary := range metricSummaries {
+		summaries[summary.MetricName] = summary
+	}
+
+	observation := &commonv1beta1.Observation{}
 	for _, strategy := range strategies {
-		timestamps[strategy.Name] = nil
-		metrics[strategy.Name] = &commonv1beta1.Metric{
+		metric := commonv1beta1.Metric{
 			Name:   strategy.Name,
 			Min:    consts.UnavailableMetricValue,
 			Max:    consts.UnavailableMetricValue,
 			Latest: consts.UnavailableMetricValue,
 		}
-	}
-
-	for _, metricLog := range metricLogs {
-		metric, ok := metrics[metricLog.Metric.Name]
-		if !ok {
-			continue
-		}
-		strValue := metricLog.Metric.Value
-		floatValue, err := strconv.ParseFloat(strValue, 64)
-		if err == nil {
-			if metric.Min == consts.UnavailableMetricValue {
-				metric.Min = strValue
-				metric.Max = strValue
-			} else {
-				// We can't get error here, because we parsed this value before
-				minMetric, _ := strconv.ParseFloat(metric.Min, 64)
-				maxMetric, _ := strconv.ParseFloat(metric.Max, 64)
-				if floatValue < minMetric {
-					metric.Min = strValue
-				} else if floatValue > maxMetric {
-					metric.Max = strValue
-				}
+		if summary, ok := summaries[strategy.Name]; ok {
+			// Min and Max are empty if metric doesn't have numeric values
+			if summary.Min != "" {
+				metric.Min = summary.Min
 			}
+			if summary.Max != "" {
+				metric.Max = summary.Max
+			}
+			// Latest is the value with the highest step, or the last reported value if metrics don't have step.
+			metric.Latest = summary.Latest
 		}
-		currentTime, err := time.Parse(time.RFC3339Nano, metricLog.TimeStamp)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse timestamps %s: %e", metricLog.TimeStamp, err)
-		}
-		timestamp := timestamps[metricLog.Metric.Name]
-		if timestamp == nil || !timestamp.After(currentTime) {
-			timestamps[metricLog.Metric.Name] = &currentTime
-			metric.Latest = strValue
-		}
-	}
-
-	observation := &commonv1beta1.Observation{}
-	for _, metric := range metrics {
-		observation.Metrics = append(observation.Metrics, *metric)
+		observation.Metrics = append(observation.Metrics, metric)
 	}
 
-	return observation, nil
+	return observation
 }
 
 func needUpdateFinalizers(trial *trialsv1beta1.Trial) (bool, []string) {
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 )
 
@@ -118,3 +119,23 @@ func GetDeployedJobStatus(trial *trialsv1beta1.Trial, deployedJob *unstructured.
 	// Otherwise returns nil object and Trial status doesn't need to be updated
 	return nil, nil
 }
+
+// GetJobSuspend returns whether the Job is suspended.
+func GetJobSuspend(job *unstructured.Unstructured) bool {
+	suspend, _, _ := unstructured.NestedBool(job.Object, getJobSuspendFields(job)...)
+	return suspend
+}
+
+// SetJobSuspend suspends or resumes the Job.
+func SetJobSuspend(job *unstructured.Unstructured, suspend bool) error {
+	return unstructured.SetNestedField(job.Object, suspend, getJobSuspendFields(job)...)
+}
+
+// getJobSuspendFields returns path to the Job suspend field.
+// Batch Job is suspended with spec.suspend, Kubeflow Training Operator jobs are suspended with spec.runPolicy.suspend.
+func getJobSuspendFields(job *unstructured.Unstructured) []string {
+	if job.GetKind() == consts.JobKindJob {
+		return []string{"spec", "suspend"}
+	}
+	return []string{"spec", "runPolicy", "suspend"}
+}
@@ -121,6 +121,56 @@ func TestGetDeployedJobStatus(t *testing.T) {
 	}
 }
 
+func TestSetJobSuspend(t *testing.T) {
+
+	tcs := []struct {
+		job                 *unstructured.Unstructured
+		suspend             bool
+		expectedSuspendPath []string
+		testDescription     string
+	}{
+		{
+			job: func() *unstructured.Unstructured {
+				job := newFakeDeployedJob(newFakeJob())
+				job.SetKind("Job")
+				return job
+			}(),
+			suspend:             true,
+			expectedSuspendPath: []string{"spec", "suspend"},
+			testDescription:     "Batch Job is suspended with spec.suspend",
+		},
+		{
+			job: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "kubeflow.org/v1",
+					"kind":       "TFJob",
+					"spec": map[string]interface{}{
+						"runPolicy": map[string]interface{}{
+							"suspend": true,
+						},
+					},
+				},
+			},
+			suspend:             false,
+			expectedSuspendPath: []string{"spec", "runPolicy", "suspend"},
+			testDescription:     "TFJob is resumed with spec.runPolicy.suspend",
+		},
+	}
+
+	for _, tc := range tcs {
+		if err := SetJobSuspend(tc.job, tc.suspend); err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+			continue
+		}
+		if suspend := GetJobSuspend(tc.job); suspend != tc.suspend {
+			t.Errorf("Case: %v failed. Expected suspend %v, got %v", tc.testDescription, tc.suspend, suspend)
+		}
+		if suspend, found, _ := unstructured.NestedBool(tc.job.Object, tc.expectedSuspendPath...); !found || suspend != tc.suspend {
+			t.Errorf("Case: %v failed. Expected %v to be %v", tc.testDescription, tc.expectedSuspendPath, tc.suspend)
+		}
+	}
+}
+
 func newFakeTrial(successCondition, failureCondition string) *trialsv1beta1.Trial {
 	return &trialsv1beta1.Trial{
 		Spec: trialsv1beta1.TrialSpec{
@@ -21,7 +21,9 @@ const (
 
 	DBNameEnvName = "DB_NAME"
 
-	MySqlDBNameEnvValue = "mysql"
+	MySqlDBNameEnvValue    = "mysql"
+	PostgresDBNameEnvValue = "postgres"
+	SqliteDBNameEnvValue   = "sqlite"
 
 	DBPasswordEnvName = "DB_PASSWORD"
 
@@ -33,4 +35,24 @@ const (
 	DefaultMySQLDatabase = "katib"
 	DefaultMySQLHost     = "katib-mysql"
 	DefaultMySQLPort     = "3306"
+
+	PostgreSQLDBHostEnvName = "KATIB_POSTGRESQL_DB_HOST"
+	PostgreSQLDBPortEnvName = "KATIB_POSTGRESQL_DB_PORT"
+	PostgreSQLDatabase      = "KATIB_POSTGRESQL_DB_DATABASE"
+	PostgreSQLSSLMode       = "KATIB_POSTGRESQL_SSL_MODE"
+
+	DefaultPostgreSQLUser     = "katib"
+	DefaultPostgreSQLDatabase = "katib"
+	DefaultPostgreSQLHost     = "katib-postgres"
+	DefaultPostgreSQLPort     = "5432"
+	DefaultPostgreSQLSSLMode  = "disable"
+
+	SqliteDBPathEnvName = "KATIB_SQLITE_DB_PATH"
+
+	DefaultSqliteDBPath = "/var/lib/katib/katib.db"
+
+	// MatchLegacyNamespaceEnvName is the env name to select observation logs without namespace by every namespace.
+	// Logs registered before the namespace was stored can't be matched to the Trial namespace,
+	// so they are selected only if this env is true and they are never deleted by the Trial.
+	MatchLegacyNamespaceEnvName = "KATIB_DB_MATCH_LEGACY_NAMESPACE"
 )
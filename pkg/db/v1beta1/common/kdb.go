@@ -17,14 +17,54 @@ limitations under the License.
 package common
 
 import (
+	"os"
+	"strconv"
+	"time"
+
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 )
 
+// ObservationLogOwner identifies the Trial which reported observation logs.
+type ObservationLogOwner struct {
+	Namespace string
+	TrialName string
+	TrialUID  string
+}
+
+// MatchLegacyNamespace checks if observation logs without namespace are selected by every namespace.
+func MatchLegacyNamespace() bool {
+	match, _ := strconv.ParseBool(os.Getenv(MatchLegacyNamespaceEnvName))
+	return match
+}
+
 type KatibDBInterface interface {
 	DBInit()
 	SelectOne() error
 
-	RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error
-	GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error)
-	DeleteObservationLog(trialName string) error
+	// Observation logs are owned by the Trial in the namespace.
+	// Empty namespace selects logs of Trials with the name in all namespaces.
+	// Logs reported without namespace are selected by every namespace only if MatchLegacyNamespace is true.
+	// DeleteObservationLog always matches the namespace, so logs without namespace are deleted
+	// only with the empty namespace and the empty namespace never deletes logs of other namespaces.
+	RegisterObservationLog(namespace string, experimentName string, trialName string, trialUID string, observationLog *v1beta1.ObservationLog) error
+	// Logs with the source offset are registered only if the offset is greater than the offset of the previous
+	// registered logs of the Trial from the same source, so the same logs can be reported again.
+	// Offset of another source replaces the previous one. It returns false if logs are skipped.
+	// Offset of the Trial is deleted with the Trial logs.
+	RegisterObservationLogWithOffset(namespace string, experimentName string, trialName string, trialUID string, source string, sourceOffset int64,
+		observationLog *v1beta1.ObservationLog) (bool, error)
+	// Logs are filtered by inclusive time and step ranges, empty bounds are not applied.
+	GetObservationLog(namespace string, trialName string, metricName string, startTime string, endTime string,
+		startStep string, endStep string, orderByStep bool) (*v1beta1.ObservationLog, error)
+	GetObservationLogs(namespace string, trialNames []string, metricNames []string, startTime string, endTime string) (map[string]*v1beta1.ObservationLog, error)
+	// Latest value of the summary is the value with the highest step or the latest time if logs don't have step.
+	GetObservationSummary(namespace string, trialName string, metricNames []string) ([]*v1beta1.MetricSummary, error)
+	DeleteObservationLog(namespace string, trialName string) error
+
+	// Observation logs retention.
+	// Owner of logs reported without namespace has the empty namespace and Trial UID.
+	GetObservationLogOwners() ([]ObservationLogOwner, error)
+	DeleteOwnerObservationLog(owner ObservationLogOwner) (int64, error)
+	// Offsets of the owner are kept, since only the oldest logs are deleted.
+	DeleteOwnerObservationLogBefore(owner ObservationLogOwner, before time.Time) (int64, error)
 }
@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"k8s.io/klog"
+)
+
+// Migration represents a single versioned change of the Katib DB schema.
+type Migration struct {
+	// Version of the schema after the migration is applied.
+	// Versions start from 1 and must be unique within the migration set.
+	Version int
+
+	// Description is a short human readable summary of the change.
+	Description string
+
+	// Up contains SQL statements that upgrade the schema to this version.
+	Up []string
+}
+
+// Migrator applies the DB backend migration set and records applied versions in the DB.
+// Each DB backend ships its own Migrator since SQL dialects differ.
+type Migrator struct {
+	// CreateVersionTable creates the table with applied schema versions if it doesn't exist.
+	CreateVersionTable string
+
+	// SelectVersion returns the single row with the current schema version or NULL
+	// if no migrations have been applied yet.
+	SelectVersion string
+
+	// InsertVersion records the applied migration, it takes version and description arguments.
+	InsertVersion string
+
+	// Migrations is the backend migration set.
+	Migrations []Migration
+
+	// Lock acquires the lock which serializes migrations of the concurrently started DB managers.
+	// It must return 1 once the lock is acquired. Migrations are not locked if it is empty.
+	Lock string
+
+	// Unlock releases the lock acquired by Lock on the same connection.
+	Unlock string
+}
+
+// LatestVersion returns the latest schema version known by the Migrator.
+func (m *Migrator) LatestVersion() int {
+	latest := 0
+	for _, migration := range m.Migrations {
+		if migration.Version > latest {
+			latest = migration.Version
+		}
+	}
+	return latest
+}
+
+// CurrentVersion returns the schema version applied to the DB.
+// 0 means that migrations have never been applied.
+func (m *Migrator) CurrentVersion(db *sql.DB) (int, error) {
+	var version sql.NullInt64
+	if err := db.QueryRow(m.SelectVersion).Scan(&version); err != nil {
+		return 0, fmt.Errorf("Failed to get current schema version: %v", err)
+	}
+	if !version.Valid {
+		return 0, nil
+	}
+	return int(version.Int64), nil
+}
+
+// Migrate upgrades the DB schema to the latest version.
+// It returns an error if the DB schema is newer than the latest known version,
+// since running against the unknown schema can corrupt the data.
+func (m *Migrator) Migrate(db *sql.DB) error {
+	migrations := make([]Migration, len(m.Migrations))
+	copy(migrations, m.Migrations)
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			return fmt.Errorf("Migration versions must be sequential starting from 1, got version %v at position %v",
+				migration.Version, i+1)
+		}
+	}
+
+	if m.Lock != "" {
+		unlock, err := m.lock(db)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	if _, err := db.Exec(m.CreateVersionTable); err != nil {
+		return fmt.Errorf("Failed to create schema version table: %v", err)
+	}
+
+	currentVersion, err := m.CurrentVersion(db)
+	if err != nil {
+		return err
+	}
+	latestVersion := m.LatestVersion()
+	if currentVersion > latestVersion {
+		return fmt.Errorf("DB schema version %v is newer than the latest version %v supported by this DB manager",
+			currentVersion, latestVersion)
+	}
+
+	for _, migration := range migrations[currentVersion:] {
+		klog.Infof("Applying DB schema migration %v: %v", migration.Version, migration.Description)
+		if err := applyMigration(db, m.InsertVersion, migration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lock acquires the migration lock and returns the function which releases it.
+// Lock is held by the DB session, so it is acquired and released on the dedicated connection.
+func (m *Migrator) lock(db *sql.DB) (func(), error) {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get connection for schema migration lock: %v", err)
+	}
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, m.Lock).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("Failed to acquire schema migration lock: %v", err)
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		conn.Close()
+		return nil, fmt.Errorf("Failed to acquire schema migration lock: lock is held by another DB manager")
+	}
+	return func() {
+		if _, err := conn.ExecContext(ctx, m.Unlock); err != nil {
+			klog.Errorf("Failed to release schema migration lock: %v", err)
+		}
+		conn.Close()
+	}, nil
+}
+
+func applyMigration(db *sql.DB, insertVersion string, migration Migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("Failed to begin transaction for migration %v: %v", migration.Version, err)
+	}
+	for _, statement := range migration.Up {
+		if _, err := tx.Exec(statement); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("Failed to apply migration %v: %v", migration.Version, err)
+		}
+	}
+	if _, err := tx.Exec(insertVersion, migration.Version, migration.Description); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("Failed to record migration %v: %v", migration.Version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Failed to commit migration %v: %v", migration.Version, err)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func newTestMigrator() *Migrator {
+	return &Migrator{
+		CreateVersionTable: "CREATE TABLE IF NOT EXISTS schema_migrations",
+		SelectVersion:      "SELECT MAX(version) FROM schema_migrations",
+		InsertVersion:      "INSERT INTO schema_migrations (version, description) VALUES (?, ?)",
+		Migrations: []Migration{
+			{
+				Version:     2,
+				Description: "Add index",
+				Up:          []string{"CREATE INDEX idx ON t (a)"},
+			},
+			{
+				Version:     1,
+				Description: "Create table",
+				Up:          []string{"CREATE TABLE t (a INT)"},
+			},
+		},
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		currentVersion interface{}
+		expect         func(mock sqlmock.Sqlmock)
+		err            bool
+	}{
+		{
+			name:           "Fresh DB",
+			currentVersion: nil,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("CREATE TABLE t").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(1, "Create table").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+				mock.ExpectBegin()
+				mock.ExpectExec("CREATE INDEX idx").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(2, "Add index").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:           "Partially migrated DB",
+			currentVersion: 1,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("CREATE INDEX idx").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(2, "Add index").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:           "Up to date DB",
+			currentVersion: 2,
+			expect:         func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:           "Newer DB schema",
+			currentVersion: 3,
+			expect:         func(mock sqlmock.Sqlmock) {},
+			err:            true,
+		},
+		{
+			name:           "Failed migration",
+			currentVersion: 1,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("CREATE INDEX idx").WillReturnError(sqlmock.ErrCancelled)
+				mock.ExpectRollback()
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("Error opening db: %v", err)
+		}
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT MAX\\(version\\) FROM schema_migrations").WillReturnRows(
+			sqlmock.NewRows([]string{"MAX(version)"}).AddRow(tc.currentVersion))
+		tc.expect(mock)
+
+		err = newTestMigrator().Migrate(db)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.name, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected error, got nil", tc.name)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("Case: %v failed. %v", tc.name, err)
+		}
+		db.Close()
+	}
+}
+
+func TestMigrateNonSequentialVersions(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error opening db: %v", err)
+	}
+	defer db.Close()
+
+	m := newTestMigrator()
+	m.Migrations[0].Version = 3
+	if err := m.Migrate(db); err == nil {
+		t.Errorf("Expected error for non sequential migration versions, got nil")
+	}
+}
+
+func TestMigrateLock(t *testing.T) {
+	testCases := []struct {
+		name   string
+		locked interface{}
+		expect func(mock sqlmock.Sqlmock)
+		err    bool
+	}{
+		{
+			name:   "Lock is acquired",
+			locked: 1,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT MAX\\(version\\) FROM schema_migrations").WillReturnRows(
+					sqlmock.NewRows([]string{"MAX(version)"}).AddRow(2))
+				mock.ExpectExec("SELECT RELEASE_LOCK").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name:   "Lock is held by another DB manager",
+			locked: 0,
+			expect: func(mock sqlmock.Sqlmock) {},
+			err:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("Error opening db: %v", err)
+		}
+		mock.ExpectQuery("SELECT GET_LOCK").WillReturnRows(sqlmock.NewRows([]string{"GET_LOCK"}).AddRow(tc.locked))
+		tc.expect(mock)
+
+		m := newTestMigrator()
+		m.Lock = "SELECT GET_LOCK('schema_migrations', 1)"
+		m.Unlock = "SELECT RELEASE_LOCK('schema_migrations')"
+		err = m.Migrate(db)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.name, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected error, got nil", tc.name)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("Case: %v failed. %v", tc.name, err)
+		}
+		db.Close()
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// NumericValueRegexp matches metric values which are aggregated as numbers.
+// It matches only decimal numbers, since MySQL and Postgres can't compare NaN and infinite values
+// the same way, so NaN, Inf and hexadecimal values accepted by strconv.ParseFloat are not numeric.
+// It doesn't contain backslashes, so it can be used in SQL string literals as is.
+const NumericValueRegexp = `^[-+]?([0-9]+[.]?[0-9]*|[.][0-9]+)([eE][-+]?[0-9]+)?$`
+
+var numericValueRegexp = regexp.MustCompile(NumericValueRegexp)
+
+// ParseNumericValue converts the metric value to the number which is aggregated.
+// The value is numeric only if it matches NumericValueRegexp as a whole, the same as in the SQL queries.
+func ParseNumericValue(value string) (float64, bool) {
+	if !numericValueRegexp.MatchString(value) {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
+
+// ParseStep converts the step of the metric log to the nullable SQL value.
+// Empty step means that the log doesn't have step and it is stored as NULL.
+func ParseStep(step string) (sql.NullInt64, error) {
+	if step == "" {
+		return sql.NullInt64{}, nil
+	}
+	s, err := strconv.ParseInt(step, 10, 64)
+	if err != nil {
+		return sql.NullInt64{}, fmt.Errorf("Error parsing step %s: %v", step, err)
+	}
+	return sql.NullInt64{Int64: s, Valid: true}, nil
+}
+
+// FormatNullStep formats the step of the metric log, NULL step is formatted as empty string.
+func FormatNullStep(step sql.NullInt64) string {
+	if !step.Valid {
+		return ""
+	}
+	return strconv.FormatInt(step.Int64, 10)
+}
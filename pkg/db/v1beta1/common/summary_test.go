@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+)
+
+func TestParseNumericValue(t *testing.T) {
+	tcs := []struct {
+		value           string
+		expectedNumber  float64
+		expectedNumeric bool
+	}{
+		{value: "88.950", expectedNumber: 88.95, expectedNumeric: true},
+		{value: "1e-3", expectedNumber: 0.001, expectedNumeric: true},
+		{value: "-.5", expectedNumber: -0.5, expectedNumeric: true},
+		{value: "+2.", expectedNumber: 2, expectedNumeric: true},
+		{value: "1-2"},
+		{value: "e"},
+		{value: "1e"},
+		{value: ""},
+		{value: "unavailable"},
+		// Values which are accepted by strconv.ParseFloat, but are not aggregated as numbers
+		{value: "NaN"},
+		{value: "Inf"},
+		{value: "-Inf"},
+		{value: "infinity"},
+		{value: "0x1p-2"},
+		{value: "0x_1p0"},
+	}
+
+	for _, tc := range tcs {
+		number, numeric := ParseNumericValue(tc.value)
+		if numeric != tc.expectedNumeric || number != tc.expectedNumber {
+			t.Errorf("Case: %v failed. Expected %v, %v, got %v, %v", tc.value, tc.expectedNumber, tc.expectedNumeric, number, numeric)
+		}
+	}
+}
@@ -21,12 +21,19 @@ import (
 
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/mysql"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/postgres"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/sqlite"
 )
 
 func NewKatibDBInterface(dbName string) (common.KatibDBInterface, error) {
 
-	if dbName == common.MySqlDBNameEnvValue {
+	switch dbName {
+	case common.MySqlDBNameEnvValue:
 		return mysql.NewDBInterface()
+	case common.PostgresDBNameEnvValue:
+		return postgres.NewDBInterface()
+	case common.SqliteDBNameEnvValue:
+		return sqlite.NewDBInterface()
 	}
 	return nil, errors.New("Invalid DB Name")
 }
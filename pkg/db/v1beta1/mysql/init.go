@@ -26,14 +26,8 @@ func (d *dbConn) DBInit() {
 	db := d.db
 	klog.Info("Initializing v1beta1 DB schema")
 
-	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS observation_logs
-		(trial_name VARCHAR(255) NOT NULL,
-		id INT AUTO_INCREMENT PRIMARY KEY,
-		time DATETIME(6),
-		metric_name VARCHAR(255) NOT NULL,
-		value TEXT NOT NULL)`)
-	if err != nil {
-		klog.Fatalf("Error creating observation_logs table: %v", err)
+	if err := migrator.Migrate(db); err != nil {
+		klog.Fatalf("Error migrating DB schema: %v", err)
 	}
 }
 
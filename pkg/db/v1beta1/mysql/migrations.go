@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+const (
+	// migrationLockTimeoutSeconds is the time to wait for migrations of another DB manager.
+	migrationLockTimeoutSeconds = 300
+)
+
+// migrator contains the versioned mysql schema of the Katib DB.
+// Append new migrations to the end of the list, never modify already released ones.
+var migrator = &common.Migrator{
+	CreateVersionTable: `CREATE TABLE IF NOT EXISTS schema_migrations
+		(version INT NOT NULL PRIMARY KEY,
+		description VARCHAR(255) NOT NULL,
+		applied_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6))`,
+	SelectVersion: `SELECT MAX(version) FROM schema_migrations`,
+	InsertVersion: `INSERT INTO schema_migrations (version, description) VALUES (?, ?)`,
+	Lock:          fmt.Sprintf(`SELECT GET_LOCK('katib_schema_migrations', %d)`, migrationLockTimeoutSeconds),
+	Unlock:        `SELECT RELEASE_LOCK('katib_schema_migrations')`,
+	Migrations: []common.Migration{
+		{
+			Version:     1,
+			Description: "Create observation_logs table",
+			// IF NOT EXISTS keeps DBs created before schema versioning was introduced.
+			Up: []string{
+				`CREATE TABLE IF NOT EXISTS observation_logs
+				(trial_name VARCHAR(255) NOT NULL,
+				id INT AUTO_INCREMENT PRIMARY KEY,
+				time DATETIME(6),
+				metric_name VARCHAR(255) NOT NULL,
+				value TEXT NOT NULL)`,
+			},
+		},
+		{
+			Version:     2,
+			Description: "Add observation_logs index on trial_name and metric_name",
+			Up:          createIndexIfNotExists("observation_logs", "observation_logs_trial_name_metric_name", "trial_name, metric_name"),
+		},
+		{
+			Version:     3,
+			Description: "Add namespace, experiment_name and trial_uid to observation_logs",
+			// Existing logs get empty values, they are selected by every namespace only if
+			// KATIB_DB_MATCH_LEGACY_NAMESPACE is true, since their namespace is unknown.
+			Up: concat(
+				addColumnIfNotExists("observation_logs", "namespace", "VARCHAR(255) NOT NULL DEFAULT ''"),
+				addColumnIfNotExists("observation_logs", "experiment_name", "VARCHAR(255) NOT NULL DEFAULT ''"),
+				addColumnIfNotExists("observation_logs", "trial_uid", "VARCHAR(255) NOT NULL DEFAULT ''"),
+			),
+		},
+		{
+			Version:     4,
+			Description: "Add observation_logs index on time",
+			// Retention deletes logs by time.
+			Up: createIndexIfNotExists("observation_logs", "observation_logs_time", "time"),
+		},
+		{
+			Version:     5,
+			Description: "Add step to observation_logs",
+			// Existing logs don't have step.
+			Up: addColumnIfNotExists("observation_logs", "step", "BIGINT"),
+		},
+		{
+			Version:     6,
+			Description: "Create observation_log_offsets table",
+			// Offset of the last report of the metrics source makes reports of the Trial idempotent.
+			Up: []string{
+				`CREATE TABLE IF NOT EXISTS observation_log_offsets
+				(namespace VARCHAR(255) NOT NULL,
+				trial_name VARCHAR(255) NOT NULL,
+				trial_uid VARCHAR(255) NOT NULL,
+				source VARCHAR(255) NOT NULL,
+				source_offset BIGINT NOT NULL,
+				PRIMARY KEY (namespace, trial_name, trial_uid))`,
+			},
+		},
+	},
+}
+
+// MySQL DDL statements are not transactional and don't support IF NOT EXISTS for indexes and columns.
+// Statements below check information_schema before the change, so partially applied migration can be applied again.
+
+// createIndexIfNotExists returns statements which create the index if it doesn't exist.
+func createIndexIfNotExists(table, index, columns string) []string {
+	return execIfNotExists(
+		fmt.Sprintf(`SELECT COUNT(*) FROM information_schema.statistics
+		WHERE table_schema = DATABASE() AND table_name = '%s' AND index_name = '%s'`, table, index),
+		fmt.Sprintf(`CREATE INDEX %s ON %s (%s)`, index, table, columns))
+}
+
+// addColumnIfNotExists returns statements which add the column to the table if it doesn't exist.
+func addColumnIfNotExists(table, column, definition string) []string {
+	return execIfNotExists(
+		fmt.Sprintf(`SELECT COUNT(*) FROM information_schema.columns
+		WHERE table_schema = DATABASE() AND table_name = '%s' AND column_name = '%s'`, table, column),
+		fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s %s`, table, column, definition))
+}
+
+// execIfNotExists returns statements which execute the statement if the count query returns 0.
+func execIfNotExists(countQuery, statement string) []string {
+	return []string{
+		fmt.Sprintf(`SET @statement = IF((%s) = 0, '%s', 'DO 0')`, countQuery, strings.ReplaceAll(statement, "'", "''")),
+		`PREPARE statement FROM @statement`,
+		`EXECUTE statement`,
+		`DEALLOCATE PREPARE statement`,
+	}
+}
+
+func concat(statements ...[]string) []string {
+	var result []string
+	for _, s := range statements {
+		result = append(result, s...)
+	}
+	return result
+}
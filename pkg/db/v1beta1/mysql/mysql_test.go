@@ -19,7 +19,9 @@ package mysql
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	_ "github.com/go-sql-driver/mysql"
@@ -42,7 +44,38 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		fmt.Printf("error NewWithSQLConn: %v\n", err)
 	}
+	mock.ExpectQuery("SELECT GET_LOCK\\('katib_schema_migrations'").WillReturnRows(
+		sqlmock.NewRows([]string{"GET_LOCK"}).AddRow(1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT MAX\\(version\\) FROM schema_migrations").WillReturnRows(
+		sqlmock.NewRows([]string{"MAX(version)"}).AddRow(nil))
+	mock.ExpectBegin()
 	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_logs").WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(1, "Create observation_logs table").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	expectExecIfNotExists("CREATE INDEX observation_logs_trial_name_metric_name")
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	expectExecIfNotExists("ALTER TABLE observation_logs ADD COLUMN namespace")
+	expectExecIfNotExists("ALTER TABLE observation_logs ADD COLUMN experiment_name")
+	expectExecIfNotExists("ALTER TABLE observation_logs ADD COLUMN trial_uid")
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(3, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	expectExecIfNotExists("CREATE INDEX observation_logs_time")
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(4, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	expectExecIfNotExists("ALTER TABLE observation_logs ADD COLUMN step")
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(5, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_log_offsets").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(6, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("SELECT RELEASE_LOCK\\('katib_schema_migrations'\\)").WillReturnResult(sqlmock.NewResult(0, 0))
 	dbInterface.DBInit()
 	err = dbInterface.SelectOne()
 	if err != nil {
@@ -51,6 +84,15 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
+// expectExecIfNotExists expects statements which execute the DDL statement only if the object doesn't exist.
+func expectExecIfNotExists(statement string) {
+	mock.ExpectExec("SET @statement = IF\\(\\(SELECT COUNT\\(\\*\\) FROM information_schema.* = 0, '" + statement).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("PREPARE statement FROM @statement").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("EXECUTE statement").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DEALLOCATE PREPARE statement").WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
 func TestRegisterObservationLog(t *testing.T) {
 	obsLog := &api_pb.ObservationLog{
 		MetricLogs: []*api_pb.MetricLog{
@@ -60,6 +102,7 @@ func TestRegisterObservationLog(t *testing.T) {
 					Name:  "f1_score",
 					Value: "88.95",
 				},
+				Step: "10",
 			},
 			{
 				TimeStamp: "2016-12-31T20:02:05.123456Z",
@@ -74,60 +117,308 @@ func TestRegisterObservationLog(t *testing.T) {
 	mock.ExpectExec(
 		"INSERT",
 	).WithArgs(
+		"test-namespace",
+		"test1",
 		"test1_trial1",
+		"test1_trial1_uid",
 		"2016-12-31 20:02:05.123456",
 		"f1_score",
 		"88.95",
+		10,
+		"test-namespace",
+		"test1",
 		"test1_trial1",
+		"test1_trial1_uid",
 		"2016-12-31 20:02:05.123456",
 		"loss",
 		"0.5",
+		nil,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := dbInterface.RegisterObservationLog("test1_trial1", obsLog)
+	err := dbInterface.RegisterObservationLog("test-namespace", "test1", "test1_trial1", "test1_trial1_uid", obsLog)
 	if err != nil {
 		t.Errorf("RegisterExperiment failed: %v", err)
 	}
 
 }
 
+func TestRegisterObservationLogWithOffset(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+				Step: "1",
+			},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO observation_log_offsets").WithArgs(
+		"test-namespace", "test1_trial1", "test1_trial1_uid", "test1-trial1-pod", 100,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO observation_logs").WithArgs(
+		"test-namespace", "test1", "test1_trial1", "test1_trial1_uid", "2016-12-31 20:02:05.123456", "loss", "0.5", 1,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	registered, err := dbInterface.RegisterObservationLogWithOffset("test-namespace", "test1", "test1_trial1", "test1_trial1_uid", "test1-trial1-pod", 100, obsLog)
+	if err != nil {
+		t.Errorf("RegisterObservationLogWithOffset failed: %v", err)
+	} else if !registered {
+		t.Errorf("RegisterObservationLogWithOffset skipped logs with the new offset")
+	}
+
+	// Logs which are reported again with the same offset are skipped.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO observation_log_offsets").WithArgs(
+		"test-namespace", "test1_trial1", "test1_trial1_uid", "test1-trial1-pod", 100,
+	).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	registered, err = dbInterface.RegisterObservationLogWithOffset("test-namespace", "test1", "test1_trial1", "test1_trial1_uid", "test1-trial1-pod", 100, obsLog)
+	if err != nil {
+		t.Errorf("RegisterObservationLogWithOffset failed: %v", err)
+	} else if registered {
+		t.Errorf("RegisterObservationLogWithOffset registered logs with the old offset")
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
 func TestGetObservationLog(t *testing.T) {
-	mock.ExpectQuery("SELECT").WillReturnRows(
-		sqlmock.NewRows([]string{"time", "metric_name", "value"}).AddRow(
+	mock.ExpectQuery(
+		`SELECT time, metric_name, value, step FROM observation_logs WHERE trial_name = \? AND namespace = \? `+
+			`AND metric_name = \? AND time >= \? AND time <= \? AND step >= \? AND step <= \? ORDER BY step IS NULL, step, time`,
+	).WithArgs(
+		"test1_trial1",
+		"test-namespace",
+		"loss",
+		"2016-12-31 21:01:05.123456",
+		"2016-12-31 22:10:20.123456",
+		1,
+		5,
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"time", "metric_name", "value", "step"}).AddRow(
 			"2016-12-31 21:02:05.123456",
 			"loss",
 			"0.9",
+			1,
 		).AddRow(
 			"2016-12-31 22:02:05.123456",
 			"loss",
 			"0.9",
+			5,
 		),
 	)
 	obsLog, err := dbInterface.GetObservationLog(
+		"test-namespace",
 		"test1_trial1",
 		"loss",
 		"2016-12-31T21:01:05.123456Z",
 		"2016-12-31T22:10:20.123456Z",
+		"1",
+		"5",
+		true,
 	)
 	if err != nil {
 		t.Errorf("GetObservationLog failed %v", err)
-	} else if len(obsLog.MetricLogs) != 2 {
+	} else if len(obsLog.MetricLogs) != 2 || obsLog.MetricLogs[1].Step != "5" {
 		t.Errorf("GetObservationLog incorrect return %v", obsLog)
 	}
 
+	_, err = dbInterface.GetObservationLog("test-namespace", "test1_trial1", "loss", "", "", "first", "", false)
+	if err == nil {
+		t.Errorf("GetObservationLog must fail for invalid step")
+	}
+
+}
+
+func TestGetObservationLogs(t *testing.T) {
+	mock.ExpectQuery(
+		`SELECT trial_name, time, metric_name, value, step FROM observation_logs WHERE trial_name IN \(\?, \?\) AND namespace = \? `+
+			`AND metric_name IN \(\?, \?\) AND time >= \? AND time <= \? ORDER BY time`,
+	).WithArgs(
+		"test1_trial1",
+		"test1_trial2",
+		"test-namespace",
+		"loss",
+		"accuracy",
+		"2016-12-31 21:01:05.123456",
+		"2016-12-31 22:10:20.123456",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"trial_name", "time", "metric_name", "value", "step"}).AddRow(
+			"test1_trial1",
+			"2016-12-31 21:02:05.123456",
+			"loss",
+			"0.9",
+			nil,
+		).AddRow(
+			"test1_trial1",
+			"2016-12-31 22:02:05.123456",
+			"accuracy",
+			"0.7",
+			nil,
+		),
+	)
+	obsLogs, err := dbInterface.GetObservationLogs(
+		"test-namespace",
+		[]string{"test1_trial1", "test1_trial2"},
+		[]string{"loss", "accuracy"},
+		"2016-12-31T21:01:05.123456Z",
+		"2016-12-31T22:10:20.123456Z",
+	)
+	if err != nil {
+		t.Errorf("GetObservationLogs failed %v", err)
+	} else if len(obsLogs) != 2 || len(obsLogs["test1_trial1"].MetricLogs) != 2 || len(obsLogs["test1_trial2"].MetricLogs) != 0 {
+		t.Errorf("GetObservationLogs incorrect return %v", obsLogs)
+	}
+}
+
+func TestGetObservationSummary(t *testing.T) {
+	mock.ExpectQuery(
+		`WITH logs AS \(SELECT metric_name, value, step, time, id, `,
+	).WithArgs(
+		"test1_trial1",
+		"test-namespace",
+		"loss",
+		"accuracy",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"metric_name", "min_value", "max_value", "value", "step", "total", "first_time", "last_time"}).AddRow(
+			"accuracy",
+			nil,
+			nil,
+			"unavailable",
+			nil,
+			1,
+			"2016-12-31 21:02:05.123456",
+			"2016-12-31 21:02:05.123456",
+		).AddRow(
+			"loss",
+			"1.5e-1",
+			"0.50",
+			"unavailable",
+			3,
+			4,
+			"2016-12-31 21:02:05.123456",
+			"2016-12-31 22:02:05.123456",
+		).AddRow(
+			"loss",
+			"1.5e-1",
+			"0.50",
+			"0.3",
+			3,
+			4,
+			"2016-12-31 21:02:05.123456",
+			"2016-12-31 22:02:05.123456",
+		),
+	)
+	summaries, err := dbInterface.GetObservationSummary("test-namespace", "test1_trial1", []string{"loss", "accuracy"})
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed %v", err)
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("GetObservationSummary incorrect return %v", summaries)
+	}
+	if summaries[0].Min != "" || summaries[0].Max != "" || summaries[0].Latest != "unavailable" {
+		t.Errorf("GetObservationSummary incorrect summary of non-numeric metric %v", summaries[0])
+	}
+	if summaries[1].Min != "1.5e-1" || summaries[1].Max != "0.50" || summaries[1].Latest != "0.3" || summaries[1].LatestStep != "3" || summaries[1].Count != 4 ||
+		summaries[1].FirstTimeStamp != "2016-12-31T21:02:05.123456Z" || summaries[1].LastTimeStamp != "2016-12-31T22:02:05.123456Z" {
+		t.Errorf("GetObservationSummary incorrect summary %v", summaries[1])
+	}
 }
 
 func TestDeleteObservationLog(t *testing.T) {
 	trialName := "test1_trial1"
 
 	mock.ExpectExec(
-		"DELETE FROM observation_logs",
-	).WithArgs(trialName).WillReturnResult(sqlmock.NewResult(1, 1))
+		`DELETE FROM observation_logs WHERE trial_name = \? AND namespace = \?`,
+	).WithArgs(trialName, "test-namespace").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(
+		`DELETE FROM observation_log_offsets WHERE trial_name = \? AND namespace = \?`,
+	).WithArgs(trialName, "test-namespace").WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := dbInterface.DeleteObservationLog(trialName)
+	err := dbInterface.DeleteObservationLog("test-namespace", trialName)
 	if err != nil {
 		t.Errorf("DeleteObservationLog failed: %v", err)
 	}
+
+	// Empty namespace deletes only logs of the Trial without namespace.
+	mock.ExpectExec(
+		`DELETE FROM observation_logs WHERE trial_name = \? AND namespace = \?`,
+	).WithArgs(trialName, "").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(
+		`DELETE FROM observation_log_offsets WHERE trial_name = \? AND namespace = \?`,
+	).WithArgs(trialName, "").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = dbInterface.DeleteObservationLog("", trialName)
+	if err != nil {
+		t.Errorf("DeleteObservationLog failed: %v", err)
+	}
+}
+
+func TestGetObservationLogOwners(t *testing.T) {
+	mock.ExpectQuery(
+		`SELECT DISTINCT namespace, trial_name, trial_uid FROM observation_logs`,
+	).WillReturnRows(sqlmock.NewRows([]string{"namespace", "trial_name", "trial_uid"}).
+		AddRow("test-namespace", "test1_trial1", "test1-trial1-uid").
+		AddRow("test-namespace", "test1_trial2", "test1-trial2-uid").
+		AddRow("", "test1_trial3", ""))
+
+	owners, err := dbInterface.GetObservationLogOwners()
+	if err != nil {
+		t.Fatalf("GetObservationLogOwners failed: %v", err)
+	}
+	expected := []common.ObservationLogOwner{
+		{Namespace: "test-namespace", TrialName: "test1_trial1", TrialUID: "test1-trial1-uid"},
+		{Namespace: "test-namespace", TrialName: "test1_trial2", TrialUID: "test1-trial2-uid"},
+		{TrialName: "test1_trial3"},
+	}
+	if !reflect.DeepEqual(owners, expected) {
+		t.Errorf("GetObservationLogOwners returns wrong owners: %v", owners)
+	}
+}
+
+func TestDeleteOwnerObservationLog(t *testing.T) {
+	mock.ExpectExec(
+		`DELETE FROM observation_logs WHERE namespace = \? AND trial_name = \? AND trial_uid = \?`,
+	).WithArgs("test-namespace", "test1_trial1", "test1-trial1-uid").WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(
+		`DELETE FROM observation_log_offsets WHERE namespace = \? AND trial_name = \? AND trial_uid = \?`,
+	).WithArgs("test-namespace", "test1_trial1", "test1-trial1-uid").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	deleted, err := dbInterface.DeleteOwnerObservationLog(common.ObservationLogOwner{
+		Namespace: "test-namespace",
+		TrialName: "test1_trial1",
+		TrialUID:  "test1-trial1-uid",
+	})
+	if err != nil {
+		t.Errorf("DeleteOwnerObservationLog failed: %v", err)
+	} else if deleted != 3 {
+		t.Errorf("DeleteOwnerObservationLog returns %d deleted logs, expected 3", deleted)
+	}
+}
+
+func TestDeleteOwnerObservationLogBefore(t *testing.T) {
+	mock.ExpectExec(
+		`DELETE FROM observation_logs WHERE namespace = \? AND trial_name = \? AND trial_uid = \? AND time < \?`,
+	).WithArgs("test-namespace", "test1_trial1", "test1-trial1-uid", "2016-12-31 20:02:05.123456").WillReturnResult(sqlmock.NewResult(0, 5))
+
+	deleted, err := dbInterface.DeleteOwnerObservationLogBefore(common.ObservationLogOwner{
+		Namespace: "test-namespace",
+		TrialName: "test1_trial1",
+		TrialUID:  "test1-trial1-uid",
+	}, time.Date(2016, 12, 31, 20, 2, 5, 123456000, time.UTC))
+	if err != nil {
+		t.Errorf("DeleteOwnerObservationLogBefore failed: %v", err)
+	} else if deleted != 5 {
+		t.Errorf("DeleteOwnerObservationLogBefore returns %d deleted logs, expected 5", deleted)
+	}
 }
 
 func TestGetDbName(t *testing.T) {
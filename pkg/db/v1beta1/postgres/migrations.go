@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+const (
+	// migrationLockKey is the key of the session advisory lock which serializes migrations.
+	migrationLockKey = 20220101
+)
+
+// migrator contains the versioned postgres schema of the Katib DB.
+// Append new migrations to the end of the list, never modify already released ones.
+var migrator = &common.Migrator{
+	CreateVersionTable: `CREATE TABLE IF NOT EXISTS schema_migrations
+		(version INT NOT NULL PRIMARY KEY,
+		description VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP)`,
+	SelectVersion: `SELECT MAX(version) FROM schema_migrations`,
+	InsertVersion: `INSERT INTO schema_migrations (version, description) VALUES ($1, $2)`,
+	// pg_advisory_lock waits for migrations of another DB manager and returns void,
+	// so 1 is selected once the lock is acquired.
+	Lock:   fmt.Sprintf(`SELECT 1 FROM pg_advisory_lock(%d)`, migrationLockKey),
+	Unlock: fmt.Sprintf(`SELECT pg_advisory_unlock(%d)`, migrationLockKey),
+	Migrations: []common.Migration{
+		{
+			Version:     1,
+			Description: "Create observation_logs table",
+			// IF NOT EXISTS keeps DBs created before schema versioning was introduced.
+			Up: []string{
+				`CREATE TABLE IF NOT EXISTS observation_logs
+				(trial_name VARCHAR(255) NOT NULL,
+				id SERIAL PRIMARY KEY,
+				time TIMESTAMP(6),
+				metric_name VARCHAR(255) NOT NULL,
+				value TEXT NOT NULL)`,
+			},
+		},
+		{
+			Version:     2,
+			Description: "Add observation_logs index on trial_name and metric_name",
+			Up: []string{
+				`CREATE INDEX observation_logs_trial_name_metric_name
+				ON observation_logs (trial_name, metric_name)`,
+			},
+		},
+		{
+			Version:     3,
+			Description: "Add namespace, experiment_name and trial_uid to observation_logs",
+			// Existing logs get empty values, they are selected by every namespace only if
+			// KATIB_DB_MATCH_LEGACY_NAMESPACE is true, since their namespace is unknown.
+			Up: []string{
+				`ALTER TABLE observation_logs ADD COLUMN namespace VARCHAR(255) NOT NULL DEFAULT ''`,
+				`ALTER TABLE observation_logs ADD COLUMN experiment_name VARCHAR(255) NOT NULL DEFAULT ''`,
+				`ALTER TABLE observation_logs ADD COLUMN trial_uid VARCHAR(255) NOT NULL DEFAULT ''`,
+			},
+		},
+		{
+			Version:     4,
+			Description: "Add observation_logs index on time",
+			// Retention deletes logs by time.
+			Up: []string{
+				`CREATE INDEX observation_logs_time
+				ON observation_logs (time)`,
+			},
+		},
+		{
+			Version:     5,
+			Description: "Add step to observation_logs",
+			// Existing logs don't have step.
+			Up: []string{
+				`ALTER TABLE observation_logs ADD COLUMN step BIGINT`,
+			},
+		},
+		{
+			Version:     6,
+			Description: "Create observation_log_offsets table",
+			// Offset of the last report of the metrics source makes reports of the Trial idempotent.
+			Up: []string{
+				`CREATE TABLE observation_log_offsets
+				(namespace VARCHAR(255) NOT NULL,
+				trial_name VARCHAR(255) NOT NULL,
+				trial_uid VARCHAR(255) NOT NULL,
+				source VARCHAR(255) NOT NULL,
+				source_offset BIGINT NOT NULL,
+				PRIMARY KEY (namespace, trial_name, trial_uid))`,
+			},
+		},
+	},
+}
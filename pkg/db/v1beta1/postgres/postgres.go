@@ -0,0 +1,457 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/env"
+)
+
+const (
+	dbDriver        = "postgres"
+	dbNameTmpl      = "postgresql://%s:%s@%s:%s/%s?sslmode=%s"
+	postgresTimeFmt = "2006-01-02 15:04:05.999999"
+
+	// numericValue converts the value of a log to a number, non-numeric values are NULL and skipped by aggregates.
+	numericValue = "CASE WHEN value ~ '" + common.NumericValueRegexp + "' THEN CAST(value AS DOUBLE PRECISION) END"
+)
+
+type dbConn struct {
+	db *sql.DB
+	// matchLegacyNamespace selects logs without namespace by every namespace.
+	matchLegacyNamespace bool
+}
+
+func getDbName() string {
+	dbPass := os.Getenv(common.DBPasswordEnvName)
+	dbUser := env.GetEnvOrDefault(
+		common.DBUserEnvName, common.DefaultPostgreSQLUser)
+	dbHost := env.GetEnvOrDefault(
+		common.PostgreSQLDBHostEnvName, common.DefaultPostgreSQLHost)
+	dbPort := env.GetEnvOrDefault(
+		common.PostgreSQLDBPortEnvName, common.DefaultPostgreSQLPort)
+	dbName := env.GetEnvOrDefault(common.PostgreSQLDatabase,
+		common.DefaultPostgreSQLDatabase)
+	sslMode := env.GetEnvOrDefault(common.PostgreSQLSSLMode,
+		common.DefaultPostgreSQLSSLMode)
+
+	return fmt.Sprintf(dbNameTmpl, dbUser, dbPass, dbHost, dbPort, dbName, sslMode)
+}
+
+func NewWithSQLConn(db *sql.DB) (common.KatibDBInterface, error) {
+	d := new(dbConn)
+	d.db = db
+	d.matchLegacyNamespace = common.MatchLegacyNamespace()
+	return d, nil
+}
+
+func NewDBInterface() (common.KatibDBInterface, error) {
+	db, err := common.OpenSQLConn(dbDriver, getDbName(), common.ConnectInterval, common.ConnectTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("DB open failed: %v", err)
+	}
+	return NewWithSQLConn(db)
+}
+
+func (d *dbConn) RegisterObservationLog(namespace string, experimentName string, trialName string, trialUID string, observationLog *v1beta1.ObservationLog) error {
+	sqlQuery, values, err := insertObservationLogQuery(namespace, experimentName, trialName, trialUID, observationLog)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the statement
+	stmt, err := d.db.Prepare(sqlQuery)
+	if err != nil {
+		return fmt.Errorf("Prepare SQL statement failed: %v", err)
+	}
+
+	// Close the statement
+	defer stmt.Close()
+
+	// Execute INSERT
+	_, err = stmt.Exec(values...)
+	if err != nil {
+		return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+	}
+
+	return nil
+}
+
+func (d *dbConn) RegisterObservationLogWithOffset(namespace string, experimentName string, trialName string, trialUID string, source string, sourceOffset int64,
+	observationLog *v1beta1.ObservationLog) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("Begin transaction failed: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Offset is updated only if it's greater than the current one or the source is changed,
+	// the row is locked until the transaction is completed.
+	result, err := tx.Exec(`INSERT INTO observation_log_offsets (namespace, trial_name, trial_uid, source, source_offset) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (namespace, trial_name, trial_uid) DO UPDATE SET source = excluded.source, source_offset = excluded.source_offset
+		WHERE observation_log_offsets.source != excluded.source OR observation_log_offsets.source_offset < excluded.source_offset`,
+		namespace, trialName, trialUID, source, sourceOffset)
+	if err != nil {
+		return false, fmt.Errorf("Execute SQL INSERT failed: %v", err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if updated == 0 {
+		return false, nil
+	}
+
+	sqlQuery, values, err := insertObservationLogQuery(namespace, experimentName, trialName, trialUID, observationLog)
+	if err != nil {
+		return false, err
+	}
+	if len(values) != 0 {
+		if _, err = tx.Exec(sqlQuery, values...); err != nil {
+			return false, fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("Commit transaction failed: %v", err)
+	}
+	return true, nil
+}
+
+// insertObservationLogQuery returns the query and the values to insert the logs, logs without time stamp are skipped.
+func insertObservationLogQuery(namespace string, experimentName string, trialName string, trialUID string,
+	observationLog *v1beta1.ObservationLog) (string, []interface{}, error) {
+	sqlQuery := "INSERT INTO observation_logs (namespace, experiment_name, trial_name, trial_uid, time, metric_name, value, step) VALUES "
+	values := []interface{}{}
+	placeholders := []string{}
+
+	for _, mlog := range observationLog.MetricLogs {
+		if mlog.TimeStamp == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, mlog.TimeStamp)
+		if err != nil {
+			return "", nil, fmt.Errorf("Error parsing start time %s: %v", mlog.TimeStamp, err)
+		}
+		sqlTimeStr := t.UTC().Format(postgresTimeFmt)
+		step, err := common.ParseStep(mlog.Step)
+		if err != nil {
+			return "", nil, err
+		}
+
+		// PostgreSQL uses positional parameters: ($1, $2, ..., $8), ($9, $10, ..., $16), ...
+		idx := len(values)
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			idx+1, idx+2, idx+3, idx+4, idx+5, idx+6, idx+7, idx+8))
+		values = append(values, namespace, experimentName, trialName, trialUID, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value, step)
+	}
+	sqlQuery += strings.Join(placeholders, ",")
+	return sqlQuery, values, nil
+}
+
+// namespaceCondition selects logs of the namespace with the placeholder.
+// Logs without namespace are selected too if they are matched by every namespace.
+func (d *dbConn) namespaceCondition(placeholder string) string {
+	if d.matchLegacyNamespace {
+		return " AND namespace IN (" + placeholder + ", '')"
+	}
+	return " AND namespace = " + placeholder
+}
+
+func (d *dbConn) DeleteObservationLog(namespace string, trialName string) error {
+	// Namespace is always matched, so the empty namespace deletes only logs without namespace.
+	if _, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = $1 AND namespace = $2", trialName, namespace); err != nil {
+		return err
+	}
+	_, err := d.db.Exec("DELETE FROM observation_log_offsets WHERE trial_name = $1 AND namespace = $2", trialName, namespace)
+	return err
+}
+
+func (d *dbConn) GetObservationLog(namespace string, trialName string, metricName string, startTime string, endTime string,
+	startStep string, endStep string, orderByStep bool) (*v1beta1.ObservationLog, error) {
+	qfield := []interface{}{trialName}
+	qstr := ""
+	if namespace != "" {
+		qfield = append(qfield, namespace)
+		qstr += d.namespaceCondition(fmt.Sprintf("$%d", len(qfield)))
+	}
+	if metricName != "" {
+		qfield = append(qfield, metricName)
+		qstr += fmt.Sprintf(" AND metric_name = $%d", len(qfield))
+	}
+	if startTime != "" {
+		s_time, err := time.Parse(time.RFC3339Nano, startTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+		}
+		formattedStartTime := s_time.UTC().Format(postgresTimeFmt)
+		qfield = append(qfield, formattedStartTime)
+		qstr += fmt.Sprintf(" AND time >= $%d", len(qfield))
+	}
+	if endTime != "" {
+		e_time, err := time.Parse(time.RFC3339Nano, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+		}
+		formattedEndTime := e_time.UTC().Format(postgresTimeFmt)
+		qfield = append(qfield, formattedEndTime)
+		qstr += fmt.Sprintf(" AND time <= $%d", len(qfield))
+	}
+	for _, bound := range []struct {
+		step string
+		op   string
+	}{{startStep, ">="}, {endStep, "<="}} {
+		if bound.step == "" {
+			continue
+		}
+		step, err := common.ParseStep(bound.step)
+		if err != nil {
+			return nil, err
+		}
+		qfield = append(qfield, step)
+		qstr += fmt.Sprintf(" AND step %s $%d", bound.op, len(qfield))
+	}
+	orderBy := " ORDER BY time"
+	if orderByStep {
+		// Logs without step are the last.
+		orderBy = " ORDER BY step IS NULL, step, time"
+	}
+	rows, err := d.db.Query("SELECT time, metric_name, value, step FROM observation_logs WHERE trial_name = $1"+qstr+orderBy,
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
+	}
+	// Close the rows
+	defer rows.Close()
+	result := &v1beta1.ObservationLog{
+		MetricLogs: []*v1beta1.MetricLog{},
+	}
+	for rows.Next() {
+		var mname, mvalue string
+		var sqlTime time.Time
+		var step sql.NullInt64
+		err := rows.Scan(&sqlTime, &mname, &mvalue, &step)
+		if err != nil {
+			klog.Errorf("Error scanning log: %v", err)
+			continue
+		}
+		timeStamp := sqlTime.UTC().Format(time.RFC3339Nano)
+		result.MetricLogs = append(result.MetricLogs, &v1beta1.MetricLog{
+			TimeStamp: timeStamp,
+			Metric: &v1beta1.Metric{
+				Name:  mname,
+				Value: mvalue,
+			},
+			Step: common.FormatNullStep(step),
+		})
+	}
+	return result, nil
+}
+
+func (d *dbConn) GetObservationLogs(namespace string, trialNames []string, metricNames []string, startTime string, endTime string) (map[string]*v1beta1.ObservationLog, error) {
+	result := make(map[string]*v1beta1.ObservationLog, len(trialNames))
+	if len(trialNames) == 0 {
+		return result, nil
+	}
+	qfield := []interface{}{}
+	placeholders := func(values []string) string {
+		p := make([]string, 0, len(values))
+		for _, v := range values {
+			qfield = append(qfield, v)
+			p = append(p, fmt.Sprintf("$%d", len(qfield)))
+		}
+		return strings.Join(p, ", ")
+	}
+	for _, trialName := range trialNames {
+		result[trialName] = &v1beta1.ObservationLog{
+			MetricLogs: []*v1beta1.MetricLog{},
+		}
+	}
+	qstr := " WHERE trial_name IN (" + placeholders(trialNames) + ")"
+	if namespace != "" {
+		qstr += d.namespaceCondition(placeholders([]string{namespace}))
+	}
+	if len(metricNames) != 0 {
+		qstr += " AND metric_name IN (" + placeholders(metricNames) + ")"
+	}
+	if startTime != "" {
+		s_time, err := time.Parse(time.RFC3339Nano, startTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+		}
+		formattedStartTime := s_time.UTC().Format(postgresTimeFmt)
+		qfield = append(qfield, formattedStartTime)
+		qstr += fmt.Sprintf(" AND time >= $%d", len(qfield))
+	}
+	if endTime != "" {
+		e_time, err := time.Parse(time.RFC3339Nano, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+		}
+		formattedEndTime := e_time.UTC().Format(postgresTimeFmt)
+		qfield = append(qfield, formattedEndTime)
+		qstr += fmt.Sprintf(" AND time <= $%d", len(qfield))
+	}
+	rows, err := d.db.Query("SELECT trial_name, time, metric_name, value, step FROM observation_logs"+qstr+" ORDER BY time",
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
+	}
+	// Close the rows
+	defer rows.Close()
+	for rows.Next() {
+		var tname, mname, mvalue string
+		var sqlTime time.Time
+		var step sql.NullInt64
+		err := rows.Scan(&tname, &sqlTime, &mname, &mvalue, &step)
+		if err != nil {
+			klog.Errorf("Error scanning log: %v", err)
+			continue
+		}
+		log, ok := result[tname]
+		if !ok {
+			continue
+		}
+		timeStamp := sqlTime.UTC().Format(time.RFC3339Nano)
+		log.MetricLogs = append(log.MetricLogs, &v1beta1.MetricLog{
+			TimeStamp: timeStamp,
+			Metric: &v1beta1.Metric{
+				Name:  mname,
+				Value: mvalue,
+			},
+			Step: common.FormatNullStep(step),
+		})
+	}
+	return result, nil
+}
+
+func (d *dbConn) GetObservationSummary(namespace string, trialName string, metricNames []string) ([]*v1beta1.MetricSummary, error) {
+	qfield := []interface{}{trialName}
+	qstr := ""
+	if namespace != "" {
+		qfield = append(qfield, namespace)
+		qstr += d.namespaceCondition(fmt.Sprintf("$%d", len(qfield)))
+	}
+	if len(metricNames) != 0 {
+		p := make([]string, 0, len(metricNames))
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+			p = append(p, fmt.Sprintf("$%d", len(qfield)))
+		}
+		qstr += " AND metric_name IN (" + strings.Join(p, ", ") + ")"
+	}
+	// Min and max are the stored values of the first logs with the lowest and the highest number,
+	// so they keep the format reported by the metrics collector.
+	// Latest value is joined by the highest step or by the time of the last log if logs don't have step,
+	// joined rows are ordered by time and insertion.
+	rows, err := d.db.Query(`WITH logs AS (SELECT metric_name, value, step, time, id, `+numericValue+` AS numeric_value
+		FROM observation_logs WHERE trial_name = $1`+qstr+`),
+		s AS (SELECT metric_name, MIN(numeric_value) AS min_value, MAX(numeric_value) AS max_value,
+		COUNT(*) AS total, MIN(time) AS first_time, MAX(time) AS last_time, MAX(step) AS last_step
+		FROM logs GROUP BY metric_name)
+		SELECT s.metric_name,
+		(SELECT m.value FROM logs m WHERE m.metric_name = s.metric_name AND m.numeric_value = s.min_value ORDER BY m.time, m.id LIMIT 1),
+		(SELECT m.value FROM logs m WHERE m.metric_name = s.metric_name AND m.numeric_value = s.max_value ORDER BY m.time, m.id LIMIT 1),
+		l.value, l.step, s.total, s.first_time, s.last_time
+		FROM s JOIN logs l ON l.metric_name = s.metric_name
+		AND (l.step = s.last_step OR (s.last_step IS NULL AND l.time = s.last_time))
+		ORDER BY s.metric_name, l.time, l.id`,
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ObservationSummary %v", err)
+	}
+	// Close the rows
+	defer rows.Close()
+	result := []*v1beta1.MetricSummary{}
+	for rows.Next() {
+		var mname, latest string
+		var minValue, maxValue sql.NullString
+		var latestStep sql.NullInt64
+		var count int64
+		var firstTime, lastTime time.Time
+		err := rows.Scan(&mname, &minValue, &maxValue, &latest, &latestStep, &count, &firstTime, &lastTime)
+		if err != nil {
+			klog.Errorf("Error scanning summary: %v", err)
+			continue
+		}
+		// Several logs can have the highest step or the latest time, the last one wins.
+		if n := len(result); n != 0 && result[n-1].MetricName == mname {
+			result[n-1].Latest = latest
+			result[n-1].LatestStep = common.FormatNullStep(latestStep)
+			continue
+		}
+		result = append(result, &v1beta1.MetricSummary{
+			MetricName:     mname,
+			Min:            minValue.String,
+			Max:            maxValue.String,
+			Latest:         latest,
+			Count:          count,
+			FirstTimeStamp: firstTime.UTC().Format(time.RFC3339Nano),
+			LastTimeStamp:  lastTime.UTC().Format(time.RFC3339Nano),
+			LatestStep:     common.FormatNullStep(latestStep),
+		})
+	}
+	return result, nil
+}
+
+func (d *dbConn) GetObservationLogOwners() ([]common.ObservationLogOwner, error) {
+	rows, err := d.db.Query("SELECT DISTINCT namespace, trial_name, trial_uid FROM observation_logs")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get observation log owners: %v", err)
+	}
+	defer rows.Close()
+	var owners []common.ObservationLogOwner
+	for rows.Next() {
+		var owner common.ObservationLogOwner
+		if err := rows.Scan(&owner.Namespace, &owner.TrialName, &owner.TrialUID); err != nil {
+			return nil, fmt.Errorf("Failed to scan observation log owner: %v", err)
+		}
+		owners = append(owners, owner)
+	}
+	return owners, rows.Err()
+}
+
+func (d *dbConn) DeleteOwnerObservationLog(owner common.ObservationLogOwner) (int64, error) {
+	result, err := d.db.Exec("DELETE FROM observation_logs WHERE namespace = $1 AND trial_name = $2 AND trial_uid = $3",
+		owner.Namespace, owner.TrialName, owner.TrialUID)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = d.db.Exec("DELETE FROM observation_log_offsets WHERE namespace = $1 AND trial_name = $2 AND trial_uid = $3",
+		owner.Namespace, owner.TrialName, owner.TrialUID); err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (d *dbConn) DeleteOwnerObservationLogBefore(owner common.ObservationLogOwner, before time.Time) (int64, error) {
+	result, err := d.db.Exec("DELETE FROM observation_logs WHERE namespace = $1 AND trial_name = $2 AND trial_uid = $3 AND time < $4",
+		owner.Namespace, owner.TrialName, owner.TrialUID, before.UTC().Format(postgresTimeFmt))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,415 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+var dbInterface common.KatibDBInterface
+var mock sqlmock.Sqlmock
+
+func TestMain(m *testing.M) {
+	db, sm, err := sqlmock.New()
+	mock = sm
+	if err != nil {
+		fmt.Printf("error opening db: %v\n", err)
+		os.Exit(1)
+	}
+	dbInterface, err = NewWithSQLConn(db)
+	if err != nil {
+		fmt.Printf("error NewWithSQLConn: %v\n", err)
+	}
+	mock.ExpectQuery("SELECT 1 FROM pg_advisory_lock\\(20220101\\)").WillReturnRows(
+		sqlmock.NewRows([]string{"?column?"}).AddRow(1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT MAX\\(version\\) FROM schema_migrations").WillReturnRows(
+		sqlmock.NewRows([]string{"MAX(version)"}).AddRow(nil))
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_logs").WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(1, "Create observation_logs table").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE INDEX observation_logs_trial_name_metric_name").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN namespace").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN experiment_name").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN trial_uid").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(3, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE INDEX observation_logs_time").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(4, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN step").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(5, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE observation_log_offsets").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(6, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("SELECT pg_advisory_unlock\\(20220101\\)").WillReturnResult(sqlmock.NewResult(0, 0))
+	dbInterface.DBInit()
+	err = dbInterface.SelectOne()
+	if err != nil {
+		fmt.Printf("error `SELECT 1` probing: %v\n", err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestRegisterObservationLog(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "f1_score",
+					Value: "88.95",
+				},
+				Step: "10",
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+			},
+		},
+	}
+	mock.ExpectPrepare(`INSERT INTO observation_logs \(namespace, experiment_name, trial_name, trial_uid, time, metric_name, value, step\) ` +
+		`VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\),\(\$9, \$10, \$11, \$12, \$13, \$14, \$15, \$16\)`)
+	mock.ExpectExec(
+		"INSERT",
+	).WithArgs(
+		"test-namespace",
+		"test1",
+		"test1_trial1",
+		"test1_trial1_uid",
+		"2016-12-31 20:02:05.123456",
+		"f1_score",
+		"88.95",
+		10,
+		"test-namespace",
+		"test1",
+		"test1_trial1",
+		"test1_trial1_uid",
+		"2016-12-31 20:02:05.123456",
+		"loss",
+		"0.5",
+		nil,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := dbInterface.RegisterObservationLog("test-namespace", "test1", "test1_trial1", "test1_trial1_uid", obsLog)
+	if err != nil {
+		t.Errorf("RegisterExperiment failed: %v", err)
+	}
+}
+
+func TestRegisterObservationLogWithOffset(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+				Step: "1",
+			},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO observation_log_offsets").WithArgs(
+		"test-namespace", "test1_trial1", "test1_trial1_uid", "test1-trial1-pod", 100,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO observation_logs").WithArgs(
+		"test-namespace", "test1", "test1_trial1", "test1_trial1_uid", "2016-12-31 20:02:05.123456", "loss", "0.5", 1,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	registered, err := dbInterface.RegisterObservationLogWithOffset("test-namespace", "test1", "test1_trial1", "test1_trial1_uid", "test1-trial1-pod", 100, obsLog)
+	if err != nil {
+		t.Errorf("RegisterObservationLogWithOffset failed: %v", err)
+	} else if !registered {
+		t.Errorf("RegisterObservationLogWithOffset skipped logs with the new offset")
+	}
+
+	// Logs which are reported again with the same offset are skipped.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO observation_log_offsets").WithArgs(
+		"test-namespace", "test1_trial1", "test1_trial1_uid", "test1-trial1-pod", 100,
+	).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	registered, err = dbInterface.RegisterObservationLogWithOffset("test-namespace", "test1", "test1_trial1", "test1_trial1_uid", "test1-trial1-pod", 100, obsLog)
+	if err != nil {
+		t.Errorf("RegisterObservationLogWithOffset failed: %v", err)
+	} else if registered {
+		t.Errorf("RegisterObservationLogWithOffset registered logs with the old offset")
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetObservationLog(t *testing.T) {
+	mock.ExpectQuery(
+		`SELECT time, metric_name, value, step FROM observation_logs WHERE trial_name = \$1 AND namespace = \$2 `+
+			`AND metric_name = \$3 AND time >= \$4 AND time <= \$5 AND step >= \$6 ORDER BY step IS NULL, step, time`,
+	).WithArgs(
+		"test1_trial1",
+		"test-namespace",
+		"loss",
+		"2016-12-31 21:01:05.123456",
+		"2016-12-31 22:10:20.123456",
+		1,
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"time", "metric_name", "value", "step"}).AddRow(
+			time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC),
+			"loss",
+			"0.9",
+			1,
+		).AddRow(
+			time.Date(2016, 12, 31, 22, 2, 5, 123456000, time.UTC),
+			"loss",
+			"0.9",
+			2,
+		),
+	)
+	obsLog, err := dbInterface.GetObservationLog(
+		"test-namespace",
+		"test1_trial1",
+		"loss",
+		"2016-12-31T21:01:05.123456Z",
+		"2016-12-31T22:10:20.123456Z",
+		"1",
+		"",
+		true,
+	)
+	if err != nil {
+		t.Errorf("GetObservationLog failed %v", err)
+	} else if len(obsLog.MetricLogs) != 2 {
+		t.Errorf("GetObservationLog incorrect return %v", obsLog)
+	} else if obsLog.MetricLogs[0].TimeStamp != "2016-12-31T21:02:05.123456Z" || obsLog.MetricLogs[0].Step != "1" {
+		t.Errorf("GetObservationLog incorrect first log %v", obsLog.MetricLogs[0])
+	}
+}
+
+func TestGetObservationLogs(t *testing.T) {
+	mock.ExpectQuery(
+		`SELECT trial_name, time, metric_name, value, step FROM observation_logs WHERE trial_name IN \(\$1, \$2\) AND namespace = \$3 `+
+			`AND metric_name IN \(\$4, \$5\) AND time >= \$6 AND time <= \$7 ORDER BY time`,
+	).WithArgs(
+		"test1_trial1",
+		"test1_trial2",
+		"test-namespace",
+		"loss",
+		"accuracy",
+		"2016-12-31 21:01:05.123456",
+		"2016-12-31 22:10:20.123456",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"trial_name", "time", "metric_name", "value", "step"}).AddRow(
+			"test1_trial1",
+			time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC),
+			"loss",
+			"0.9",
+			nil,
+		).AddRow(
+			"test1_trial1",
+			time.Date(2016, 12, 31, 22, 2, 5, 123456000, time.UTC),
+			"accuracy",
+			"0.7",
+			nil,
+		),
+	)
+	obsLogs, err := dbInterface.GetObservationLogs(
+		"test-namespace",
+		[]string{"test1_trial1", "test1_trial2"},
+		[]string{"loss", "accuracy"},
+		"2016-12-31T21:01:05.123456Z",
+		"2016-12-31T22:10:20.123456Z",
+	)
+	if err != nil {
+		t.Errorf("GetObservationLogs failed %v", err)
+	} else if len(obsLogs) != 2 || len(obsLogs["test1_trial1"].MetricLogs) != 2 || len(obsLogs["test1_trial2"].MetricLogs) != 0 {
+		t.Errorf("GetObservationLogs incorrect return %v", obsLogs)
+	}
+}
+
+func TestGetObservationSummary(t *testing.T) {
+	mock.ExpectQuery(
+		`WITH logs AS \(SELECT metric_name, value, step, time, id, `,
+	).WithArgs(
+		"test1_trial1",
+		"test-namespace",
+		"loss",
+		"accuracy",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"metric_name", "min_value", "max_value", "value", "step", "total", "first_time", "last_time"}).AddRow(
+			"accuracy",
+			nil,
+			nil,
+			"unavailable",
+			nil,
+			1,
+			time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC),
+			time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC),
+		).AddRow(
+			"loss",
+			"1.5e-1",
+			"0.50",
+			"unavailable",
+			3,
+			4,
+			time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC),
+			time.Date(2016, 12, 31, 22, 2, 5, 123456000, time.UTC),
+		).AddRow(
+			"loss",
+			"1.5e-1",
+			"0.50",
+			"0.3",
+			3,
+			4,
+			time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC),
+			time.Date(2016, 12, 31, 22, 2, 5, 123456000, time.UTC),
+		),
+	)
+	summaries, err := dbInterface.GetObservationSummary("test-namespace", "test1_trial1", []string{"loss", "accuracy"})
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed %v", err)
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("GetObservationSummary incorrect return %v", summaries)
+	}
+	if summaries[0].Min != "" || summaries[0].Max != "" || summaries[0].Latest != "unavailable" {
+		t.Errorf("GetObservationSummary incorrect summary of non-numeric metric %v", summaries[0])
+	}
+	if summaries[1].Min != "1.5e-1" || summaries[1].Max != "0.50" || summaries[1].Latest != "0.3" || summaries[1].LatestStep != "3" || summaries[1].Count != 4 ||
+		summaries[1].FirstTimeStamp != "2016-12-31T21:02:05.123456Z" || summaries[1].LastTimeStamp != "2016-12-31T22:02:05.123456Z" {
+		t.Errorf("GetObservationSummary incorrect summary %v", summaries[1])
+	}
+}
+
+func TestDeleteObservationLog(t *testing.T) {
+	trialName := "test1_trial1"
+
+	mock.ExpectExec(
+		`DELETE FROM observation_logs WHERE trial_name = \$1 AND namespace = \$2`,
+	).WithArgs(trialName, "test-namespace").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(
+		`DELETE FROM observation_log_offsets WHERE trial_name = \$1 AND namespace = \$2`,
+	).WithArgs(trialName, "test-namespace").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := dbInterface.DeleteObservationLog("test-namespace", trialName)
+	if err != nil {
+		t.Errorf("DeleteObservationLog failed: %v", err)
+	}
+
+	// Empty namespace deletes only logs of the Trial without namespace.
+	mock.ExpectExec(
+		`DELETE FROM observation_logs WHERE trial_name = \$1 AND namespace = \$2`,
+	).WithArgs(trialName, "").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(
+		`DELETE FROM observation_log_offsets WHERE trial_name = \$1 AND namespace = \$2`,
+	).WithArgs(trialName, "").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = dbInterface.DeleteObservationLog("", trialName)
+	if err != nil {
+		t.Errorf("DeleteObservationLog failed: %v", err)
+	}
+}
+
+func TestGetObservationLogOwners(t *testing.T) {
+	mock.ExpectQuery(
+		`SELECT DISTINCT namespace, trial_name, trial_uid FROM observation_logs`,
+	).WillReturnRows(sqlmock.NewRows([]string{"namespace", "trial_name", "trial_uid"}).
+		AddRow("test-namespace", "test1_trial1", "test1-trial1-uid").
+		AddRow("test-namespace", "test1_trial2", "test1-trial2-uid").
+		AddRow("", "test1_trial3", ""))
+
+	owners, err := dbInterface.GetObservationLogOwners()
+	if err != nil {
+		t.Fatalf("GetObservationLogOwners failed: %v", err)
+	}
+	expected := []common.ObservationLogOwner{
+		{Namespace: "test-namespace", TrialName: "test1_trial1", TrialUID: "test1-trial1-uid"},
+		{Namespace: "test-namespace", TrialName: "test1_trial2", TrialUID: "test1-trial2-uid"},
+		{TrialName: "test1_trial3"},
+	}
+	if !reflect.DeepEqual(owners, expected) {
+		t.Errorf("GetObservationLogOwners returns wrong owners: %v", owners)
+	}
+}
+
+func TestDeleteOwnerObservationLog(t *testing.T) {
+	mock.ExpectExec(
+		`DELETE FROM observation_logs WHERE namespace = \$1 AND trial_name = \$2 AND trial_uid = \$3`,
+	).WithArgs("test-namespace", "test1_trial1", "test1-trial1-uid").WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(
+		`DELETE FROM observation_log_offsets WHERE namespace = \$1 AND trial_name = \$2 AND trial_uid = \$3`,
+	).WithArgs("test-namespace", "test1_trial1", "test1-trial1-uid").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	deleted, err := dbInterface.DeleteOwnerObservationLog(common.ObservationLogOwner{
+		Namespace: "test-namespace",
+		TrialName: "test1_trial1",
+		TrialUID:  "test1-trial1-uid",
+	})
+	if err != nil {
+		t.Errorf("DeleteOwnerObservationLog failed: %v", err)
+	} else if deleted != 3 {
+		t.Errorf("DeleteOwnerObservationLog returns %d deleted logs, expected 3", deleted)
+	}
+}
+
+func TestDeleteOwnerObservationLogBefore(t *testing.T) {
+	mock.ExpectExec(
+		`DELETE FROM observation_logs WHERE namespace = \$1 AND trial_name = \$2 AND trial_uid = \$3 AND time < \$4`,
+	).WithArgs("test-namespace", "test1_trial1", "test1-trial1-uid", "2016-12-31 20:02:05.123456").WillReturnResult(sqlmock.NewResult(0, 5))
+
+	deleted, err := dbInterface.DeleteOwnerObservationLogBefore(common.ObservationLogOwner{
+		Namespace: "test-namespace",
+		TrialName: "test1_trial1",
+		TrialUID:  "test1-trial1-uid",
+	}, time.Date(2016, 12, 31, 20, 2, 5, 123456000, time.UTC))
+	if err != nil {
+		t.Errorf("DeleteOwnerObservationLogBefore failed: %v", err)
+	} else if deleted != 5 {
+		t.Errorf("DeleteOwnerObservationLogBefore returns %d deleted logs, expected 5", deleted)
+	}
+}
+
+func TestGetDbName(t *testing.T) {
+	dbName := "postgresql://katib:@katib-postgres:5432/katib?sslmode=disable"
+
+	if getDbName() != dbName {
+		t.Errorf("getDbName returns wrong value %v", getDbName())
+	}
+}
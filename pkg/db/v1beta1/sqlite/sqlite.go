@@ -0,0 +1,491 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog"
+	"modernc.org/sqlite"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/env"
+)
+
+const (
+	dbDriver = "sqlite"
+	// Fixed width fraction keeps lexical order of the stored strings equal to the time order.
+	sqliteTimeFmt = "2006-01-02 15:04:05.000000"
+
+	// numericValue converts the value of a log to a number, non-numeric values are NULL and skipped by aggregates.
+	// SQLite doesn't have REGEXP by default, so values are converted by the numericValueFunc function.
+	numericValue = numericValueFunc + "(value)"
+
+	numericValueFunc = "katib_numeric_value"
+)
+
+func init() {
+	// CAST in SQLite converts the longest numeric prefix, e.g. "1-2" to 1, so values are parsed in Go
+	// and non-numeric values are NULL.
+	sqlite.MustRegisterDeterministicScalarFunction(numericValueFunc, 1, func(ctx *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		var value string
+		switch v := args[0].(type) {
+		case string:
+			value = v
+		case []byte:
+			value = string(v)
+		default:
+			return nil, nil
+		}
+		if f, ok := common.ParseNumericValue(value); ok {
+			return f, nil
+		}
+		return nil, nil
+	})
+}
+
+type dbConn struct {
+	db *sql.DB
+	// matchLegacyNamespace selects logs without namespace by every namespace.
+	matchLegacyNamespace bool
+}
+
+func getDbPath() string {
+	return env.GetEnvOrDefault(common.SqliteDBPathEnvName, common.DefaultSqliteDBPath)
+}
+
+func NewWithSQLConn(db *sql.DB) (common.KatibDBInterface, error) {
+	d := new(dbConn)
+	d.db = db
+	d.matchLegacyNamespace = common.MatchLegacyNamespace()
+	return d, nil
+}
+
+func NewDBInterface() (common.KatibDBInterface, error) {
+	dbPath := getDbPath()
+	// DB file is usually located on the mounted volume, create the parent directory if it doesn't exist.
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
+		return nil, fmt.Errorf("Failed to create directory for DB file %v: %v", dbPath, err)
+	}
+	db, err := common.OpenSQLConn(dbDriver, dbPath, common.ConnectInterval, common.ConnectTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("DB open failed: %v", err)
+	}
+	// SQLite allows only one writer at a time.
+	// Serialize access through the single connection to avoid "database is locked" errors.
+	db.SetMaxOpenConns(1)
+	if _, err = db.Exec("PRAGMA journal_mode=WAL"); err != nil {
+		return nil, fmt.Errorf("Failed to enable WAL journal mode: %v", err)
+	}
+	if _, err = db.Exec("PRAGMA busy_timeout=5000"); err != nil {
+		return nil, fmt.Errorf("Failed to set busy timeout: %v", err)
+	}
+	return NewWithSQLConn(db)
+}
+
+func (d *dbConn) RegisterObservationLog(namespace string, experimentName string, trialName string, trialUID string, observationLog *v1beta1.ObservationLog) error {
+	sqlQuery, values, err := insertObservationLogQuery(namespace, experimentName, trialName, trialUID, observationLog)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the statement
+	stmt, err := d.db.Prepare(sqlQuery)
+	if err != nil {
+		return fmt.Errorf("Prepare SQL statement failed: %v", err)
+	}
+
+	// Close the statement
+	defer stmt.Close()
+
+	// Execute INSERT
+	_, err = stmt.Exec(values...)
+	if err != nil {
+		return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+	}
+
+	return nil
+}
+
+func (d *dbConn) RegisterObservationLogWithOffset(namespace string, experimentName string, trialName string, trialUID string, source string, sourceOffset int64,
+	observationLog *v1beta1.ObservationLog) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("Begin transaction failed: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Offset is updated only if it's greater than the current one or the source is changed.
+	result, err := tx.Exec(`INSERT INTO observation_log_offsets (namespace, trial_name, trial_uid, source, source_offset) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (namespace, trial_name, trial_uid) DO UPDATE SET source = excluded.source, source_offset = excluded.source_offset
+		WHERE observation_log_offsets.source != excluded.source OR observation_log_offsets.source_offset < excluded.source_offset`,
+		namespace, trialName, trialUID, source, sourceOffset)
+	if err != nil {
+		return false, fmt.Errorf("Execute SQL INSERT failed: %v", err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if updated == 0 {
+		return false, nil
+	}
+
+	sqlQuery, values, err := insertObservationLogQuery(namespace, experimentName, trialName, trialUID, observationLog)
+	if err != nil {
+		return false, err
+	}
+	if len(values) != 0 {
+		if _, err = tx.Exec(sqlQuery, values...); err != nil {
+			return false, fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("Commit transaction failed: %v", err)
+	}
+	return true, nil
+}
+
+// insertObservationLogQuery returns the query and the values to insert the logs, logs without time stamp are skipped.
+func insertObservationLogQuery(namespace string, experimentName string, trialName string, trialUID string,
+	observationLog *v1beta1.ObservationLog) (string, []interface{}, error) {
+	sqlQuery := "INSERT INTO observation_logs (namespace, experiment_name, trial_name, trial_uid, time, metric_name, value, step) VALUES "
+	values := []interface{}{}
+
+	for _, mlog := range observationLog.MetricLogs {
+		if mlog.TimeStamp == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, mlog.TimeStamp)
+		if err != nil {
+			return "", nil, fmt.Errorf("Error parsing start time %s: %v", mlog.TimeStamp, err)
+		}
+		sqlTimeStr := t.UTC().Format(sqliteTimeFmt)
+		step, err := common.ParseStep(mlog.Step)
+		if err != nil {
+			return "", nil, err
+		}
+
+		sqlQuery += "(?, ?, ?, ?, ?, ?, ?, ?),"
+		values = append(values, namespace, experimentName, trialName, trialUID, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value, step)
+	}
+	sqlQuery = sqlQuery[0 : len(sqlQuery)-1]
+	return sqlQuery, values, nil
+}
+
+// namespaceCondition selects logs of the namespace.
+// Logs without namespace are selected too if they are matched by every namespace.
+func (d *dbConn) namespaceCondition() string {
+	if d.matchLegacyNamespace {
+		return " AND namespace IN (?, '')"
+	}
+	return " AND namespace = ?"
+}
+
+func (d *dbConn) DeleteObservationLog(namespace string, trialName string) error {
+	// Namespace is always matched, so the empty namespace deletes only logs without namespace.
+	if _, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = ? AND namespace = ?", trialName, namespace); err != nil {
+		return err
+	}
+	_, err := d.db.Exec("DELETE FROM observation_log_offsets WHERE trial_name = ? AND namespace = ?", trialName, namespace)
+	return err
+}
+
+func (d *dbConn) GetObservationLog(namespace string, trialName string, metricName string, startTime string, endTime string,
+	startStep string, endStep string, orderByStep bool) (*v1beta1.ObservationLog, error) {
+	qfield := []interface{}{trialName}
+	qstr := ""
+	if namespace != "" {
+		qstr += d.namespaceCondition()
+		qfield = append(qfield, namespace)
+	}
+	if metricName != "" {
+		qstr += " AND metric_name = ?"
+		qfield = append(qfield, metricName)
+	}
+	if startTime != "" {
+		s_time, err := time.Parse(time.RFC3339Nano, startTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+		}
+		formattedStartTime := s_time.UTC().Format(sqliteTimeFmt)
+		qstr += " AND time >= ?"
+		qfield = append(qfield, formattedStartTime)
+	}
+	if endTime != "" {
+		e_time, err := time.Parse(time.RFC3339Nano, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+		}
+		formattedEndTime := e_time.UTC().Format(sqliteTimeFmt)
+		qstr += " AND time <= ?"
+		qfield = append(qfield, formattedEndTime)
+	}
+	for _, bound := range []struct {
+		step string
+		op   string
+	}{{startStep, ">="}, {endStep, "<="}} {
+		if bound.step == "" {
+			continue
+		}
+		step, err := common.ParseStep(bound.step)
+		if err != nil {
+			return nil, err
+		}
+		qstr += " AND step " + bound.op + " ?"
+		qfield = append(qfield, step)
+	}
+	orderBy := " ORDER BY time"
+	if orderByStep {
+		// Logs without step are the last.
+		orderBy = " ORDER BY step IS NULL, step, time"
+	}
+	rows, err := d.db.Query("SELECT time, metric_name, value, step FROM observation_logs WHERE trial_name = ?"+qstr+orderBy,
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
+	}
+	// Close the rows
+	defer rows.Close()
+	result := &v1beta1.ObservationLog{
+		MetricLogs: []*v1beta1.MetricLog{},
+	}
+	for rows.Next() {
+		var mname, mvalue, sqlTimeStr string
+		var step sql.NullInt64
+		err := rows.Scan(&sqlTimeStr, &mname, &mvalue, &step)
+		if err != nil {
+			klog.Errorf("Error scanning log: %v", err)
+			continue
+		}
+		ptime, err := time.Parse(sqliteTimeFmt, sqlTimeStr)
+		if err != nil {
+			klog.Errorf("Error parsing time %s: %v", sqlTimeStr, err)
+			continue
+		}
+		timeStamp := ptime.UTC().Format(time.RFC3339Nano)
+		result.MetricLogs = append(result.MetricLogs, &v1beta1.MetricLog{
+			TimeStamp: timeStamp,
+			Metric: &v1beta1.Metric{
+				Name:  mname,
+				Value: mvalue,
+			},
+			Step: common.FormatNullStep(step),
+		})
+	}
+	return result, nil
+}
+
+func (d *dbConn) GetObservationLogs(namespace string, trialNames []string, metricNames []string, startTime string, endTime string) (map[string]*v1beta1.ObservationLog, error) {
+	result := make(map[string]*v1beta1.ObservationLog, len(trialNames))
+	if len(trialNames) == 0 {
+		return result, nil
+	}
+	qfield := []interface{}{}
+	for _, trialName := range trialNames {
+		qfield = append(qfield, trialName)
+		result[trialName] = &v1beta1.ObservationLog{
+			MetricLogs: []*v1beta1.MetricLog{},
+		}
+	}
+	qstr := " WHERE trial_name IN (?" + strings.Repeat(", ?", len(trialNames)-1) + ")"
+	if namespace != "" {
+		qstr += d.namespaceCondition()
+		qfield = append(qfield, namespace)
+	}
+	if len(metricNames) != 0 {
+		qstr += " AND metric_name IN (?" + strings.Repeat(", ?", len(metricNames)-1) + ")"
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+		}
+	}
+	if startTime != "" {
+		s_time, err := time.Parse(time.RFC3339Nano, startTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+		}
+		formattedStartTime := s_time.UTC().Format(sqliteTimeFmt)
+		qstr += " AND time >= ?"
+		qfield = append(qfield, formattedStartTime)
+	}
+	if endTime != "" {
+		e_time, err := time.Parse(time.RFC3339Nano, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+		}
+		formattedEndTime := e_time.UTC().Format(sqliteTimeFmt)
+		qstr += " AND time <= ?"
+		qfield = append(qfield, formattedEndTime)
+	}
+	rows, err := d.db.Query("SELECT trial_name, time, metric_name, value, step FROM observation_logs"+qstr+" ORDER BY time",
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
+	}
+	// Close the rows
+	defer rows.Close()
+	for rows.Next() {
+		var tname, mname, mvalue, sqlTimeStr string
+		var step sql.NullInt64
+		err := rows.Scan(&tname, &sqlTimeStr, &mname, &mvalue, &step)
+		if err != nil {
+			klog.Errorf("Error scanning log: %v", err)
+			continue
+		}
+		log, ok := result[tname]
+		if !ok {
+			continue
+		}
+		ptime, err := time.Parse(sqliteTimeFmt, sqlTimeStr)
+		if err != nil {
+			klog.Errorf("Error parsing time %s: %v", sqlTimeStr, err)
+			continue
+		}
+		timeStamp := ptime.UTC().Format(time.RFC3339Nano)
+		log.MetricLogs = append(log.MetricLogs, &v1beta1.MetricLog{
+			TimeStamp: timeStamp,
+			Metric: &v1beta1.Metric{
+				Name:  mname,
+				Value: mvalue,
+			},
+			Step: common.FormatNullStep(step),
+		})
+	}
+	return result, nil
+}
+
+func (d *dbConn) GetObservationSummary(namespace string, trialName string, metricNames []string) ([]*v1beta1.MetricSummary, error) {
+	qfield := []interface{}{trialName}
+	qstr := ""
+	if namespace != "" {
+		qstr += d.namespaceCondition()
+		qfield = append(qfield, namespace)
+	}
+	if len(metricNames) != 0 {
+		qstr += " AND metric_name IN (?" + strings.Repeat(", ?", len(metricNames)-1) + ")"
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+		}
+	}
+	// Min and max are the stored values of the first logs with the lowest and the highest number,
+	// so they keep the format reported by the metrics collector.
+	// Latest value is joined by the highest step or by the time of the last log if logs don't have step,
+	// joined rows are ordered by time and insertion.
+	rows, err := d.db.Query(`WITH logs AS (SELECT metric_name, value, step, time, id, `+numericValue+` AS numeric_value
+		FROM observation_logs WHERE trial_name = ?`+qstr+`),
+		s AS (SELECT metric_name, MIN(numeric_value) AS min_value, MAX(numeric_value) AS max_value,
+		COUNT(*) AS total, MIN(time) AS first_time, MAX(time) AS last_time, MAX(step) AS last_step
+		FROM logs GROUP BY metric_name)
+		SELECT s.metric_name,
+		(SELECT m.value FROM logs m WHERE m.metric_name = s.metric_name AND m.numeric_value = s.min_value ORDER BY m.time, m.id LIMIT 1),
+		(SELECT m.value FROM logs m WHERE m.metric_name = s.metric_name AND m.numeric_value = s.max_value ORDER BY m.time, m.id LIMIT 1),
+		l.value, l.step, s.total, s.first_time, s.last_time
+		FROM s JOIN logs l ON l.metric_name = s.metric_name
+		AND (l.step = s.last_step OR (s.last_step IS NULL AND l.time = s.last_time))
+		ORDER BY s.metric_name, l.time, l.id`,
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ObservationSummary %v", err)
+	}
+	// Close the rows
+	defer rows.Close()
+	result := []*v1beta1.MetricSummary{}
+	for rows.Next() {
+		var mname, latest, firstTimeStr, lastTimeStr string
+		var minValue, maxValue sql.NullString
+		var latestStep sql.NullInt64
+		var count int64
+		err := rows.Scan(&mname, &minValue, &maxValue, &latest, &latestStep, &count, &firstTimeStr, &lastTimeStr)
+		if err != nil {
+			klog.Errorf("Error scanning summary: %v", err)
+			continue
+		}
+		firstTime, err := time.Parse(sqliteTimeFmt, firstTimeStr)
+		if err != nil {
+			klog.Errorf("Error parsing time %s: %v", firstTimeStr, err)
+			continue
+		}
+		lastTime, err := time.Parse(sqliteTimeFmt, lastTimeStr)
+		if err != nil {
+			klog.Errorf("Error parsing time %s: %v", lastTimeStr, err)
+			continue
+		}
+		// Several logs can have the highest step or the latest time, the last one wins.
+		if n := len(result); n != 0 && result[n-1].MetricName == mname {
+			result[n-1].Latest = latest
+			result[n-1].LatestStep = common.FormatNullStep(latestStep)
+			continue
+		}
+		result = append(result, &v1beta1.MetricSummary{
+			MetricName:     mname,
+			Min:            minValue.String,
+			Max:            maxValue.String,
+			Latest:         latest,
+			Count:          count,
+			FirstTimeStamp: firstTime.UTC().Format(time.RFC3339Nano),
+			LastTimeStamp:  lastTime.UTC().Format(time.RFC3339Nano),
+			LatestStep:     common.FormatNullStep(latestStep),
+		})
+	}
+	return result, nil
+}
+
+func (d *dbConn) GetObservationLogOwners() ([]common.ObservationLogOwner, error) {
+	rows, err := d.db.Query("SELECT DISTINCT namespace, trial_name, trial_uid FROM observation_logs")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get observation log owners: %v", err)
+	}
+	defer rows.Close()
+	var owners []common.ObservationLogOwner
+	for rows.Next() {
+		var owner common.ObservationLogOwner
+		if err := rows.Scan(&owner.Namespace, &owner.TrialName, &owner.TrialUID); err != nil {
+			return nil, fmt.Errorf("Failed to scan observation log owner: %v", err)
+		}
+		owners = append(owners, owner)
+	}
+	return owners, rows.Err()
+}
+
+func (d *dbConn) DeleteOwnerObservationLog(owner common.ObservationLogOwner) (int64, error) {
+	result, err := d.db.Exec("DELETE FROM observation_logs WHERE namespace = ? AND trial_name = ? AND trial_uid = ?",
+		owner.Namespace, owner.TrialName, owner.TrialUID)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = d.db.Exec("DELETE FROM observation_log_offsets WHERE namespace = ? AND trial_name = ? AND trial_uid = ?",
+		owner.Namespace, owner.TrialName, owner.TrialUID); err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (d *dbConn) DeleteOwnerObservationLogBefore(owner common.ObservationLogOwner, before time.Time) (int64, error) {
+	result, err := d.db.Exec("DELETE FROM observation_logs WHERE namespace = ? AND trial_name = ? AND trial_uid = ? AND time < ?",
+		owner.Namespace, owner.TrialName, owner.TrialUID, before.UTC().Format(sqliteTimeFmt))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,684 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+var dbInterface common.KatibDBInterface
+
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "katib-sqlite")
+	if err != nil {
+		fmt.Printf("error creating temp dir: %v\n", err)
+		os.Exit(1)
+	}
+	os.Setenv(common.SqliteDBPathEnvName, filepath.Join(dir, "data", "katib.db"))
+	dbInterface, err = NewDBInterface()
+	if err != nil {
+		fmt.Printf("error NewDBInterface: %v\n", err)
+		os.Exit(1)
+	}
+	dbInterface.DBInit()
+	err = dbInterface.SelectOne()
+	if err != nil {
+		fmt.Printf("error `SELECT 1` probing: %v\n", err)
+	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func TestObservationLog(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "f1_score",
+					Value: "88.95",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:06Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.4",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T22:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.3",
+				},
+			},
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "test1", "test1_trial1", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "test1", "test1_trial2", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+
+	obsLogReply, err := dbInterface.GetObservationLog(
+		"test-namespace",
+		"test1_trial1",
+		"loss",
+		"2016-12-31T20:02:05.123456Z",
+		"2016-12-31T21:10:20.123456Z",
+		"",
+		"",
+		false,
+	)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLogReply.MetricLogs) != 2 {
+		t.Fatalf("GetObservationLog incorrect return %v", obsLogReply)
+	}
+	if obsLogReply.MetricLogs[0].TimeStamp != "2016-12-31T20:02:05.123456Z" || obsLogReply.MetricLogs[0].Metric.Value != "0.5" {
+		t.Errorf("GetObservationLog incorrect first log %v", obsLogReply.MetricLogs[0])
+	}
+	if obsLogReply.MetricLogs[1].TimeStamp != "2016-12-31T20:02:06Z" || obsLogReply.MetricLogs[1].Metric.Value != "0.4" {
+		t.Errorf("GetObservationLog incorrect second log %v", obsLogReply.MetricLogs[1])
+	}
+
+	if err = dbInterface.DeleteObservationLog("test-namespace", "test1_trial1"); err != nil {
+		t.Fatalf("DeleteObservationLog failed: %v", err)
+	}
+	obsLogReply, err = dbInterface.GetObservationLog("test-namespace", "test1_trial1", "", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLogReply.MetricLogs) != 0 {
+		t.Errorf("DeleteObservationLog didn't delete logs %v", obsLogReply)
+	}
+	obsLogReply, err = dbInterface.GetObservationLog("test-namespace", "test1_trial2", "", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLogReply.MetricLogs) != 4 {
+		t.Errorf("DeleteObservationLog deleted logs of another Trial %v", obsLogReply)
+	}
+}
+
+func TestGetObservationLogs(t *testing.T) {
+	logs := map[string][]*api_pb.MetricLog{
+		"test2_trial1": {
+			{
+				TimeStamp: "2016-12-31T20:02:05Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:06Z",
+				Metric: &api_pb.Metric{
+					Name:  "accuracy",
+					Value: "0.8",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:07Z",
+				Metric: &api_pb.Metric{
+					Name:  "recall",
+					Value: "0.6",
+				},
+			},
+		},
+		"test2_trial2": {
+			{
+				TimeStamp: "2016-12-31T20:02:05Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.4",
+				},
+			},
+		},
+	}
+	for trialName, metricLogs := range logs {
+		if err := dbInterface.RegisterObservationLog("test-namespace", "test2", trialName, "", &api_pb.ObservationLog{MetricLogs: metricLogs}); err != nil {
+			t.Fatalf("RegisterObservationLog failed: %v", err)
+		}
+	}
+
+	obsLogs, err := dbInterface.GetObservationLogs(
+		"test-namespace",
+		[]string{"test2_trial1", "test2_trial2", "test2_trial3"},
+		[]string{"loss", "accuracy"},
+		"",
+		"",
+	)
+	if err != nil {
+		t.Fatalf("GetObservationLogs failed %v", err)
+	}
+	if len(obsLogs) != 3 {
+		t.Fatalf("GetObservationLogs incorrect return %v", obsLogs)
+	}
+	if trial1 := obsLogs["test2_trial1"].MetricLogs; len(trial1) != 2 || trial1[0].Metric.Name != "loss" || trial1[1].Metric.Name != "accuracy" {
+		t.Errorf("GetObservationLogs incorrect logs for test2_trial1 %v", trial1)
+	}
+	if trial2 := obsLogs["test2_trial2"].MetricLogs; len(trial2) != 1 || trial2[0].Metric.Value != "0.4" {
+		t.Errorf("GetObservationLogs incorrect logs for test2_trial2 %v", trial2)
+	}
+	if trial3 := obsLogs["test2_trial3"].MetricLogs; len(trial3) != 0 {
+		t.Errorf("GetObservationLogs incorrect logs for test2_trial3 %v", trial3)
+	}
+
+	obsLogs, err = dbInterface.GetObservationLogs("test-namespace", []string{"test2_trial1"}, nil, "2016-12-31T20:02:06Z", "")
+	if err != nil {
+		t.Fatalf("GetObservationLogs failed %v", err)
+	}
+	if trial1 := obsLogs["test2_trial1"].MetricLogs; len(trial1) != 2 {
+		t.Errorf("GetObservationLogs incorrect logs for test2_trial1 since start time %v", trial1)
+	}
+}
+
+func TestGetObservationSummary(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2016-12-31T20:02:05Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:06Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "1.5e-1",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:07Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "unavailable",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:07Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "1-2",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:07Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "e",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:07Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.3",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:05Z",
+				Metric: &api_pb.Metric{
+					Name:  "accuracy",
+					Value: "unavailable",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:05Z",
+				Metric: &api_pb.Metric{
+					Name:  "recall",
+					Value: "-2",
+				},
+			},
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "test3", "test3_trial1", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+
+	summaries, err := dbInterface.GetObservationSummary("test-namespace", "test3_trial1", []string{"loss", "accuracy"})
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed %v", err)
+	}
+	expected := []*api_pb.MetricSummary{
+		{
+			MetricName:     "accuracy",
+			Latest:         "unavailable",
+			Count:          1,
+			FirstTimeStamp: "2016-12-31T20:02:05Z",
+			LastTimeStamp:  "2016-12-31T20:02:05Z",
+		},
+		{
+			MetricName:     "loss",
+			Min:            "1.5e-1",
+			Max:            "0.5",
+			Latest:         "0.3",
+			Count:          6,
+			FirstTimeStamp: "2016-12-31T20:02:05Z",
+			LastTimeStamp:  "2016-12-31T20:02:07Z",
+		},
+	}
+	if len(summaries) != len(expected) {
+		t.Fatalf("GetObservationSummary incorrect return %v", summaries)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(summaries[i], expected[i]) {
+			t.Errorf("GetObservationSummary incorrect summary, expected %v, got %v", expected[i], summaries[i])
+		}
+	}
+
+	summaries, err = dbInterface.GetObservationSummary("test-namespace", "test3_trial1", nil)
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed %v", err)
+	}
+	if len(summaries) != 3 || summaries[2].MetricName != "recall" || summaries[2].Min != "-2" {
+		t.Errorf("GetObservationSummary incorrect return for all metrics %v", summaries)
+	}
+}
+
+func TestObservationLogStep(t *testing.T) {
+	newMetricLog := func(timeStamp, value, step string) *api_pb.MetricLog {
+		return &api_pb.MetricLog{
+			TimeStamp: timeStamp,
+			Metric: &api_pb.Metric{
+				Name:  "loss",
+				Value: value,
+			},
+			Step: step,
+		}
+	}
+	// Logs of the restarted training have earlier steps and later timestamps.
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T20:02:05Z", "0.5", "1"),
+			newMetricLog("2016-12-31T20:02:06Z", "0.4", "2"),
+			newMetricLog("2016-12-31T20:02:07Z", "0.2", "3"),
+			newMetricLog("2016-12-31T20:02:08Z", "0.45", "2"),
+			newMetricLog("2016-12-31T20:02:09Z", "0.6", ""),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "test5", "test5_trial1", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+
+	testCases := []struct {
+		description string
+		startStep   string
+		endStep     string
+		orderByStep bool
+		expected    []string
+	}{
+		{
+			description: "Logs are ordered by time",
+			expected:    []string{"0.5", "0.4", "0.2", "0.45", "0.6"},
+		},
+		{
+			description: "Logs are ordered by step, logs without step are the last",
+			orderByStep: true,
+			expected:    []string{"0.5", "0.4", "0.45", "0.2", "0.6"},
+		},
+		{
+			description: "Logs are selected by step range",
+			startStep:   "2",
+			endStep:     "2",
+			orderByStep: true,
+			expected:    []string{"0.4", "0.45"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			obsLogReply, err := dbInterface.GetObservationLog("test-namespace", "test5_trial1", "loss", "", "",
+				tc.startStep, tc.endStep, tc.orderByStep)
+			if err != nil {
+				t.Fatalf("GetObservationLog failed %v", err)
+			}
+			var actual []string
+			for _, mlog := range obsLogReply.MetricLogs {
+				actual = append(actual, mlog.Metric.Value)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("GetObservationLog incorrect logs, expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+
+	if _, err := dbInterface.GetObservationLog("test-namespace", "test5_trial1", "loss", "", "", "1.5", "", false); err == nil {
+		t.Errorf("GetObservationLog must fail for invalid step")
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "test5", "test5_trial1", "", &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{newMetricLog("2016-12-31T20:02:10Z", "0.1", "epoch")},
+	}); err == nil {
+		t.Errorf("RegisterObservationLog must fail for invalid step")
+	}
+
+	summaries, err := dbInterface.GetObservationSummary("test-namespace", "test5_trial1", []string{"loss"})
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].Latest != "0.2" || summaries[0].LatestStep != "3" {
+		t.Errorf("GetObservationSummary latest value must have the highest step %v", summaries)
+	}
+}
+
+func TestNamespaceIsolation(t *testing.T) {
+	newLog := func(timeStamp, value string) *api_pb.ObservationLog {
+		return &api_pb.ObservationLog{
+			MetricLogs: []*api_pb.MetricLog{
+				{
+					TimeStamp: timeStamp,
+					Metric: &api_pb.Metric{
+						Name:  "loss",
+						Value: value,
+					},
+				},
+			},
+		}
+	}
+	// Trials with the same name in different namespaces and the log reported without namespace.
+	if err := dbInterface.RegisterObservationLog("namespace-a", "test4", "test4_trial1", "uid-a", newLog("2016-12-31T20:02:05Z", "0.1")); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	if err := dbInterface.RegisterObservationLog("namespace-b", "test4", "test4_trial1", "uid-b", newLog("2016-12-31T20:02:06Z", "0.2")); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	if err := dbInterface.RegisterObservationLog("", "", "test4_trial1", "", newLog("2016-12-31T20:02:04Z", "0.3")); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+
+	obsLog, err := dbInterface.GetObservationLog("namespace-a", "test4_trial1", "", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 1 || obsLog.MetricLogs[0].Metric.Value != "0.1" {
+		t.Errorf("GetObservationLog incorrect logs for namespace-a %v", obsLog)
+	}
+	obsLog, err = dbInterface.GetObservationLog("", "test4_trial1", "", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 3 {
+		t.Errorf("GetObservationLog incorrect logs for all namespaces %v", obsLog)
+	}
+	obsLogs, err := dbInterface.GetObservationLogs("namespace-b", []string{"test4_trial1"}, nil, "", "")
+	if err != nil {
+		t.Fatalf("GetObservationLogs failed %v", err)
+	}
+	if trial1 := obsLogs["test4_trial1"].MetricLogs; len(trial1) != 1 || trial1[0].Metric.Value != "0.2" {
+		t.Errorf("GetObservationLogs incorrect logs for namespace-b %v", trial1)
+	}
+	summaries, err := dbInterface.GetObservationSummary("namespace-a", "test4_trial1", nil)
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].Count != 1 || summaries[0].Latest != "0.1" || summaries[0].Max != "0.1" {
+		t.Errorf("GetObservationSummary incorrect summary for namespace-a %v", summaries)
+	}
+
+	// Log without namespace is selected by every namespace only in the compatibility mode.
+	legacyDBInterface := &dbConn{db: dbInterface.(*dbConn).db, matchLegacyNamespace: true}
+	obsLog, err = legacyDBInterface.GetObservationLog("namespace-a", "test4_trial1", "", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 2 || obsLog.MetricLogs[0].Metric.Value != "0.3" || obsLog.MetricLogs[1].Metric.Value != "0.1" {
+		t.Errorf("GetObservationLog incorrect logs for namespace-a with logs without namespace %v", obsLog)
+	}
+	summaries, err = legacyDBInterface.GetObservationSummary("namespace-a", "test4_trial1", nil)
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].Count != 2 || summaries[0].Latest != "0.1" || summaries[0].Max != "0.3" {
+		t.Errorf("GetObservationSummary incorrect summary for namespace-a with logs without namespace %v", summaries)
+	}
+
+	// Log without namespace is never deleted by the Trial in the namespace.
+	if err = legacyDBInterface.DeleteObservationLog("namespace-a", "test4_trial1"); err != nil {
+		t.Fatalf("DeleteObservationLog failed: %v", err)
+	}
+	obsLog, err = dbInterface.GetObservationLog("", "test4_trial1", "", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 2 || obsLog.MetricLogs[0].Metric.Value != "0.3" || obsLog.MetricLogs[1].Metric.Value != "0.2" {
+		t.Errorf("DeleteObservationLog deleted logs without namespace %v", obsLog)
+	}
+
+	if err = dbInterface.DeleteObservationLog("namespace-a", "test4_trial1"); err != nil {
+		t.Fatalf("DeleteObservationLog failed: %v", err)
+	}
+	obsLog, err = dbInterface.GetObservationLog("namespace-b", "test4_trial1", "", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 1 || obsLog.MetricLogs[0].Metric.Value != "0.2" {
+		t.Errorf("DeleteObservationLog deleted logs of Trial in another namespace %v", obsLog)
+	}
+
+	// Empty namespace deletes only logs without namespace.
+	if err = dbInterface.DeleteObservationLog("", "test4_trial1"); err != nil {
+		t.Fatalf("DeleteObservationLog failed: %v", err)
+	}
+	obsLog, err = dbInterface.GetObservationLog("", "test4_trial1", "", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 1 || obsLog.MetricLogs[0].Metric.Value != "0.2" {
+		t.Errorf("DeleteObservationLog with empty namespace deleted logs of Trial in the namespace %v", obsLog)
+	}
+}
+
+func TestObservationLogWithOffset(t *testing.T) {
+	newLog := func(value string) *api_pb.ObservationLog {
+		return &api_pb.ObservationLog{
+			MetricLogs: []*api_pb.MetricLog{
+				{
+					TimeStamp: "2017-01-01T00:00:00Z",
+					Metric: &api_pb.Metric{
+						Name:  "loss",
+						Value: value,
+					},
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		description string
+		trialUID    string
+		source      string
+		offset      int64
+		value       string
+		registered  bool
+	}{
+		{
+			description: "First logs are registered",
+			trialUID:    "uid-1",
+			source:      "pod-1",
+			offset:      10,
+			value:       "0.5",
+			registered:  true,
+		},
+		{
+			description: "Logs with the same offset are skipped",
+			trialUID:    "uid-1",
+			source:      "pod-1",
+			offset:      10,
+			value:       "0.5",
+		},
+		{
+			description: "Logs with the lower offset are skipped",
+			trialUID:    "uid-1",
+			source:      "pod-1",
+			offset:      5,
+			value:       "0.6",
+		},
+		{
+			description: "Logs with the greater offset are registered",
+			trialUID:    "uid-1",
+			source:      "pod-1",
+			offset:      20,
+			value:       "0.4",
+			registered:  true,
+		},
+		{
+			description: "Offset of another source replaces the previous offset",
+			trialUID:    "uid-1",
+			source:      "pod-2",
+			offset:      5,
+			value:       "0.3",
+			registered:  true,
+		},
+		{
+			description: "Offsets of the Trial with another UID are separated",
+			trialUID:    "uid-2",
+			source:      "pod-1",
+			offset:      5,
+			value:       "0.2",
+			registered:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			registered, err := dbInterface.RegisterObservationLogWithOffset("namespace-offset", "test6", "test6_trial1", tc.trialUID, tc.source, tc.offset, newLog(tc.value))
+			if err != nil {
+				t.Fatalf("RegisterObservationLogWithOffset failed: %v", err)
+			}
+			if registered != tc.registered {
+				t.Errorf("RegisterObservationLogWithOffset returns %v, expected %v", registered, tc.registered)
+			}
+		})
+	}
+
+	obsLogReply, err := dbInterface.GetObservationLog("namespace-offset", "test6_trial1", "loss", "", "", "", "", false)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	if len(obsLogReply.MetricLogs) != 4 {
+		t.Errorf("GetObservationLog returns %d logs, expected 4", len(obsLogReply.MetricLogs))
+	}
+
+	// Offsets are deleted with logs, so logs can be registered again.
+	if err = dbInterface.DeleteObservationLog("namespace-offset", "test6_trial1"); err != nil {
+		t.Fatalf("DeleteObservationLog failed: %v", err)
+	}
+	registered, err := dbInterface.RegisterObservationLogWithOffset("namespace-offset", "test6", "test6_trial1", "uid-1", "pod-1", 10, newLog("0.5"))
+	if err != nil || !registered {
+		t.Errorf("RegisterObservationLogWithOffset returns %v with error %v after logs are deleted", registered, err)
+	}
+}
+
+func TestRetention(t *testing.T) {
+	newLog := func(timeStamp string) *api_pb.ObservationLog {
+		return &api_pb.ObservationLog{
+			MetricLogs: []*api_pb.MetricLog{
+				{
+					TimeStamp: timeStamp,
+					Metric: &api_pb.Metric{
+						Name:  "loss",
+						Value: "0.1",
+					},
+				},
+			},
+		}
+	}
+	// Logs are older than logs of other tests.
+	if err := dbInterface.RegisterObservationLog("namespace-retention", "test5", "test5_trial1", "uid-1", newLog("2010-01-01T00:00:00Z")); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	if err := dbInterface.RegisterObservationLog("namespace-retention", "test5", "test5_trial2", "uid-2", newLog("2010-06-01T00:00:00Z")); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+
+	before := time.Date(2010, 3, 1, 0, 0, 0, 0, time.UTC)
+	// Logs of another owner are kept.
+	deleted, err := dbInterface.DeleteOwnerObservationLogBefore(common.ObservationLogOwner{Namespace: "namespace-retention", TrialName: "test5_trial1", TrialUID: "uid-2"}, before)
+	if err != nil || deleted != 0 {
+		t.Errorf("DeleteOwnerObservationLogBefore deleted %d logs with error %v, expected 0", deleted, err)
+	}
+	deleted, err = dbInterface.DeleteOwnerObservationLogBefore(common.ObservationLogOwner{Namespace: "namespace-retention", TrialName: "test5_trial1", TrialUID: "uid-1"}, before)
+	if err != nil {
+		t.Fatalf("DeleteOwnerObservationLogBefore failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("DeleteOwnerObservationLogBefore deleted %d logs, expected 1", deleted)
+	}
+	// Logs which are not older than TTL are kept.
+	deleted, err = dbInterface.DeleteOwnerObservationLogBefore(common.ObservationLogOwner{Namespace: "namespace-retention", TrialName: "test5_trial2", TrialUID: "uid-2"}, before)
+	if err != nil || deleted != 0 {
+		t.Errorf("DeleteOwnerObservationLogBefore deleted %d logs with error %v, expected 0", deleted, err)
+	}
+
+	owners, err := dbInterface.GetObservationLogOwners()
+	if err != nil {
+		t.Fatalf("GetObservationLogOwners failed: %v", err)
+	}
+	var retentionOwners []common.ObservationLogOwner
+	for _, owner := range owners {
+		if owner.Namespace == "namespace-retention" {
+			retentionOwners = append(retentionOwners, owner)
+		}
+	}
+	expected := []common.ObservationLogOwner{{Namespace: "namespace-retention", TrialName: "test5_trial2", TrialUID: "uid-2"}}
+	if !reflect.DeepEqual(retentionOwners, expected) {
+		t.Errorf("GetObservationLogOwners returns %v, expected %v", retentionOwners, expected)
+	}
+
+	// Logs of the Trial with another UID are kept.
+	if deleted, err = dbInterface.DeleteOwnerObservationLog(common.ObservationLogOwner{Namespace: "namespace-retention", TrialName: "test5_trial2", TrialUID: "uid-3"}); err != nil || deleted != 0 {
+		t.Errorf("DeleteOwnerObservationLog deleted %d logs with error %v, expected 0", deleted, err)
+	}
+	if deleted, err = dbInterface.DeleteOwnerObservationLog(expected[0]); err != nil || deleted != 1 {
+		t.Errorf("DeleteOwnerObservationLog deleted %d logs with error %v, expected 1", deleted, err)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	db := dbInterface.(*dbConn).db
+	// Migrations must be already applied by DBInit, so Migrate is no-op.
+	if err := migrator.Migrate(db); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	version, err := migrator.CurrentVersion(db)
+	if err != nil {
+		t.Fatalf("CurrentVersion failed: %v", err)
+	}
+	if version != migrator.LatestVersion() {
+		t.Errorf("Schema version %v, expected %v", version, migrator.LatestVersion())
+	}
+}
@@ -153,7 +153,8 @@ class MedianStopService(api_pb2_grpc.EarlyStoppingServicer):
                 with api_pb2.beta_create_DBManager_stub(channel) as client:
                     get_log_response = client.GetObservationLog(api_pb2.GetObservationLogRequest(
                         trial_name=trial.name,
-                        metric_name=self.objective_metric
+                        metric_name=self.objective_metric,
+                        namespace=self.namespace
                     ), timeout=APISERVER_TIMEOUT)
 
                 # Get only first start_step metrics.
@@ -28,8 +28,20 @@ const (
 	// DefaultTimeout is the default value for timeout before invoke error during running processes check
 	// To run without timeout set value to 0
 	DefaultTimeout = 0
+	// DefaultFlushInterval is the default value for interval between reports of new metrics in the metrics file
+	DefaultFlushInterval = 10 * time.Second
+	// DefaultScrapeInterval is the default value for interval between Prometheus metrics scrapes
+	DefaultScrapeInterval = 10 * time.Second
+	// DefaultScrapeTimeout is the default value for timeout of Prometheus metrics scrape
+	DefaultScrapeTimeout = 5 * time.Second
 	// DefaultWaitAll is the default value whether wait for all other main process of container exiting
 	DefaultWaitAllProcesses = "true"
+	// DefaultTerminationMode is the default value for which training processes are signaled when training is early stopped
+	DefaultTerminationMode = string(TerminationModeProcessTree)
+	// DefaultTerminationSignal is the default value for signal which is sent to training processes when training is early stopped
+	DefaultTerminationSignal = "SIGTERM"
+	// DefaultTerminationGracePeriod is the default value for time to wait until training processes are stopped by the signal
+	DefaultTerminationGracePeriod = 30 * time.Second
 	// TrainingCompleted is the job finished marker in $$$$.pid file when main training process is completed
 	TrainingCompleted = "completed"
 
@@ -48,6 +60,11 @@ const (
 
 	TimeStampJsonKey = "timestamp"
 
+	// StepKey and EpochKey are the keys of the metrics step, step is used if both keys are set.
+	// For example, step is collected from the line "epoch=2 step=150 loss=0.3".
+	StepKey  = "step"
+	EpochKey = "epoch"
+
 	// TODO (andreyvelich): Do we need to maintain 2 names? Should we leave only 1?
 	MetricCollectorContainerName       = "metrics-collector"
 	MetricLoggerCollectorContainerName = "metrics-logger-and-collector"
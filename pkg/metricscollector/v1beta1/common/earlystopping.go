@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/v3/process"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// mainProcessStopTimeout is the time to wait until main process is completed after training is terminated.
+const mainProcessStopTimeout = 60 * time.Second
+
+// StopRulesFlag is the flag value with the list of early stopping rules.
+// Each rule is set in the "name;value;comparison;startStep[;strategy[;windowSize]]" format.
+// If strategy is not set, the default aggregation of metric values is used.
+type StopRulesFlag []commonv1beta1.EarlyStoppingRule
+
+func (flag *StopRulesFlag) String() string {
+	stopRuleStrings := []string{}
+	for _, r := range *flag {
+		stopRuleStrings = append(stopRuleStrings, FormatStopRule(r))
+	}
+	return strings.Join(stopRuleStrings, ",")
+}
+
+func (flag *StopRulesFlag) Set(value string) error {
+	stopRuleParsed := strings.Split(value, ";")
+	if len(stopRuleParsed) < 4 || len(stopRuleParsed) > 6 {
+		return fmt.Errorf("Invalid Early Stopping rule: %v", value)
+	}
+
+	// Get int start step.
+	startStep, err := strconv.Atoi(stopRuleParsed[3])
+	if err != nil {
+		return fmt.Errorf("Parse start step: %v to int error: %v", stopRuleParsed[3], err)
+	}
+
+	// For each stop rule this order: 1 - metric name, 2 - metric value, 3 - comparison type, 4 - start step,
+	// 5 - strategy, 6 - window size.
+	// Start step is equal to 0, if it's not defined.
+	stopRule := commonv1beta1.EarlyStoppingRule{
+		Name:       stopRuleParsed[0],
+		Value:      stopRuleParsed[1],
+		Comparison: commonv1beta1.ComparisonType(stopRuleParsed[2]),
+		StartStep:  startStep,
+	}
+	if len(stopRuleParsed) > 4 {
+		stopRule.Strategy = commonv1beta1.EarlyStoppingStrategyType(stopRuleParsed[4])
+	}
+	if len(stopRuleParsed) > 5 {
+		stopRule.WindowSize, err = strconv.Atoi(stopRuleParsed[5])
+		if err != nil {
+			return fmt.Errorf("Parse window size: %v to int error: %v", stopRuleParsed[5], err)
+		}
+	}
+	if err = validateStopRuleStrategy(stopRule); err != nil {
+		return err
+	}
+
+	*flag = append(*flag, stopRule)
+	return nil
+}
+
+// FormatStopRule converts the rule to the stop rule flag value, e.g. accuracy;0.8;less;4 or accuracy;0.8;less;4;movingAverage;3.
+func FormatStopRule(rule commonv1beta1.EarlyStoppingRule) string {
+	ruleString := rule.Name + ";" + rule.Value + ";" + string(rule.Comparison) + ";" + strconv.Itoa(rule.StartStep)
+	if rule.Strategy != "" {
+		ruleString += ";" + string(rule.Strategy) + ";" + strconv.Itoa(rule.WindowSize)
+	}
+	return ruleString
+}
+
+func validateStopRuleStrategy(rule commonv1beta1.EarlyStoppingRule) error {
+	switch rule.Strategy {
+	case "", commonv1beta1.EarlyStoppingStrategyMin, commonv1beta1.EarlyStoppingStrategyMax, commonv1beta1.EarlyStoppingStrategyLatest:
+		if rule.WindowSize != 0 {
+			return fmt.Errorf("Window size can be set only for %v strategy, rule metric: %v",
+				commonv1beta1.EarlyStoppingStrategyMovingAverage, rule.Name)
+		}
+	case commonv1beta1.EarlyStoppingStrategyMovingAverage:
+		if rule.WindowSize <= 0 {
+			return fmt.Errorf("Window size must be greater than 0 for %v strategy, rule metric: %v",
+				commonv1beta1.EarlyStoppingStrategyMovingAverage, rule.Name)
+		}
+	default:
+		return fmt.Errorf("Unsupported strategy: %v for rule metric: %v", rule.Strategy, rule.Name)
+	}
+	return nil
+}
+
+// stopRule is the early stopping rule with the aggregated metric values.
+type stopRule struct {
+	commonv1beta1.EarlyStoppingRule
+	// reportedSteps is the quantity of the reported metric values.
+	// Rule is applied only if metric is reported at least "start_step" times.
+	reportedSteps int
+	// value is the metric value aggregated by the rule strategy.
+	value float64
+	// window contains the latest metric values for the moving average strategy.
+	window []float64
+}
+
+// StopRules tracks early stopping rules which have not been reached yet.
+type StopRules struct {
+	rules []*stopRule
+}
+
+// NewStopRules creates StopRules for the rules, objectiveMetric and objectiveType are used to
+// calculate the best objective value for the rules without strategy.
+func NewStopRules(rules []commonv1beta1.EarlyStoppingRule, objectiveMetric string, objectiveType commonv1beta1.ObjectiveType) *StopRules {
+	stopRules := &StopRules{}
+	for _, rule := range rules {
+		// For objective metric we calculate best optimal value from the recorded metrics.
+		// This is workaround for Median Stop algorithm.
+		if rule.Strategy == "" && rule.Name == objectiveMetric {
+			switch objectiveType {
+			case commonv1beta1.ObjectiveTypeMaximize:
+				rule.Strategy = commonv1beta1.EarlyStoppingStrategyMax
+			case commonv1beta1.ObjectiveTypeMinimize:
+				rule.Strategy = commonv1beta1.EarlyStoppingStrategyMin
+			}
+		}
+		if rule.Strategy == "" {
+			rule.Strategy = commonv1beta1.EarlyStoppingStrategyLatest
+		}
+		stopRules.rules = append(stopRules.rules, &stopRule{EarlyStoppingRule: rule})
+	}
+	return stopRules
+}
+
+// Rules returns the rules which have not been reached yet.
+func (s *StopRules) Rules() []commonv1beta1.EarlyStoppingRule {
+	rules := make([]commonv1beta1.EarlyStoppingRule, 0, len(s.rules))
+	for _, rule := range s.rules {
+		rules = append(rules, rule.EarlyStoppingRule)
+	}
+	return rules
+}
+
+// Reached returns true if all rules are reached and training should be early stopped.
+func (s *StopRules) Reached() bool {
+	return len(s.rules) == 0
+}
+
+// Update applies the reported metric value to the rules of the metric.
+// After rule is reached it is deleted from the rules.
+func (s *StopRules) Update(metricName string, metricValue float64) error {
+	// Rules are iterated backward, since the reached rule is replaced by the last one.
+	for idx := len(s.rules) - 1; idx >= 0; idx-- {
+		if s.rules[idx].Name != metricName {
+			continue
+		}
+		reached, err := s.rules[idx].update(metricValue)
+		if err != nil {
+			return err
+		}
+		// Deleting reached stop rule from the array.
+		if reached {
+			s.rules[idx] = s.rules[len(s.rules)-1]
+			s.rules[len(s.rules)-1] = nil
+			s.rules = s.rules[:len(s.rules)-1]
+		}
+	}
+	return nil
+}
+
+// update aggregates the metric value and returns true if the rule is reached.
+func (r *stopRule) update(metricValue float64) (bool, error) {
+	r.reportedSteps++
+	switch r.Strategy {
+	case commonv1beta1.EarlyStoppingStrategyMin:
+		if r.reportedSteps == 1 || metricValue < r.value {
+			r.value = metricValue
+		}
+	case commonv1beta1.EarlyStoppingStrategyMax:
+		if r.reportedSteps == 1 || metricValue > r.value {
+			r.value = metricValue
+		}
+	case commonv1beta1.EarlyStoppingStrategyLatest:
+		r.value = metricValue
+	case commonv1beta1.EarlyStoppingStrategyMovingAverage:
+		r.window = append(r.window, metricValue)
+		if len(r.window) > r.WindowSize {
+			r.window = r.window[1:]
+		}
+		// Rule is applied once the window is full.
+		if len(r.window) < r.WindowSize {
+			return false, nil
+		}
+		sum := 0.0
+		for _, v := range r.window {
+			sum += v
+		}
+		r.value = sum / float64(len(r.window))
+	default:
+		return false, fmt.Errorf("Unsupported strategy: %v for rule metric: %v", r.Strategy, r.Name)
+	}
+
+	if r.reportedSteps < r.StartStep {
+		return false, nil
+	}
+
+	ruleValue, err := strconv.ParseFloat(r.Value, 64)
+	if err != nil {
+		return false, fmt.Errorf("Unable to parse value %v to float for rule metric %v", r.Value, r.Name)
+	}
+
+	// Metric value can be equal, less or greater than stop rule.
+	return (r.Comparison == commonv1beta1.ComparisonTypeEqual && r.value == ruleValue) ||
+		(r.Comparison == commonv1beta1.ComparisonTypeLess && r.value < ruleValue) ||
+		(r.Comparison == commonv1beta1.ComparisonTypeGreater && r.value > ruleValue), nil
+}
+
+// MarkTrainingEarlyStopped creates ".pid" file with "early-stopped" line in the markDir.
+// Which means that training is early stopped and Trial status is updated.
+func MarkTrainingEarlyStopped(markDir string, mainPid int) error {
+	markFile := filepath.Join(markDir, fmt.Sprintf("%d.pid", mainPid))
+	if err := ioutil.WriteFile(markFile, []byte(TrainingEarlyStopped), 0644); err != nil {
+		return fmt.Errorf("Write to file %v error: %v", markFile, err)
+	}
+	return nil
+}
+
+// WaitMainProcessStopped waits until the main process is completed after the training is terminated.
+func WaitMainProcessStopped(mainPid int) error {
+	mainProc, err := psutil.NewProcess(int32(mainPid))
+	if err != nil {
+		// Process is already completed.
+		return nil
+	}
+	endTime := time.Now().Add(mainProcessStopTimeout)
+	isProcRunning := true
+	for isProcRunning && time.Now().Before(endTime) {
+		isProcRunning, err = mainProc.IsRunning()
+		// Ignore "no such file error". It means that process is complete.
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Check process status for main PID: %v failed: %v", mainPid, err)
+		}
+	}
+	return nil
+}
+
+// SetTrialEarlyStopped sends request to Early Stopping service to change Trial status to early stopped.
+func SetTrialEarlyStopped(earlyStopServiceAddr, trialName string) error {
+	// Create connection and client for Early Stopping service.
+	conn, err := grpc.Dial(earlyStopServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("Could not connect to Early Stopping service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewEarlyStoppingClient(conn)
+
+	setTrialStatusReq := &api.SetTrialStatusRequest{
+		TrialName: trialName,
+	}
+	if _, err = c.SetTrialStatus(context.Background(), setTrialStatusReq); err != nil {
+		return fmt.Errorf("Set Trial status error: %v", err)
+	}
+	return nil
+}
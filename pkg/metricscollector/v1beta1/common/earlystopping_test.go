@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+)
+
+type testMetric struct {
+	name  string
+	value float64
+}
+
+func TestStopRules(t *testing.T) {
+	testCases := []struct {
+		description string
+		rules       []string
+		metrics     []testMetric
+		reached     bool
+	}{
+		{
+			description: "Rule is reached by metric value",
+			rules:       []string{"loss;0.5;less;0"},
+			metrics:     []testMetric{{"loss", 0.7}, {"loss", 0.4}},
+			reached:     true,
+		},
+		{
+			description: "Rule is not reached before start step",
+			rules:       []string{"loss;0.5;less;3"},
+			metrics:     []testMetric{{"loss", 0.4}, {"loss", 0.3}},
+			reached:     false,
+		},
+		{
+			description: "Best objective value is compared with the rule",
+			rules:       []string{"accuracy;0.6;less;2"},
+			metrics:     []testMetric{{"accuracy", 0.7}, {"accuracy", 0.5}},
+			reached:     false,
+		},
+		{
+			description: "All rules must be reached",
+			rules:       []string{"accuracy;0.6;less;0", "loss;1;greater;0"},
+			metrics:     []testMetric{{"accuracy", 0.5}, {"loss", 0.5}},
+			reached:     false,
+		},
+		{
+			description: "Several rules of the same metric are reached",
+			rules:       []string{"loss;1;greater;0", "loss;5;less;0", "accuracy;0.6;less;0"},
+			metrics:     []testMetric{{"loss", 2}, {"accuracy", 0.5}},
+			reached:     true,
+		},
+		{
+			description: "Latest objective value is compared with the rule",
+			rules:       []string{"accuracy;0.6;less;2;latest;0"},
+			metrics:     []testMetric{{"accuracy", 0.7}, {"accuracy", 0.5}},
+			reached:     true,
+		},
+		{
+			description: "Minimum metric value is compared with the rule",
+			rules:       []string{"loss;0.5;less;0;min;0"},
+			metrics:     []testMetric{{"loss", 0.4}, {"loss", 0.9}},
+			reached:     true,
+		},
+		{
+			description: "Maximum metric value is compared with the rule",
+			rules:       []string{"loss;1;greater;3;max;0"},
+			metrics:     []testMetric{{"loss", 0.5}, {"loss", 0.4}, {"loss", 0.3}},
+			reached:     false,
+		},
+		{
+			description: "Moving average is compared with the rule",
+			rules:       []string{"accuracy;0.6;less;0;movingAverage;2"},
+			metrics:     []testMetric{{"accuracy", 0.9}, {"accuracy", 0.5}, {"accuracy", 0.6}},
+			reached:     true,
+		},
+		{
+			description: "Rule is not applied before moving average window is full",
+			rules:       []string{"accuracy;0.6;less;0;movingAverage;3"},
+			metrics:     []testMetric{{"accuracy", 0.5}, {"accuracy", 0.4}},
+			reached:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var rulesFlag StopRulesFlag
+			for _, rule := range tc.rules {
+				if err := rulesFlag.Set(rule); err != nil {
+					t.Fatal(err)
+				}
+			}
+			stopRules := NewStopRules(rulesFlag, "accuracy", commonv1beta1.ObjectiveTypeMaximize)
+			for _, metric := range tc.metrics {
+				if err := stopRules.Update(metric.name, metric.value); err != nil {
+					t.Fatalf("Update failed: %v", err)
+				}
+			}
+			if stopRules.Reached() != tc.reached {
+				t.Errorf("Expected reached %v, got %v, rules: %v", tc.reached, stopRules.Reached(), stopRules.Rules())
+			}
+		})
+	}
+
+	for _, rule := range []string{
+		"loss;0.5;less",
+		"loss;0.5;less;0;median",
+		"loss;0.5;less;0;movingAverage;0",
+		"loss;0.5;less;0;latest;3",
+	} {
+		t.Run("Invalid rule "+rule, func(t *testing.T) {
+			var rulesFlag StopRulesFlag
+			if err := rulesFlag.Set(rule); err == nil {
+				t.Errorf("Expected error for invalid rule")
+			}
+		})
+	}
+}
+
+func TestFormatStopRule(t *testing.T) {
+	testCases := []struct {
+		description string
+		rule        commonv1beta1.EarlyStoppingRule
+		expected    string
+	}{
+		{
+			description: "Rule without strategy",
+			rule:        commonv1beta1.EarlyStoppingRule{Name: "accuracy", Value: "0.8", Comparison: commonv1beta1.ComparisonTypeLess, StartStep: 4},
+			expected:    "accuracy;0.8;less;4",
+		},
+		{
+			description: "Rule with moving average strategy",
+			rule: commonv1beta1.EarlyStoppingRule{
+				Name:       "loss",
+				Value:      "2",
+				Comparison: commonv1beta1.ComparisonTypeGreater,
+				Strategy:   commonv1beta1.EarlyStoppingStrategyMovingAverage,
+				WindowSize: 3,
+			},
+			expected: "loss;2;greater;0;movingAverage;3",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			actual := FormatStopRule(tc.rule)
+			if actual != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, actual)
+			}
+			// Formatted rule must be parsed to the same rule.
+			var rulesFlag StopRulesFlag
+			if err := rulesFlag.Set(actual); err != nil {
+				t.Fatal(err)
+			}
+			if rulesFlag[0] != tc.rule {
+				t.Errorf("Expected parsed rule %v, got %v", tc.rule, rulesFlag[0])
+			}
+		})
+	}
+}
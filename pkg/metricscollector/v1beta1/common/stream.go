@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"io"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// ObservationLogStream reports metric logs of the Trial to Katib DB Manager while they are collected.
+type ObservationLogStream struct {
+	stream         v1beta1.DBManager_StreamObservationLogClient
+	trialName      string
+	namespace      string
+	experimentName string
+	trialUID       string
+}
+
+// NewObservationLogStream opens the stream of metric logs for the Trial.
+// Logs which are sent before the stream is broken are stored by Katib DB Manager.
+func NewObservationLogStream(ctx context.Context, client v1beta1.DBManagerClient,
+	trialName, namespace, experimentName, trialUID string) (*ObservationLogStream, error) {
+	stream, err := client.StreamObservationLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ObservationLogStream{
+		stream:         stream,
+		trialName:      trialName,
+		namespace:      namespace,
+		experimentName: experimentName,
+		trialUID:       trialUID,
+	}, nil
+}
+
+// Send reports the next metric logs of the Trial.
+func (s *ObservationLogStream) Send(metricLogs []*v1beta1.MetricLog) error {
+	return s.SendWithOffset(metricLogs, "", 0)
+}
+
+// SendWithOffset reports the next metric logs of the Trial which end at the offset in the source.
+// Logs are registered only if the offset is greater than the offset of the previous logs from the source,
+// so the same logs can be sent again once the source is resumed.
+func (s *ObservationLogStream) SendWithOffset(metricLogs []*v1beta1.MetricLog, source string, sourceOffset int64) error {
+	err := s.stream.Send(&v1beta1.ReportObservationLogRequest{
+		TrialName:      s.trialName,
+		Namespace:      s.namespace,
+		ExperimentName: s.experimentName,
+		TrialUid:       s.trialUID,
+		ObservationLog: &v1beta1.ObservationLog{
+			MetricLogs: metricLogs,
+		},
+		SourceOffset: sourceOffset,
+		Source:       source,
+	})
+	// Send returns io.EOF if the stream is aborted, the real error is returned by CloseAndRecv.
+	if err == io.EOF {
+		if _, recvErr := s.stream.CloseAndRecv(); recvErr != nil {
+			err = recvErr
+		}
+	}
+	return err
+}
+
+// Close finishes the stream and waits until Katib DB Manager stores all sent metric logs.
+func (s *ObservationLogStream) Close() error {
+	_, err := s.stream.CloseAndRecv()
+	return err
+}
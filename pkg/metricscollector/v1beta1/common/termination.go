@@ -0,0 +1,251 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/v3/process"
+	"k8s.io/klog"
+)
+
+// terminationPollInterval is the interval between checks whether training processes are stopped.
+const terminationPollInterval = 100 * time.Millisecond
+
+// TerminationMode defines which processes receive the signal when training is early stopped.
+type TerminationMode string
+
+const (
+	// TerminationModeProcessTree sends the signal to all descendant processes of the main process.
+	TerminationModeProcessTree TerminationMode = "ProcessTree"
+	// TerminationModeProcessGroup sends the signal to process groups of the main process children.
+	// Children which are in the main process group are signaled as in the ProcessTree mode.
+	TerminationModeProcessGroup TerminationMode = "ProcessGroup"
+)
+
+// TerminationOpts is the options to stop training processes.
+type TerminationOpts struct {
+	Mode   TerminationMode
+	Signal syscall.Signal
+	// GracePeriod is the time to wait until processes are stopped by the signal.
+	// After that processes are killed with SIGKILL.
+	GracePeriod time.Duration
+}
+
+// NewTerminationOpts returns TerminationOpts for the mode and the signal name.
+func NewTerminationOpts(mode string, signal string, gracePeriod time.Duration) (TerminationOpts, error) {
+	if TerminationMode(mode) != TerminationModeProcessTree && TerminationMode(mode) != TerminationModeProcessGroup {
+		return TerminationOpts{}, fmt.Errorf("Termination mode must be %v or %v, got %v",
+			TerminationModeProcessTree, TerminationModeProcessGroup, mode)
+	}
+	sig, err := ParseSignal(signal)
+	if err != nil {
+		return TerminationOpts{}, err
+	}
+	if gracePeriod < 0 {
+		return TerminationOpts{}, fmt.Errorf("Termination grace period must not be negative, got %v", gracePeriod)
+	}
+	return TerminationOpts{
+		Mode:        TerminationMode(mode),
+		Signal:      sig,
+		GracePeriod: gracePeriod,
+	}, nil
+}
+
+var signals = map[string]syscall.Signal{
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGINT":  syscall.SIGINT,
+	"SIGQUIT": syscall.SIGQUIT,
+	"SIGKILL": syscall.SIGKILL,
+	"SIGUSR1": syscall.SIGUSR1,
+	"SIGUSR2": syscall.SIGUSR2,
+	"SIGTERM": syscall.SIGTERM,
+}
+
+// ParseSignal returns the signal by the name, e.g. "SIGINT" or "INT", or by the number.
+func ParseSignal(name string) (syscall.Signal, error) {
+	if number, err := strconv.Atoi(name); err == nil && number > 0 {
+		return syscall.Signal(number), nil
+	}
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	sig, ok := signals[name]
+	if !ok {
+		return 0, fmt.Errorf("Unsupported signal: %v", name)
+	}
+	return sig, nil
+}
+
+// processTable lists and signals processes of the container.
+type processTable interface {
+	// parentPids returns the parent PID of each process.
+	parentPids() (map[int]int, error)
+	pgid(pid int) (int, error)
+	// signal sends the signal to the process, or to the process group if pid is negative.
+	signal(pid int, sig syscall.Signal) error
+	isRunning(pid int) bool
+}
+
+type osProcessTable struct{}
+
+func (osProcessTable) parentPids() (map[int]int, error) {
+	procs, err := psutil.Processes()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list processes: %v", err)
+	}
+	parents := make(map[int]int, len(procs))
+	for _, proc := range procs {
+		ppid, err := proc.Ppid()
+		if err != nil {
+			// Process is completed.
+			continue
+		}
+		parents[int(proc.Pid)] = int(ppid)
+	}
+	return parents, nil
+}
+
+func (osProcessTable) pgid(pid int) (int, error) {
+	return syscall.Getpgid(pid)
+}
+
+func (osProcessTable) signal(pid int, sig syscall.Signal) error {
+	if err := syscall.Kill(pid, sig); err != nil && err != syscall.ESRCH {
+		return err
+	}
+	return nil
+}
+
+func (osProcessTable) isRunning(pid int) bool {
+	proc, err := psutil.NewProcess(int32(pid))
+	if err != nil {
+		return false
+	}
+	// Zombie process is completed, but not reaped by its parent yet.
+	status, err := proc.Status()
+	if err != nil {
+		return false
+	}
+	return len(status) == 0 || status[0] != psutil.Zombie
+}
+
+// TerminateTraining sends the signal to the training processes of the main process.
+// Processes which are still running after the grace period are killed with SIGKILL.
+func TerminateTraining(mainPid int, opts TerminationOpts) error {
+	return terminateTraining(osProcessTable{}, mainPid, opts)
+}
+
+func terminateTraining(pt processTable, mainPid int, opts TerminationOpts) error {
+	parents, err := pt.parentPids()
+	if err != nil {
+		return err
+	}
+	children := make(map[int][]int)
+	for pid, ppid := range parents {
+		children[ppid] = append(children[ppid], pid)
+	}
+	for _, pids := range children {
+		sort.Ints(pids)
+	}
+	descendants := processSubtree(children, mainPid)
+	if len(descendants) == 0 {
+		return fmt.Errorf("Training processes are not found for main PID: %v", mainPid)
+	}
+
+	// Processes which are signaled by the process group.
+	var pgids []int
+	pids := descendants
+	if opts.Mode == TerminationModeProcessGroup {
+		mainPgid, err := pt.pgid(mainPid)
+		if err != nil {
+			return fmt.Errorf("Get process group for main PID: %v failed: %v", mainPid, err)
+		}
+		pids = nil
+		for _, child := range children[mainPid] {
+			pgid, err := pt.pgid(child)
+			if err != nil {
+				return fmt.Errorf("Get process group for PID: %v failed: %v", child, err)
+			}
+			// Main process must not be signaled with its group.
+			if pgid == mainPgid {
+				pids = append(pids, child)
+				pids = append(pids, processSubtree(children, child)...)
+			} else {
+				pgids = append(pgids, pgid)
+			}
+		}
+	} else if opts.Mode != TerminationModeProcessTree {
+		return fmt.Errorf("Unsupported termination mode: %v", opts.Mode)
+	}
+
+	signalAll := func(sig syscall.Signal) error {
+		for _, pgid := range pgids {
+			if err := pt.signal(-pgid, sig); err != nil {
+				return fmt.Errorf("Unable to send %v to process group %v, error: %v", sig, pgid, err)
+			}
+		}
+		for _, pid := range pids {
+			if err := pt.signal(pid, sig); err != nil {
+				return fmt.Errorf("Unable to send %v to process %v, error: %v", sig, pid, err)
+			}
+		}
+		return nil
+	}
+	klog.Infof("Send %v to training processes %v and process groups %v", opts.Signal, pids, pgids)
+	if err = signalAll(opts.Signal); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(opts.GracePeriod)
+	for {
+		var running []int
+		for _, pid := range descendants {
+			if pt.isRunning(pid) {
+				running = append(running, pid)
+			}
+		}
+		if len(running) == 0 {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			klog.Warningf("Training processes %v are still running after %v, they are killed", running, opts.GracePeriod)
+			pids = running
+			return signalAll(syscall.SIGKILL)
+		}
+		time.Sleep(terminationPollInterval)
+	}
+}
+
+// processSubtree returns all descendants of the root process, parents go before children.
+func processSubtree(children map[int][]int, root int) []int {
+	var subtree []int
+	queue := append([]int(nil), children[root]...)
+	for len(queue) != 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		subtree = append(subtree, pid)
+		queue = append(queue, children[pid]...)
+	}
+	return subtree
+}
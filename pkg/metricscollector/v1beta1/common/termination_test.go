@@ -0,0 +1,220 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"reflect"
+	"syscall"
+	"testing"
+)
+
+type fakeProcess struct {
+	ppid int
+	pgid int
+	// ignoreSignals is true if process is stopped only by SIGKILL.
+	ignoreSignals bool
+	stopped       bool
+}
+
+// fakeProcessTable records signals which are received by processes.
+type fakeProcessTable struct {
+	procs   map[int]*fakeProcess
+	signals map[int][]syscall.Signal
+}
+
+func newFakeProcessTable(procs map[int]*fakeProcess) *fakeProcessTable {
+	return &fakeProcessTable{
+		procs:   procs,
+		signals: make(map[int][]syscall.Signal),
+	}
+}
+
+func (pt *fakeProcessTable) parentPids() (map[int]int, error) {
+	parents := make(map[int]int)
+	for pid, proc := range pt.procs {
+		if !proc.stopped {
+			parents[pid] = proc.ppid
+		}
+	}
+	return parents, nil
+}
+
+func (pt *fakeProcessTable) pgid(pid int) (int, error) {
+	return pt.procs[pid].pgid, nil
+}
+
+func (pt *fakeProcessTable) signal(pid int, sig syscall.Signal) error {
+	for procPid, proc := range pt.procs {
+		if proc.stopped || (pid > 0 && procPid != pid) || (pid < 0 && proc.pgid != -pid) {
+			continue
+		}
+		pt.signals[procPid] = append(pt.signals[procPid], sig)
+		if !proc.ignoreSignals || sig == syscall.SIGKILL {
+			proc.stopped = true
+		}
+	}
+	return nil
+}
+
+func (pt *fakeProcessTable) isRunning(pid int) bool {
+	return !pt.procs[pid].stopped
+}
+
+func TestTerminateTraining(t *testing.T) {
+	// Main process 10 runs in the group 1 with the metrics collector.
+	const mainPid = 10
+	testCases := []struct {
+		description string
+		procs       map[int]*fakeProcess
+		opts        TerminationOpts
+		expected    map[int][]syscall.Signal
+		err         bool
+	}{
+		{
+			description: "Single child process is terminated",
+			procs: map[int]*fakeProcess{
+				mainPid: {ppid: 1, pgid: 1},
+				11:      {ppid: mainPid, pgid: 1},
+			},
+			opts:     TerminationOpts{Mode: TerminationModeProcessTree, Signal: syscall.SIGTERM},
+			expected: map[int][]syscall.Signal{11: {syscall.SIGTERM}},
+		},
+		{
+			description: "All processes of the launcher tree receive the signal",
+			procs: map[int]*fakeProcess{
+				mainPid: {ppid: 1, pgid: 1},
+				11:      {ppid: mainPid, pgid: 1},
+				12:      {ppid: 11, pgid: 1},
+				13:      {ppid: 11, pgid: 1},
+				14:      {ppid: 13, pgid: 1},
+				20:      {ppid: 1, pgid: 1},
+			},
+			opts: TerminationOpts{Mode: TerminationModeProcessTree, Signal: syscall.SIGINT},
+			expected: map[int][]syscall.Signal{
+				11: {syscall.SIGINT},
+				12: {syscall.SIGINT},
+				13: {syscall.SIGINT},
+				14: {syscall.SIGINT},
+			},
+		},
+		{
+			description: "Processes which ignore the signal are killed after grace period",
+			procs: map[int]*fakeProcess{
+				mainPid: {ppid: 1, pgid: 1},
+				11:      {ppid: mainPid, pgid: 1},
+				12:      {ppid: 11, pgid: 1, ignoreSignals: true},
+			},
+			opts: TerminationOpts{Mode: TerminationModeProcessTree, Signal: syscall.SIGTERM},
+			expected: map[int][]syscall.Signal{
+				11: {syscall.SIGTERM},
+				12: {syscall.SIGTERM, syscall.SIGKILL},
+			},
+		},
+		{
+			description: "Process groups of the children receive the signal",
+			procs: map[int]*fakeProcess{
+				mainPid: {ppid: 1, pgid: 1},
+				11:      {ppid: mainPid, pgid: 11},
+				12:      {ppid: 11, pgid: 11},
+				// Process is reparented, but it is still in the training process group.
+				13: {ppid: 1, pgid: 11, ignoreSignals: true},
+				// Child in the main process group is signaled without its group.
+				15: {ppid: mainPid, pgid: 1},
+				16: {ppid: 15, pgid: 1},
+				20: {ppid: 1, pgid: 1},
+			},
+			opts: TerminationOpts{Mode: TerminationModeProcessGroup, Signal: syscall.SIGTERM},
+			expected: map[int][]syscall.Signal{
+				11: {syscall.SIGTERM},
+				12: {syscall.SIGTERM},
+				13: {syscall.SIGTERM},
+				15: {syscall.SIGTERM},
+				16: {syscall.SIGTERM},
+			},
+		},
+		{
+			description: "Process groups are killed after grace period",
+			procs: map[int]*fakeProcess{
+				mainPid: {ppid: 1, pgid: 1},
+				11:      {ppid: mainPid, pgid: 11},
+				12:      {ppid: 11, pgid: 11, ignoreSignals: true},
+			},
+			opts: TerminationOpts{Mode: TerminationModeProcessGroup, Signal: syscall.SIGTERM},
+			expected: map[int][]syscall.Signal{
+				11: {syscall.SIGTERM},
+				12: {syscall.SIGTERM, syscall.SIGKILL},
+			},
+		},
+		{
+			description: "Main process without children",
+			procs: map[int]*fakeProcess{
+				mainPid: {ppid: 1, pgid: 1},
+			},
+			opts: TerminationOpts{Mode: TerminationModeProcessTree, Signal: syscall.SIGTERM},
+			err:  true,
+		},
+		{
+			description: "Invalid termination mode",
+			procs: map[int]*fakeProcess{
+				mainPid: {ppid: 1, pgid: 1},
+				11:      {ppid: mainPid, pgid: 1},
+			},
+			opts: TerminationOpts{Mode: "Container", Signal: syscall.SIGTERM},
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			pt := newFakeProcessTable(tc.procs)
+			err := terminateTraining(pt, mainPid, tc.opts)
+			if tc.err {
+				if err == nil {
+					t.Errorf("Expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("terminateTraining failed: %v", err)
+			}
+			if !reflect.DeepEqual(pt.signals, tc.expected) {
+				t.Errorf("Expected signals %v\n got %v", tc.expected, pt.signals)
+			}
+		})
+	}
+}
+
+func TestParseSignal(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected syscall.Signal
+		err      bool
+	}{
+		{name: "SIGTERM", expected: syscall.SIGTERM},
+		{name: "int", expected: syscall.SIGINT},
+		{name: "10", expected: syscall.Signal(10)},
+		{name: "SIGUNKNOWN", err: true},
+	}
+	for _, tc := range testCases {
+		actual, err := ParseSignal(tc.name)
+		if (err != nil) != tc.err {
+			t.Errorf("Signal %v: expected error %v, got %v", tc.name, tc.err, err)
+		} else if actual != tc.expected {
+			t.Errorf("Signal %v: expected %v, got %v", tc.name, tc.expected, actual)
+		}
+	}
+}
@@ -17,15 +17,20 @@ limitations under the License.
 package sidecarmetricscollector
 
 import (
-	"encoding/json"
+	"bufio"
+	"encoding/csv"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math"
 	"os"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/tidwall/gjson"
 	"k8s.io/klog"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
@@ -34,7 +39,8 @@ import (
 	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 )
 
-func CollectObservationLog(fileName string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat) (*v1beta1.ObservationLog, error) {
+func CollectObservationLog(fileName string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat,
+	timestampKey string) (*v1beta1.ObservationLog, error) {
 	file, err := os.Open(fileName)
 	if err != nil {
 		return nil, err
@@ -46,16 +52,221 @@ func CollectObservationLog(fileName string, metrics []string, filters []string,
 	}
 	logs := string(content)
 
-	switch fileFormat {
+	mlogs, err := NewMetricLogParser(metrics, filters, fileFormat, timestampKey).Parse(strings.Split(logs, "\n"))
+	if err != nil {
+		return nil, err
+	}
+	return newObservationLog(mlogs, metrics), nil
+}
+
+// FollowObservationLog parses metrics from the file while it is written and passes new metric logs to send
+// every flushInterval with the file offset after the last parsed line, so the report is idempotent.
+// Only complete lines are parsed until stop is closed, after that the rest of the file is parsed.
+// If checkpointFile is set, offsets of the logs are saved there before they are sent. Once the collector is restarted,
+// the last logs are sent again with the same offset and the rest of the file is followed.
+// If objective metric is not found in the file, the unavailable value is sent as CollectObservationLog does.
+func FollowObservationLog(fileName string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat, timestampKey string,
+	flushInterval time.Duration, checkpointFile string, stop <-chan struct{}, send func([]*v1beta1.MetricLog, int64) error) error {
+	isObjectiveMetricReported := false
+	parser := NewMetricLogParser(metrics, filters, fileFormat, timestampKey)
+	parseLines := func(lines []string) ([]*v1beta1.MetricLog, error) {
+		mlogs, err := parser.Parse(lines)
+		if err != nil {
+			return nil, err
+		}
+		for _, mlog := range mlogs {
+			if mlog.Metric.Name == metrics[0] {
+				isObjectiveMetricReported = true
+			}
+		}
+		return mlogs, nil
+	}
+	sendLines := func(lines []string, start, end int64) error {
+		mlogs, err := parseLines(lines)
+		if err != nil || len(mlogs) == 0 {
+			return err
+		}
+		if err = saveCheckpoint(checkpointFile, start, end); err != nil {
+			return err
+		}
+		return send(mlogs, end)
+	}
+
+	var file *os.File
+	var reader *bufio.Reader
+	defer func() {
+		if file != nil {
+			file.Close()
+		}
+	}()
+	// offset is the file offset after the last parsed line.
+	offset := int64(0)
+	// partialLine is the last line of the file which is not completed yet.
+	partialLine := ""
+	isStopped := false
+	for {
+		if file == nil {
+			f, err := os.Open(fileName)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			} else if err == nil {
+				file = f
+				reader = bufio.NewReader(file)
+				if offset, err = resumeFromCheckpoint(reader, checkpointFile, parseLines, sendLines); err != nil {
+					return err
+				}
+			}
+		}
+		if file != nil {
+			start := offset
+			var lines []string
+			for {
+				line, err := reader.ReadString('\n')
+				if err == io.EOF {
+					partialLine += line
+					break
+				} else if err != nil {
+					return err
+				}
+				lines = append(lines, strings.TrimSuffix(partialLine+line, "\n"))
+				offset += int64(len(partialLine) + len(line))
+				partialLine = ""
+			}
+			if isStopped && partialLine != "" {
+				lines = append(lines, partialLine)
+				offset += int64(len(partialLine))
+			}
+			if err := sendLines(lines, start, offset); err != nil {
+				return err
+			}
+		}
+		if isStopped {
+			break
+		}
+		select {
+		case <-stop:
+			isStopped = true
+		case <-time.After(flushInterval):
+		}
+	}
+
+	if !isObjectiveMetricReported {
+		// Offset is greater than the offset of the file lines, the report is idempotent, since the file is completed.
+		return send(newObservationLog(nil, metrics).MetricLogs, offset+1)
+	}
+	return nil
+}
+
+// resumeFromCheckpoint restores the state of the followed file from the checkpoint and returns the offset to follow the file from.
+// Lines before the last sent lines are parsed without sending to restore the parser state, e.g. the CSV header.
+// The last sent lines are sent again, since they might be not reported.
+func resumeFromCheckpoint(reader *bufio.Reader, checkpointFile string,
+	parseLines func([]string) ([]*v1beta1.MetricLog, error), sendLines func([]string, int64, int64) error) (int64, error) {
+	start, end, err := loadCheckpoint(checkpointFile)
+	if err != nil || end == 0 {
+		return 0, err
+	}
+	klog.Infof("Metrics are reported again from the offset %d of the metrics file", start)
+	readLines := func(size int64) ([]string, error) {
+		if size == 0 {
+			return nil, nil
+		}
+		content := make([]byte, size)
+		if _, err := io.ReadFull(reader, content); err != nil {
+			return nil, fmt.Errorf("failed to read metrics file until the checkpoint offset %d: %v", end, err)
+		}
+		return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n"), nil
+	}
+	lines, err := readLines(start)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = parseLines(lines); err != nil {
+		return 0, err
+	}
+	if lines, err = readLines(end - start); err != nil {
+		return 0, err
+	}
+	return end, sendLines(lines, start, end)
+}
+
+// loadCheckpoint returns offsets of the last sent lines from the checkpoint file, offsets are zero if the file doesn't exist.
+func loadCheckpoint(checkpointFile string) (int64, int64, error) {
+	if checkpointFile == "" {
+		return 0, 0, nil
+	}
+	content, err := ioutil.ReadFile(checkpointFile)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	} else if err != nil {
+		return 0, 0, err
+	}
+	var start, end int64
+	if _, err = fmt.Sscanf(string(content), "%d %d", &start, &end); err != nil || start < 0 || end < start {
+		return 0, 0, fmt.Errorf("invalid metrics checkpoint file %v: %q", checkpointFile, content)
+	}
+	return start, end, nil
+}
+
+// saveCheckpoint saves offsets of the sent lines to the checkpoint file, the file is replaced atomically.
+func saveCheckpoint(checkpointFile string, start, end int64) error {
+	if checkpointFile == "" {
+		return nil
+	}
+	tmpFile := checkpointFile + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, []byte(fmt.Sprintf("%d %d\n", start, end)), 0644); err != nil {
+		return fmt.Errorf("failed to save metrics checkpoint: %v", err)
+	}
+	if err := os.Rename(tmpFile, checkpointFile); err != nil {
+		return fmt.Errorf("failed to save metrics checkpoint: %v", err)
+	}
+	return nil
+}
+
+// MetricLogParser parses metric logs from lines of the metrics file.
+// Lines must be passed in the file order, since the header row of CSV file is parsed once.
+type MetricLogParser struct {
+	metrics    []string
+	filters    []string
+	fileFormat commonv1beta1.FileFormat
+	// timestampKey is the key of the timestamp in JSON, CSV and LOGFMT formats.
+	timestampKey string
+	// csvHeader contains column names of the CSV file.
+	csvHeader []string
+}
+
+// NewMetricLogParser creates MetricLogParser for the metrics in the fileFormat, filters are used only in TEXT format.
+// In JSON format, metric names and the timestampKey can be GJSON paths, e.g. "metrics.val.acc".
+// If timestampKey is empty, the timestamp is parsed from the "timestamp" key.
+func NewMetricLogParser(metrics []string, filters []string, fileFormat commonv1beta1.FileFormat, timestampKey string) *MetricLogParser {
+	if timestampKey == "" {
+		timestampKey = common.TimeStampJsonKey
+	}
+	return &MetricLogParser{
+		metrics:      metrics,
+		filters:      filters,
+		fileFormat:   fileFormat,
+		timestampKey: timestampKey,
+	}
+}
+
+// Parse returns metric logs of the lines.
+func (p *MetricLogParser) Parse(logs []string) ([]*v1beta1.MetricLog, error) {
+	switch p.fileFormat {
 	case commonv1beta1.TextFormat:
-		return parseLogsInTextFormat(strings.Split(logs, "\n"), metrics, filters)
+		return parseLogsInTextFormat(logs, p.metrics, p.filters)
 	case commonv1beta1.JsonFormat:
-		return parseLogsInJsonFormat(strings.Split(logs, "\n"), metrics)
+		return parseLogsInJsonFormat(logs, p.metrics, p.timestampKey)
+	case commonv1beta1.CsvFormat:
+		return p.parseLogsInCsvFormat(logs)
+	case commonv1beta1.LogfmtFormat:
+		return parseLogsInLogfmtFormat(logs, p.metrics, p.timestampKey)
 	}
-	return nil, fmt.Errorf("format must be set %v or %v", commonv1beta1.TextFormat, commonv1beta1.JsonFormat)
+	return nil, fmt.Errorf("format must be set %v, %v, %v or %v",
+		commonv1beta1.TextFormat, commonv1beta1.JsonFormat, commonv1beta1.CsvFormat, commonv1beta1.LogfmtFormat)
 }
 
-func parseLogsInTextFormat(logs []string, metrics []string, filters []string) (*v1beta1.ObservationLog, error) {
+func parseLogsInTextFormat(logs []string, metrics []string, filters []string) ([]*v1beta1.MetricLog, error) {
 	metricRegList := GetFilterRegexpList(filters)
 	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
 
@@ -84,6 +295,9 @@ func parseLogsInTextFormat(logs []string, metrics []string, filters []string) (*
 			}
 		}
 
+		// Step is printed in the same line as metrics, e.g. "epoch=2 loss=0.3".
+		var lineLogs []*v1beta1.MetricLog
+		stepPairs := make(map[string]string)
 		for _, metricReg := range metricRegList {
 			matchStrs := metricReg.FindAllStringSubmatch(logline, -1)
 			for _, kevList := range matchStrs {
@@ -92,11 +306,14 @@ func parseLogsInTextFormat(logs []string, metrics []string, filters []string) (*
 				}
 				name := strings.TrimSpace(kevList[1])
 				value := strings.TrimSpace(kevList[2])
+				if name == common.StepKey || name == common.EpochKey {
+					stepPairs[name] = value
+				}
 				for _, m := range metrics {
 					if name != m {
 						continue
 					}
-					mlogs = append(mlogs, &v1beta1.MetricLog{
+					lineLogs = append(lineLogs, &v1beta1.MetricLog{
 						TimeStamp: timestamp,
 						Metric: &v1beta1.Metric{
 							Name:  name,
@@ -107,36 +324,49 @@ func parseLogsInTextFormat(logs []string, metrics []string, filters []string) (*
 				}
 			}
 		}
+		step := parseStep(stepPairs)
+		for _, mlog := range lineLogs {
+			mlog.Step = step
+		}
+		mlogs = append(mlogs, lineLogs...)
 	}
-	return newObservationLog(mlogs, metrics), nil
+	return mlogs, nil
 }
 
-func parseLogsInJsonFormat(logs []string, metrics []string) (*v1beta1.ObservationLog, error) {
+func parseLogsInJsonFormat(logs []string, metrics []string, timestampKey string) ([]*v1beta1.MetricLog, error) {
 	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
 
 	for _, logline := range logs {
 		if len(logline) == 0 {
 			continue
 		}
-		var jsonObj map[string]interface{}
-		if err := json.Unmarshal([]byte(logline), &jsonObj); err != nil {
-			return nil, err
+		jsonObj := gjson.Parse(logline)
+		if !gjson.Valid(logline) || !jsonObj.IsObject() {
+			return nil, fmt.Errorf("failed to parse %v line %s: invalid JSON object", commonv1beta1.JsonFormat, logline)
 		}
 
 		timestamp := time.Time{}.UTC().Format(time.RFC3339)
-		timestampJsonValue, exist := jsonObj[common.TimeStampJsonKey]
-		if !exist {
-			klog.Warningf("Metrics will not have timestamp since %s doesn't have the key timestamp", logline)
+		timestampJsonValue := getJsonValue(jsonObj, timestampKey)
+		if !timestampJsonValue.Exists() {
+			klog.Warningf("Metrics will not have timestamp since %s doesn't have the key %s", logline, timestampKey)
 		} else {
-			if parsedTimestamp := parseTimestamp(timestampJsonValue); parsedTimestamp == "" {
+			if parsedTimestamp := parseTimestamp(timestampJsonValue.Value()); parsedTimestamp == "" {
 				klog.Warningf("Metrics will not have timestamp since error parsing time %v", timestampJsonValue)
 			} else {
 				timestamp = parsedTimestamp
 			}
 		}
 
+		stepPairs := make(map[string]string)
+		for _, key := range []string{common.StepKey, common.EpochKey} {
+			if value, ok := getJsonMetricValue(jsonObj, key); ok {
+				stepPairs[key] = value
+			}
+		}
+		step := parseStep(stepPairs)
+
 		for _, m := range metrics {
-			value, exist := jsonObj[m].(string)
+			value, exist := getJsonMetricValue(jsonObj, m)
 			if !exist {
 				continue
 			}
@@ -146,10 +376,170 @@ func parseLogsInJsonFormat(logs []string, metrics []string) (*v1beta1.Observatio
 					Name:  m,
 					Value: value,
 				},
+				Step: step,
 			})
 		}
 	}
-	return newObservationLog(mlogs, metrics), nil
+	return mlogs, nil
+}
+
+// getJsonValue returns the value of the top-level key, e.g. "val.acc" for {"val.acc": 0.9}.
+// If the object doesn't have such key, the key is used as GJSON path, e.g. "val.acc" for {"val": {"acc": 0.9}}.
+func getJsonValue(jsonObj gjson.Result, key string) gjson.Result {
+	if value, exist := jsonObj.Map()[key]; exist {
+		return value
+	}
+	return jsonObj.Get(key)
+}
+
+// getJsonMetricValue returns the metric value of the JSON string, number or boolean.
+// Numbers are returned as they are written, e.g. "1e-3".
+func getJsonMetricValue(jsonObj gjson.Result, key string) (string, bool) {
+	value := getJsonValue(jsonObj, key)
+	switch value.Type {
+	case gjson.String:
+		return value.Str, true
+	case gjson.Number:
+		return value.Raw, true
+	case gjson.True, gjson.False:
+		return strconv.FormatBool(value.Bool()), true
+	}
+	return "", false
+}
+
+func (p *MetricLogParser) parseLogsInCsvFormat(logs []string) ([]*v1beta1.MetricLog, error) {
+	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
+
+	for _, logline := range logs {
+		if len(strings.TrimSpace(logline)) == 0 {
+			continue
+		}
+		record, err := csv.NewReader(strings.NewReader(logline)).Read()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %v line %s: %v", commonv1beta1.CsvFormat, logline, err)
+		}
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+
+		// The first line is the header row, it is written again if training is restarted.
+		if p.csvHeader == nil {
+			p.csvHeader = record
+			continue
+		}
+		if reflect.DeepEqual(record, p.csvHeader) {
+			continue
+		}
+		if len(record) != len(p.csvHeader) {
+			return nil, fmt.Errorf("failed to parse %v line %s: expected %d columns, got %d", commonv1beta1.CsvFormat, logline, len(p.csvHeader), len(record))
+		}
+
+		row := make(map[string]string, len(record))
+		for i, column := range p.csvHeader {
+			row[column] = record[i]
+		}
+		mlogs = append(mlogs, newMetricLogsFromPairs(row, p.metrics, p.timestampKey, logline)...)
+	}
+	return mlogs, nil
+}
+
+func parseLogsInLogfmtFormat(logs []string, metrics []string, timestampKey string) ([]*v1beta1.MetricLog, error) {
+	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
+
+	for _, logline := range logs {
+		if len(strings.TrimSpace(logline)) == 0 {
+			continue
+		}
+		pairs, err := parseLogfmt(logline)
+		if err != nil {
+			// Training output can contain other lines, so they are skipped.
+			klog.Warningf("Line %s is skipped since it is not in %v format: %v", logline, commonv1beta1.LogfmtFormat, err)
+			continue
+		}
+		mlogs = append(mlogs, newMetricLogsFromPairs(pairs, metrics, timestampKey, logline)...)
+	}
+	return mlogs, nil
+}
+
+// newMetricLogsFromPairs returns metric logs of the metrics which are found in the key-value pairs of the line.
+// Metric logs have timestamp and step if the pairs have the timestamp and step keys.
+func newMetricLogsFromPairs(pairs map[string]string, metrics []string, timestampKey string, logline string) []*v1beta1.MetricLog {
+	var mlogs []*v1beta1.MetricLog
+	timestamp := ""
+	step := ""
+	for _, m := range metrics {
+		value := pairs[m]
+		if value == "" {
+			continue
+		}
+		if timestamp == "" {
+			timestamp = time.Time{}.UTC().Format(time.RFC3339)
+			if timestampValue, exist := pairs[timestampKey]; !exist {
+				klog.Warningf("Metrics will not have timestamp since %s doesn't have the key %s", logline, timestampKey)
+			} else if parsedTimestamp := parseTimestampString(timestampValue); parsedTimestamp == "" {
+				klog.Warningf("Metrics will not have timestamp since error parsing time %v", timestampValue)
+			} else {
+				timestamp = parsedTimestamp
+			}
+			step = parseStep(pairs)
+		}
+		mlogs = append(mlogs, &v1beta1.MetricLog{
+			TimeStamp: timestamp,
+			Metric: &v1beta1.Metric{
+				Name:  m,
+				Value: value,
+			},
+			Step: step,
+		})
+	}
+	return mlogs
+}
+
+// parseLogfmt returns key=value pairs of the line, values with spaces must be double-quoted.
+// Keys without value, e.g. words of the plain text, have empty values.
+func parseLogfmt(line string) (map[string]string, error) {
+	pairs := make(map[string]string)
+	for i := 0; i < len(line); {
+		if line[i] == ' ' || line[i] == '\t' {
+			i++
+			continue
+		}
+		keyStart := i
+		for i < len(line) && line[i] != '=' && line[i] != ' ' && line[i] != '\t' {
+			i++
+		}
+		key := line[keyStart:i]
+		if i == len(line) || line[i] != '=' {
+			pairs[key] = ""
+			continue
+		}
+		// Skip "=".
+		i++
+		if i < len(line) && line[i] == '"' {
+			valueStart := i
+			for i++; i < len(line) && line[i] != '"'; i++ {
+				if line[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(line) {
+				return nil, fmt.Errorf("unterminated quoted value of the key %s", key)
+			}
+			i++
+			value, err := strconv.Unquote(line[valueStart:i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted value of the key %s: %v", key, err)
+			}
+			pairs[key] = value
+			continue
+		}
+		valueStart := i
+		for i < len(line) && line[i] != ' ' && line[i] != '\t' {
+			i++
+		}
+		pairs[key] = line[valueStart:i]
+	}
+	return pairs, nil
 }
 
 func newObservationLog(mlogs []*v1beta1.MetricLog, metrics []string) *v1beta1.ObservationLog {
@@ -224,6 +614,35 @@ func parseTimestamp(timestamp interface{}) string {
 	}
 }
 
+// parseStep returns the step of metrics from the step or epoch value of the pairs, step has priority over epoch.
+// Metrics don't have step if the value is not an integer.
+func parseStep(pairs map[string]string) string {
+	for _, key := range []string{common.StepKey, common.EpochKey} {
+		value, exist := pairs[key]
+		if !exist {
+			continue
+		}
+		if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return strconv.FormatInt(intValue, 10)
+		}
+		// JSON numbers and some frameworks print integer value as float, e.g. "2.0".
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil && floatValue == math.Trunc(floatValue) &&
+			math.Abs(floatValue) < math.MaxInt64 {
+			return strconv.FormatInt(int64(floatValue), 10)
+		}
+		klog.Warningf("Metrics will not have %s since %s is not an integer", key, value)
+	}
+	return ""
+}
+
+// parseTimestampString parses timestamp of CSV and LOGFMT formats, it can be RFC3339Nano string or Unix time.
+func parseTimestampString(timestamp string) string {
+	if floatTimestamp, err := strconv.ParseFloat(timestamp, 64); err == nil {
+		return parseTimestamp(floatTimestamp)
+	}
+	return parseTimestamp(timestamp)
+}
+
 // GetFilterRegexpList returns Regexp array from filters string array
 func GetFilterRegexpList(filters []string) []*regexp.Regexp {
 	regexpList := make([]*regexp.Regexp, 0, len(filters))
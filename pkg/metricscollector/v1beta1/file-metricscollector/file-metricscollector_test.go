@@ -17,9 +17,11 @@ limitations under the License.
 package sidecarmetricscollector
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,7 +30,11 @@ import (
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 )
 
-var testJsonDataPath = filepath.Join("testdata", "JSON")
+var (
+	testJsonDataPath   = filepath.Join("testdata", "JSON")
+	testCsvDataPath    = filepath.Join("testdata", "CSV")
+	testLogfmtDataPath = filepath.Join("testdata", "LOGFMT")
+)
 
 func TestCollectObservationLog(t *testing.T) {
 
@@ -40,13 +46,14 @@ func TestCollectObservationLog(t *testing.T) {
 	// TODO (tenzen-y): We should add tests for logs in TEXT format.
 	// Ref: https://github.com/kubeflow/katib/issues/1756
 	testCases := []struct {
-		description string
-		filePath    string
-		metrics     []string
-		filters     []string
-		fileFormat  commonv1beta1.FileFormat
-		err         bool
-		expected    *v1beta1.ObservationLog
+		description  string
+		filePath     string
+		metrics      []string
+		filters      []string
+		fileFormat   commonv1beta1.FileFormat
+		timestampKey string
+		err          bool
+		expected     *v1beta1.ObservationLog
 	}{
 		{
 			description: "Positive case for logs in JSON format",
@@ -93,6 +100,142 @@ func TestCollectObservationLog(t *testing.T) {
 				},
 			},
 		},
+		{
+			description:  "Typed values and nested keys for logs in JSON format",
+			filePath:     filepath.Join(testJsonDataPath, "typed-values.json"),
+			metrics:      []string{"loss", "metrics.val.acc", "metrics.converged"},
+			fileFormat:   commonv1beta1.JsonFormat,
+			timestampKey: "meta.time",
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T05:27:27.000028721Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.12",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T05:27:27.000028721Z",
+						Metric: &v1beta1.Metric{
+							Name:  "metrics.val.acc",
+							Value: "9e-1",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T05:27:27.000028721Z",
+						Metric: &v1beta1.Metric{
+							Name:  "metrics.converged",
+							Value: "false",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.1",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "metrics.val.acc",
+							Value: "0.95",
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Positive case for logs in CSV format",
+			filePath:    filepath.Join(testCsvDataPath, "good.csv"),
+			metrics:     []string{"acc", "loss"},
+			fileFormat:  commonv1beta1.CsvFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T05:27:27.000028721Z",
+						Metric: &v1beta1.Metric{
+							Name:  "acc",
+							Value: "0.9349666833877563",
+						},
+						Step: "0",
+					},
+					{
+						TimeStamp: "2021-12-02T05:27:27.000028721Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.22082142531871796",
+						},
+						Step: "0",
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50.000035161Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.1414974331855774",
+						},
+						Step: "1",
+					},
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric: &v1beta1.Metric{
+							Name:  "acc",
+							Value: "0.9586416482925415",
+						},
+						Step: "2",
+					},
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.10683439671993256",
+						},
+						Step: "2",
+					},
+				},
+			},
+		},
+		{
+			description: "Invalid number of columns for logs in CSV format",
+			filePath:    filepath.Join(testCsvDataPath, "invalid-columns.csv"),
+			metrics:     []string{"acc", "loss"},
+			fileFormat:  commonv1beta1.CsvFormat,
+			err:         true,
+		},
+		{
+			description: "Positive case for logs in LOGFMT format",
+			filePath:    filepath.Join(testLogfmtDataPath, "good.log"),
+			metrics:     []string{"acc", "loss"},
+			fileFormat:  commonv1beta1.LogfmtFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "acc",
+							Value: "0.9349666833877563",
+						},
+						Step: "1",
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.22082142531871796",
+						},
+						Step: "1",
+					},
+					{
+						TimeStamp: "2021-12-02T05:27:27Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.1414974331855774",
+						},
+					},
+				},
+			},
+		},
 		{
 			description: "Invalid file name",
 			filePath:    "invalid",
@@ -155,7 +298,7 @@ func TestCollectObservationLog(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.description, func(t *testing.T) {
-			actual, err := CollectObservationLog(test.filePath, test.metrics, test.filters, test.fileFormat)
+			actual, err := CollectObservationLog(test.filePath, test.metrics, test.filters, test.fileFormat, test.timestampKey)
 			if (err != nil) != test.err {
 				t.Errorf("\nGOT: \n%v\nWANT: %v\n", err, test.err)
 			} else {
@@ -167,19 +310,230 @@ func TestCollectObservationLog(t *testing.T) {
 	}
 }
 
-func generateTestFiles() error {
-	if _, err := os.Stat(testJsonDataPath); err != nil {
-		if err = os.MkdirAll(testJsonDataPath, 0700); err != nil {
-			return err
-		}
+func TestFollowObservationLog(t *testing.T) {
+	testCases := []struct {
+		description string
+		lines       []string
+		moreLines   string
+		expected    []string
+	}{
+		{
+			description: "Metrics are sent while file is written",
+			lines:       []string{"2021-12-02T14:27:50Z epoch 1: loss=0.5\n"},
+			// Last line is not completed, it is sent once following is stopped.
+			moreLines: "2021-12-02T14:27:51Z epoch 2: loss=0.4\n2021-12-02T14:27:51Z acc=0.8",
+			expected:  []string{"loss=0.5", "loss=0.4", "acc=0.8"},
+		},
+		{
+			description: "Unavailable objective metric is sent",
+			lines:       []string{"2021-12-02T14:27:50Z epoch 1: acc=0.9\n"},
+			expected:    []string{"acc=0.9", "loss=" + consts.UnavailableMetricValue},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.description, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "metrics.log")
+			if err := os.WriteFile(filePath, []byte(strings.Join(test.lines, "")), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			sent := make(chan []*v1beta1.MetricLog, 10)
+			stop := make(chan struct{})
+			done := make(chan error, 1)
+			go func() {
+				done <- FollowObservationLog(filePath, []string{"loss", "acc"}, nil, commonv1beta1.TextFormat, "", 10*time.Millisecond, "", stop,
+					func(mlogs []*v1beta1.MetricLog, offset int64) error {
+						sent <- mlogs
+						return nil
+					})
+			}()
+
+			// Metrics of the completed lines are sent before following is stopped.
+			actual := []string{}
+			for _, mlog := range <-sent {
+				actual = append(actual, mlog.Metric.Name+"="+mlog.Metric.Value)
+			}
+			file, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = file.WriteString(test.moreLines); err != nil {
+				t.Fatal(err)
+			}
+			file.Close()
+			close(stop)
+
+			if err = <-done; err != nil {
+				t.Fatalf("FollowObservationLog failed: %v", err)
+			}
+			close(sent)
+			for mlogs := range sent {
+				for _, mlog := range mlogs {
+					actual = append(actual, mlog.Metric.Name+"="+mlog.Metric.Value)
+				}
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("Expected %v\n got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFollowObservationLogCheckpoint(t *testing.T) {
+	lines := []string{
+		"timestamp,loss\n",
+		"2021-12-02T14:27:50Z,0.5\n",
+		"2021-12-02T14:27:51Z,0.4\n",
+		"2021-12-02T14:27:52Z,0.3\n",
+	}
+	// Lines until the end of the third line were sent before the collector is restarted.
+	start := int64(len(lines[0]) + len(lines[1]))
+	end := start + int64(len(lines[2]))
+	total := end + int64(len(lines[3]))
+
+	testCases := []struct {
+		description        string
+		checkpoint         string
+		expected           []string
+		expectedCheckpoint string
+		err                bool
+	}{
+		{
+			description:        "Last sent lines are sent again with the same offset",
+			checkpoint:         fmt.Sprintf("%d %d\n", start, end),
+			expected:           []string{fmt.Sprintf("loss=0.4@%d", end), fmt.Sprintf("loss=0.3@%d", total)},
+			expectedCheckpoint: fmt.Sprintf("%d %d\n", end, total),
+		},
+		{
+			description:        "File is followed from the beginning without checkpoint",
+			expected:           []string{fmt.Sprintf("loss=0.5,loss=0.4,loss=0.3@%d", total)},
+			expectedCheckpoint: fmt.Sprintf("%d %d\n", 0, total),
+		},
+		{
+			description: "Checkpoint is beyond the end of the file",
+			checkpoint:  fmt.Sprintf("%d %d\n", end, total+1),
+			err:         true,
+		},
+		{
+			description: "Invalid checkpoint",
+			checkpoint:  "invalid",
+			err:         true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.description, func(t *testing.T) {
+			dir := t.TempDir()
+			filePath := filepath.Join(dir, "metrics.csv")
+			if err := os.WriteFile(filePath, []byte(strings.Join(lines, "")), 0600); err != nil {
+				t.Fatal(err)
+			}
+			checkpointPath := filepath.Join(dir, "metrics.checkpoint")
+			if test.checkpoint != "" {
+				if err := os.WriteFile(checkpointPath, []byte(test.checkpoint), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// Following is stopped, so the whole file is parsed at once.
+			stop := make(chan struct{})
+			close(stop)
+			actual := []string{}
+			err := FollowObservationLog(filePath, []string{"loss"}, nil, commonv1beta1.CsvFormat, "", time.Hour, checkpointPath, stop,
+				func(mlogs []*v1beta1.MetricLog, offset int64) error {
+					var sent []string
+					for _, mlog := range mlogs {
+						sent = append(sent, mlog.Metric.Name+"="+mlog.Metric.Value)
+					}
+					actual = append(actual, fmt.Sprintf("%s@%d", strings.Join(sent, ","), offset))
+					return nil
+				})
+			if test.err {
+				if err == nil {
+					t.Errorf("Expected error, got sent logs %v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FollowObservationLog failed: %v", err)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("Expected %v\n got %v", test.expected, actual)
+			}
+			checkpoint, err := os.ReadFile(checkpointPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(checkpoint) != test.expectedCheckpoint {
+				t.Errorf("Expected checkpoint %q, got %q", test.expectedCheckpoint, checkpoint)
+			}
+		})
 	}
+}
 
+func TestMetricLogParserStep(t *testing.T) {
+	testCases := []struct {
+		description string
+		fileFormat  commonv1beta1.FileFormat
+		logs        []string
+		expected    []string
+	}{
+		{
+			description: "Step is parsed from the line in TEXT format",
+			fileFormat:  commonv1beta1.TextFormat,
+			logs: []string{
+				"2021-12-02T14:27:50Z epoch=1 loss=0.5",
+				"2021-12-02T14:27:51Z epoch=1 step=150 loss=0.4",
+				"2021-12-02T14:27:52Z loss=0.3",
+			},
+			expected: []string{"1", "150", ""},
+		},
+		{
+			description: "Step is parsed from the string and number values in JSON format",
+			fileFormat:  commonv1beta1.JsonFormat,
+			logs: []string{
+				`{"epoch": 1, "loss": "0.5"}`,
+				`{"epoch": "2", "step": 300.0, "loss": "0.4"}`,
+				`{"step": "last", "loss": "0.3"}`,
+			},
+			expected: []string{"1", "300", ""},
+		},
+		{
+			description: "Step is parsed from the pairs in LOGFMT format",
+			fileFormat:  commonv1beta1.LogfmtFormat,
+			logs: []string{
+				"step=10 loss=0.5",
+				"step=1e3 epoch=2 loss=0.4",
+			},
+			expected: []string{"10", "1000"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.description, func(t *testing.T) {
+			mlogs, err := NewMetricLogParser([]string{"loss"}, nil, test.fileFormat, "").Parse(test.logs)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			actual := []string{}
+			for _, mlog := range mlogs {
+				actual = append(actual, mlog.Step)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("Expected steps %v\n got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func generateTestFiles() error {
 	testData := []struct {
 		fileName string
 		data     string
 	}{
 		{
-			fileName: "good.json",
+			fileName: filepath.Join(testJsonDataPath, "good.json"),
 			data: `{"checkpoint_path": "", "global_step": "0", "loss": "0.22082142531871796", "timestamp": 1638422847.28721, "trial": "0"}
 {"acc": "0.9349666833877563", "checkpoint_path": "", "global_step": "0", "timestamp": 1638422847.287801, "trial": "0"}
 {"checkpoint_path": "", "global_step": "1", "loss": "0.1414974331855774", "timestamp": "2021-12-02T14:27:50.000035161Z", "trial": "0"}
@@ -188,27 +542,58 @@ func generateTestFiles() error {
 `,
 		},
 		{
-			fileName: "invalid-format.json",
+			fileName: filepath.Join(testJsonDataPath, "invalid-format.json"),
 			data: `"checkpoint_path": "", "global_step": "0", "loss": "0.22082142531871796", "timestamp": 1638422847.28721, "trial": "0"
 {"acc": "0.9349666833877563", "checkpoint_path": "", "global_step": "0", "timestamp": 1638422847.287801, "trial": "0
 `,
 		},
 		{
-			fileName: "invalid-timestamp.json",
+			fileName: filepath.Join(testJsonDataPath, "invalid-timestamp.json"),
 			data: `{"checkpoint_path": "", "global_step": "0", "loss": "0.22082142531871796", "timestamp": "invalid", "trial": "0"}
 {"acc": "0.9349666833877563", "checkpoint_path": "", "global_step": "0", "timestamp": 1638422847, "trial": "0"}
 `,
 		}, {
-			fileName: "missing-objective-metric.json",
+			fileName: filepath.Join(testJsonDataPath, "missing-objective-metric.json"),
 			data: `{"checkpoint_path": "", "global_step": "0", "loss": "0.22082142531871796", "timestamp": 1638422847.28721, "trial": "0"}
 {"checkpoint_path": "", "global_step": "1", "loss": "0.1414974331855774", "timestamp": "2021-12-02T14:27:50.000035161+09:00", "trial": "0"}
 {"checkpoint_path": "", "global_step": "2", "loss": "0.10683439671993256", "trial": "0"}`,
 		},
+		{
+			fileName: filepath.Join(testJsonDataPath, "typed-values.json"),
+			data: `{"loss": 0.12, "metrics": {"val": {"acc": 9e-1}, "converged": false, "lr": null}, "meta": {"time": 1638422847.28721}}
+{"loss": "0.1", "metrics": {"val": {"acc": 0.95}, "converged": [true]}, "meta": {"time": "2021-12-02T14:27:50Z"}}
+`,
+		},
+		{
+			fileName: filepath.Join(testCsvDataPath, "good.csv"),
+			data: `epoch,timestamp,acc,loss
+0,1638422847.28721,0.9349666833877563,0.22082142531871796
+1,2021-12-02T14:27:50.000035161Z,,0.1414974331855774
+epoch,timestamp,acc,loss
+2,,0.9586416482925415,"0.10683439671993256"
+`,
+		},
+		{
+			fileName: filepath.Join(testCsvDataPath, "invalid-columns.csv"),
+			data: `epoch,timestamp,acc,loss
+0,1638422847.28721,0.9349666833877563
+`,
+		},
+		{
+			fileName: filepath.Join(testLogfmtDataPath, "good.log"),
+			data: `Epoch 1 started
+timestamp=2021-12-02T14:27:50Z epoch=1 acc=0.9349666833877563 loss=0.22082142531871796
+msg="validation is completed" loss=0.1414974331855774 timestamp=1638422847
+level=info msg="unterminated acc=0.95
+`,
+		},
 	}
 
 	for _, td := range testData {
-		filePath := filepath.Join(testJsonDataPath, td.fileName)
-		if err := os.WriteFile(filePath, []byte(td.data), 0600); err != nil {
+		if err := os.MkdirAll(filepath.Dir(td.fileName), 0700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(td.fileName, []byte(td.data), 0600); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podlogsmetricscollector
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"k8s.io/klog"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
+)
+
+// Follower parses metrics from the container logs in TEXT format while they are streamed.
+// It keeps the offset of the reported logs, so logs can be followed again from the offset once the stream is broken.
+type Follower struct {
+	metrics []string
+	parser  *filemc.MetricLogParser
+	// offset is the number of bytes of the logs which are reported.
+	offset int64
+	// partialLine is the last line of the logs which is not completed yet.
+	partialLine               string
+	isObjectiveMetricReported bool
+}
+
+// NewFollower creates Follower for the metrics, the first metric is the objective metric.
+// Filters are the same as filters of the StdOut metrics collector.
+func NewFollower(metrics []string, filters []string) *Follower {
+	return &Follower{
+		metrics: metrics,
+		parser:  filemc.NewMetricLogParser(metrics, filters, commonv1beta1.TextFormat, ""),
+	}
+}
+
+// Offset returns the number of bytes of the logs which are reported.
+func (f *Follower) Offset() int64 {
+	return f.offset
+}
+
+// Follow reads logs from the stream which starts from the beginning of the container logs and passes new metric logs
+// to send every flushInterval with the logs offset after the last parsed line. Logs before the follower offset are skipped.
+// It returns once the stream is finished, the last line which is not completed is kept until Finish is called.
+func (f *Follower) Follow(logs io.Reader, flushInterval time.Duration, send func([]*v1beta1.MetricLog, int64) error) error {
+	reader := bufio.NewReader(logs)
+	// Logs before the offset are already reported.
+	if _, err := io.CopyN(ioutil.Discard, reader, f.offset); err != nil {
+		return err
+	}
+	f.partialLine = ""
+
+	lines := make(chan string)
+	readErr := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(lines)
+		for {
+			line, err := reader.ReadString('\n')
+			if line != "" {
+				select {
+				case lines <- line:
+				case <-done:
+					return
+				}
+			}
+			if err != nil {
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	var batch []string
+	end := f.offset
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := f.sendLines(batch, end, send)
+		batch = nil
+		return err
+	}
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				if err := flush(); err != nil {
+					return err
+				}
+				if err := <-readErr; err != io.EOF {
+					return err
+				}
+				return nil
+			}
+			if !strings.HasSuffix(line, "\n") {
+				f.partialLine = line
+				continue
+			}
+			batch = append(batch, strings.TrimSuffix(line, "\n"))
+			end += int64(len(line))
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Finish passes metric logs of the last line which is not completed to send, once the container is terminated.
+// If objective metric is not found in the logs, the unavailable value is sent.
+func (f *Follower) Finish(send func([]*v1beta1.MetricLog, int64) error) error {
+	if f.partialLine != "" {
+		if err := f.sendLines([]string{f.partialLine}, f.offset+int64(len(f.partialLine)), send); err != nil {
+			return err
+		}
+		f.partialLine = ""
+	}
+	if f.isObjectiveMetricReported {
+		return nil
+	}
+	klog.Infof("Objective metric %v is not found in training logs, %v value is reported", f.metrics[0], consts.UnavailableMetricValue)
+	// Offset is greater than the offset of the logs, the report is idempotent, since the container is terminated.
+	return send([]*v1beta1.MetricLog{
+		{
+			TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+			Metric: &v1beta1.Metric{
+				Name:  f.metrics[0],
+				Value: consts.UnavailableMetricValue,
+			},
+		},
+	}, f.offset+1)
+}
+
+// sendLines passes metric logs of the lines to send and moves the offset to the end of the lines once they are sent.
+func (f *Follower) sendLines(lines []string, end int64, send func([]*v1beta1.MetricLog, int64) error) error {
+	mlogs, err := f.parser.Parse(lines)
+	if err != nil {
+		return err
+	}
+	if len(mlogs) != 0 {
+		if err = send(mlogs, end); err != nil {
+			return err
+		}
+	}
+	for _, mlog := range mlogs {
+		if mlog.Metric.Name == f.metrics[0] {
+			f.isObjectiveMetricReported = true
+		}
+	}
+	f.offset = end
+	return nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podlogsmetricscollector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func TestFollower(t *testing.T) {
+	lines := []string{
+		"2021-12-02T14:27:50Z epoch 1: loss=0.5\n",
+		"2021-12-02T14:27:51Z epoch 1: acc=0.8\n",
+		"2021-12-02T14:27:52Z epoch 2: loss=0.4",
+	}
+	completedSize := int64(len(lines[0]) + len(lines[1]))
+	totalSize := completedSize + int64(len(lines[2]))
+
+	var sent []string
+	var sendErr error
+	send := func(mlogs []*v1beta1.MetricLog, offset int64) error {
+		if sendErr != nil {
+			return sendErr
+		}
+		var metrics []string
+		for _, mlog := range mlogs {
+			metrics = append(metrics, mlog.Metric.Name+"="+mlog.Metric.Value)
+		}
+		sent = append(sent, fmt.Sprintf("%s@%d", strings.Join(metrics, ","), offset))
+		return nil
+	}
+
+	follower := NewFollower([]string{"loss", "acc"}, nil)
+
+	// Offset is not moved if logs are not sent.
+	sendErr = errors.New("failed to send")
+	if err := follower.Follow(strings.NewReader(strings.Join(lines, "")), time.Hour, send); err != sendErr {
+		t.Fatalf("Follow returns error %v, expected %v", err, sendErr)
+	}
+	if follower.Offset() != 0 {
+		t.Errorf("Offset is %d after failed send, expected 0", follower.Offset())
+	}
+
+	// Completed lines are sent once the stream is finished.
+	sendErr = nil
+	if err := follower.Follow(strings.NewReader(strings.Join(lines[:2], "")), time.Hour, send); err != nil {
+		t.Fatalf("Follow failed: %v", err)
+	}
+	// Stream is broken, logs which are already reported are skipped once logs are followed again.
+	if err := follower.Follow(strings.NewReader(strings.Join(lines, "")), time.Hour, send); err != nil {
+		t.Fatalf("Follow failed: %v", err)
+	}
+	if follower.Offset() != completedSize {
+		t.Errorf("Offset is %d, expected %d", follower.Offset(), completedSize)
+	}
+	// Last line is sent once the container is terminated.
+	if err := follower.Finish(send); err != nil {
+		t.Fatalf("Finish failed: %v", err)
+	}
+
+	expected := []string{
+		fmt.Sprintf("loss=0.5,acc=0.8@%d", completedSize),
+		fmt.Sprintf("loss=0.4@%d", totalSize),
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Errorf("Expected %v\n got %v", expected, sent)
+	}
+}
+
+func TestFollowerUnavailableObjective(t *testing.T) {
+	logs := "2021-12-02T14:27:50Z epoch 1: acc=0.8\n"
+	var sent []string
+	send := func(mlogs []*v1beta1.MetricLog, offset int64) error {
+		for _, mlog := range mlogs {
+			sent = append(sent, fmt.Sprintf("%s=%s@%d", mlog.Metric.Name, mlog.Metric.Value, offset))
+		}
+		return nil
+	}
+
+	follower := NewFollower([]string{"loss", "acc"}, nil)
+	if err := follower.Follow(strings.NewReader(logs), time.Hour, send); err != nil {
+		t.Fatalf("Follow failed: %v", err)
+	}
+	if err := follower.Finish(send); err != nil {
+		t.Fatalf("Finish failed: %v", err)
+	}
+
+	expected := []string{
+		fmt.Sprintf("acc=0.8@%d", len(logs)),
+		fmt.Sprintf("loss=%s@%d", consts.UnavailableMetricValue, len(logs)+1),
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Errorf("Expected %v\n got %v", expected, sent)
+	}
+}
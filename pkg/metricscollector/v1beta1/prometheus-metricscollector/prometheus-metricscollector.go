@@ -0,0 +1,238 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheusmetricscollector
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const acceptHeader = `text/plain;version=0.0.4;q=1,*/*;q=0.1`
+
+type sample struct {
+	value       float64
+	timestampMs int64
+}
+
+// Scraper collects metrics from the Prometheus endpoint of the training container.
+type Scraper struct {
+	url     string
+	metrics []string
+	client  *http.Client
+	// lastSamples contains the last reported sample for each metric.
+	lastSamples map[string]sample
+}
+
+// NewScraper creates the Scraper for the endpoint url, metrics are Katib metric names and the first one is objective.
+func NewScraper(url string, metrics []string, timeout time.Duration) *Scraper {
+	return &Scraper{
+		url:         url,
+		metrics:     metrics,
+		client:      &http.Client{Timeout: timeout},
+		lastSamples: make(map[string]sample),
+	}
+}
+
+// Scrape fetches the endpoint and returns metric logs of the metrics which are changed since the previous scrape.
+func (s *Scraper) Scrape(ctx context.Context) ([]*v1beta1.MetricLog, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", acceptHeader)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scrape %s returned status %s", s.url, resp.Status)
+	}
+	families, err := ParseMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	scrapeTime := time.Now()
+	var mlogs []*v1beta1.MetricLog
+	for _, metric := range s.metrics {
+		smpl, ok := findSample(families, metric)
+		if !ok {
+			continue
+		}
+		if smpl.timestampMs == 0 {
+			// Timestamp is set by scrape time, so only changed value is reported.
+			if last, reported := s.lastSamples[metric]; reported && last.value == smpl.value {
+				continue
+			}
+			smpl.timestampMs = scrapeTime.UnixNano() / int64(time.Millisecond)
+		} else if last, reported := s.lastSamples[metric]; reported && last == smpl {
+			continue
+		}
+		s.lastSamples[metric] = smpl
+		mlogs = append(mlogs, &v1beta1.MetricLog{
+			TimeStamp: time.Unix(0, smpl.timestampMs*int64(time.Millisecond)).UTC().Format(time.RFC3339Nano),
+			Metric: &v1beta1.Metric{
+				Name:  metric,
+				Value: strconv.FormatFloat(smpl.value, 'f', -1, 64),
+			},
+		})
+	}
+	return mlogs, nil
+}
+
+// FollowObservationLog scrapes the endpoint every interval until stop is closed and passes new metric logs to send.
+// Scrape errors are logged since the endpoint is not served before training is started and after it is completed.
+// If objective metric is never scraped, the unavailable value is sent.
+func FollowObservationLog(s *Scraper, interval time.Duration, stop <-chan struct{}, send func([]*v1beta1.MetricLog) error) error {
+	isObjectiveMetricReported := false
+	scrape := func() error {
+		mlogs, err := s.Scrape(context.Background())
+		if err != nil {
+			klog.Infof("Failed to scrape metrics: %v", err)
+			return nil
+		}
+		if len(mlogs) == 0 {
+			return nil
+		}
+		for _, mlog := range mlogs {
+			if mlog.Metric.Name == s.metrics[0] {
+				isObjectiveMetricReported = true
+			}
+		}
+		return send(mlogs)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for stopped := false; !stopped; {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			// Last scrape gets metrics reported after the previous one, if the endpoint is still served.
+			stopped = true
+		}
+		if err := scrape(); err != nil {
+			return err
+		}
+	}
+
+	if !isObjectiveMetricReported {
+		klog.Infof("Objective metric %v is not found in scraped metrics, %v value is reported", s.metrics[0], consts.UnavailableMetricValue)
+		return send([]*v1beta1.MetricLog{
+			{
+				TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+				Metric: &v1beta1.Metric{
+					Name:  s.metrics[0],
+					Value: consts.UnavailableMetricValue,
+				},
+			},
+		})
+	}
+	return nil
+}
+
+// ParseMetricFamilies parses metrics in the Prometheus text exposition format.
+func ParseMetricFamilies(r io.Reader) (map[string]*dto.MetricFamily, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %v", err)
+	}
+	return families, nil
+}
+
+// findSample returns the sample of the metric.
+// Gauge, counter and untyped metrics are found by name, summary and histogram by name with _sum or _count suffix.
+func findSample(families map[string]*dto.MetricFamily, name string) (sample, bool) {
+	if family, ok := families[name]; ok {
+		metric, ok := singleMetric(family)
+		if !ok {
+			return sample{}, false
+		}
+		var value float64
+		switch family.GetType() {
+		case dto.MetricType_GAUGE:
+			value = metric.GetGauge().GetValue()
+		case dto.MetricType_COUNTER:
+			value = metric.GetCounter().GetValue()
+		case dto.MetricType_UNTYPED:
+			value = metric.GetUntyped().GetValue()
+		default:
+			klog.Warningf("Metric %v has type %v, use %v_sum or %v_count name instead", name, family.GetType(), name, name)
+			return sample{}, false
+		}
+		return newSample(value, metric.GetTimestampMs())
+	}
+
+	for _, suffix := range []string{"_sum", "_count"} {
+		family, ok := families[strings.TrimSuffix(name, suffix)]
+		if !strings.HasSuffix(name, suffix) || !ok {
+			continue
+		}
+		metric, ok := singleMetric(family)
+		if !ok {
+			return sample{}, false
+		}
+		switch {
+		case family.GetType() == dto.MetricType_SUMMARY && suffix == "_sum":
+			return newSample(metric.GetSummary().GetSampleSum(), metric.GetTimestampMs())
+		case family.GetType() == dto.MetricType_SUMMARY:
+			return newSample(float64(metric.GetSummary().GetSampleCount()), metric.GetTimestampMs())
+		case family.GetType() == dto.MetricType_HISTOGRAM && suffix == "_sum":
+			return newSample(metric.GetHistogram().GetSampleSum(), metric.GetTimestampMs())
+		case family.GetType() == dto.MetricType_HISTOGRAM:
+			return newSample(float64(metric.GetHistogram().GetSampleCount()), metric.GetTimestampMs())
+		}
+	}
+	return sample{}, false
+}
+
+// singleMetric returns the series of the metric family.
+// If family has several series with different labels, only the series without labels can be collected.
+func singleMetric(family *dto.MetricFamily) (*dto.Metric, bool) {
+	if len(family.GetMetric()) == 1 {
+		return family.GetMetric()[0], true
+	}
+	for _, metric := range family.GetMetric() {
+		if len(metric.GetLabel()) == 0 {
+			return metric, true
+		}
+	}
+	klog.Warningf("Metric %v has %d series with labels, it can't be collected", family.GetName(), len(family.GetMetric()))
+	return nil, false
+}
+
+func newSample(value float64, timestampMs int64) (sample, bool) {
+	if math.IsNaN(value) {
+		return sample{}, false
+	}
+	return sample{value: value, timestampMs: timestampMs}, true
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheusmetricscollector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// testEndpoint serves metrics which can be changed by the test.
+type testEndpoint struct {
+	mu      sync.Mutex
+	metrics string
+}
+
+func (e *testEndpoint) set(metrics string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.metrics = metrics
+}
+
+func (e *testEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	fmt.Fprint(w, e.metrics)
+}
+
+func TestScrape(t *testing.T) {
+	endpoint := &testEndpoint{}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	testCases := []struct {
+		description string
+		metrics     string
+		expected    []string
+	}{
+		{
+			description: "Gauge, counter, summary and timestamped metrics are scraped",
+			metrics: `# TYPE accuracy gauge
+accuracy 0.5
+# TYPE epochs_total counter
+epochs_total 1
+# TYPE step_seconds summary
+step_seconds{quantile="0.5"} 0.2
+step_seconds_sum 1.5
+step_seconds_count 7
+loss 0.8 1640995200000
+`,
+			expected: []string{"accuracy=0.5", "loss=0.8@2022-01-01T00:00:00Z", "epochs_total=1", "step_seconds_sum=1.5", "step_seconds_count=7"},
+		},
+		{
+			description: "Only changed metrics are scraped",
+			metrics: `# TYPE accuracy gauge
+accuracy 0.6
+# TYPE epochs_total counter
+epochs_total 1
+loss 0.8 1640995200000
+`,
+			expected: []string{"accuracy=0.6"},
+		},
+		{
+			description: "Metric with new timestamp is scraped",
+			metrics: `accuracy 0.6
+loss 0.8 1640995260000
+`,
+			expected: []string{"loss=0.8@2022-01-01T00:01:00Z"},
+		},
+		{
+			description: "Metric with several labeled series is not scraped",
+			metrics: `accuracy{split="train"} 0.9
+accuracy{split="test"} 0.7
+`,
+			expected: nil,
+		},
+	}
+
+	s := NewScraper(server.URL, []string{"accuracy", "loss", "epochs_total", "step_seconds_sum", "step_seconds_count"}, time.Second)
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			endpoint.set(tc.metrics)
+			mlogs, err := s.Scrape(context.Background())
+			if err != nil {
+				t.Fatalf("Scrape failed: %v", err)
+			}
+			var actual []string
+			for _, mlog := range mlogs {
+				actual = append(actual, formatMetricLog(mlog))
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected %v\n got %v", tc.expected, actual)
+			}
+		})
+	}
+
+	t.Run("Invalid metrics format", func(t *testing.T) {
+		endpoint.set("accuracy 0.5 invalid\n")
+		if _, err := s.Scrape(context.Background()); err == nil {
+			t.Errorf("Expected error for invalid metrics format")
+		}
+	})
+}
+
+func TestFollowObservationLog(t *testing.T) {
+	testCases := []struct {
+		description string
+		metrics     string
+		expected    []string
+	}{
+		{
+			description: "Metrics are scraped until stop",
+			metrics:     "accuracy 0.9 1640995200000\n",
+			expected:    []string{"accuracy=0.9@2022-01-01T00:00:00Z"},
+		},
+		{
+			description: "Unavailable objective metric is sent",
+			metrics:     "loss 0.1 1640995200000\n",
+			expected:    []string{"loss=0.1@2022-01-01T00:00:00Z", "accuracy=" + consts.UnavailableMetricValue + "@0001-01-01T00:00:00Z"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			endpoint := &testEndpoint{metrics: tc.metrics}
+			server := httptest.NewServer(endpoint)
+			defer server.Close()
+
+			var actual []string
+			stop := make(chan struct{})
+			close(stop)
+			s := NewScraper(server.URL, []string{"accuracy", "loss"}, time.Second)
+			err := FollowObservationLog(s, time.Hour, stop, func(mlogs []*v1beta1.MetricLog) error {
+				for _, mlog := range mlogs {
+					actual = append(actual, formatMetricLog(mlog))
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("FollowObservationLog failed: %v", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected %v\n got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// formatMetricLog formats the metric log, timestamp is omitted if it is set by scrape time.
+func formatMetricLog(mlog *v1beta1.MetricLog) string {
+	timestamp, _ := time.Parse(time.RFC3339Nano, mlog.TimeStamp)
+	if time.Since(timestamp) < time.Minute {
+		return mlog.Metric.Name + "=" + mlog.Metric.Value
+	}
+	return mlog.Metric.Name + "=" + mlog.Metric.Value + "@" + mlog.TimeStamp
+}
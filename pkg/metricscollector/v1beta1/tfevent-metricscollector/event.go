@@ -0,0 +1,249 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tfeventmetricscollector
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of tensorflow.Event, tensorflow.Summary and tensorflow.TensorProto messages.
+// Only fields which are required to get scalar summaries are decoded, other fields are skipped.
+const (
+	eventWallTimeField = 1
+	eventStepField     = 2
+	eventSummaryField  = 5
+
+	summaryValueField = 1
+
+	valueTagField         = 1
+	valueSimpleValueField = 2
+	valueTensorField      = 8
+
+	tensorDtypeField    = 1
+	tensorContentField  = 4
+	tensorFloatValField = 5
+	tensorDoubleField   = 6
+	tensorIntValField   = 7
+	tensorInt64ValField = 10
+)
+
+// Data types of tensorflow.TensorProto which are converted to scalars.
+const (
+	dtFloat  = 1
+	dtDouble = 2
+	dtInt32  = 3
+	dtInt64  = 9
+)
+
+// Event is the TensorFlow event with scalar summaries.
+type Event struct {
+	WallTime float64
+	Step     int64
+	Scalars  []Scalar
+}
+
+// Scalar is the scalar summary value.
+type Scalar struct {
+	Tag   string
+	Value float64
+	// BitSize is 32 for float values, so they are formatted without float64 rounding noise.
+	BitSize int
+}
+
+// ParseEvent decodes tensorflow.Event from the record data.
+// Summary values which are not scalars, e.g. images or histograms, are skipped.
+func ParseEvent(data []byte) (*Event, error) {
+	event := &Event{}
+	err := parseMessage(data, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == eventWallTimeField && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			event.WallTime = math.Float64frombits(v)
+			return n, nil
+		case num == eventStepField && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			event.Step = int64(v)
+			return n, nil
+		case num == eventSummaryField && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return n, nil
+			}
+			scalars, err := parseSummary(v)
+			event.Scalars = append(event.Scalars, scalars...)
+			return n, err
+		}
+		return protowire.ConsumeFieldValue(num, typ, b), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse event: %v", err)
+	}
+	return event, nil
+}
+
+func parseSummary(data []byte) ([]Scalar, error) {
+	var scalars []Scalar
+	err := parseMessage(data, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		if num != summaryValueField || typ != protowire.BytesType {
+			return protowire.ConsumeFieldValue(num, typ, b), nil
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return n, nil
+		}
+		scalar, ok, err := parseSummaryValue(v)
+		if ok {
+			scalars = append(scalars, scalar)
+		}
+		return n, err
+	})
+	return scalars, err
+}
+
+func parseSummaryValue(data []byte) (Scalar, bool, error) {
+	scalar := Scalar{}
+	ok := false
+	err := parseMessage(data, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == valueTagField && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			scalar.Tag = v
+			return n, nil
+		case num == valueSimpleValueField && typ == protowire.Fixed32Type:
+			// TensorFlow 1.x writes scalars as simple_value.
+			v, n := protowire.ConsumeFixed32(b)
+			scalar.Value, scalar.BitSize, ok = float64(math.Float32frombits(v)), 32, true
+			return n, nil
+		case num == valueTensorField && typ == protowire.BytesType:
+			// TensorFlow 2.x writes scalars as tensors.
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return n, nil
+			}
+			var err error
+			scalar.Value, scalar.BitSize, ok, err = parseScalarTensor(v)
+			return n, err
+		}
+		return protowire.ConsumeFieldValue(num, typ, b), nil
+	})
+	return scalar, ok, err
+}
+
+// parseScalarTensor returns the first value of the numeric tensor.
+func parseScalarTensor(data []byte) (float64, int, bool, error) {
+	var dtype uint64
+	var content []byte
+	var values []float64
+	err := parseMessage(data, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == tensorDtypeField && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			dtype = v
+			return n, nil
+		case num == tensorContentField && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			content = v
+			return n, nil
+		case num == tensorFloatValField || num == tensorDoubleField || num == tensorIntValField || num == tensorInt64ValField:
+			return consumeRepeatedNumbers(num, typ, b, &values), nil
+		}
+		return protowire.ConsumeFieldValue(num, typ, b), nil
+	})
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	bitSize := 64
+	if dtype == dtFloat {
+		bitSize = 32
+	}
+	if len(values) > 0 {
+		return values[0], bitSize, true, nil
+	}
+	switch {
+	case dtype == dtFloat && len(content) >= 4:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(content))), bitSize, true, nil
+	case dtype == dtDouble && len(content) >= 8:
+		return math.Float64frombits(binary.LittleEndian.Uint64(content)), bitSize, true, nil
+	case dtype == dtInt32 && len(content) >= 4:
+		return float64(int32(binary.LittleEndian.Uint32(content))), bitSize, true, nil
+	case dtype == dtInt64 && len(content) >= 8:
+		return float64(int64(binary.LittleEndian.Uint64(content))), bitSize, true, nil
+	}
+	return 0, 0, false, nil
+}
+
+// consumeRepeatedNumbers appends packed or unpacked values of the repeated numeric tensor field.
+func consumeRepeatedNumbers(num protowire.Number, typ protowire.Type, b []byte, values *[]float64) int {
+	consumeOne := func(b []byte) int {
+		switch num {
+		case tensorFloatValField:
+			v, n := protowire.ConsumeFixed32(b)
+			*values = append(*values, float64(math.Float32frombits(v)))
+			return n
+		case tensorDoubleField:
+			v, n := protowire.ConsumeFixed64(b)
+			*values = append(*values, math.Float64frombits(v))
+			return n
+		case tensorIntValField:
+			v, n := protowire.ConsumeVarint(b)
+			*values = append(*values, float64(int32(v)))
+			return n
+		default:
+			v, n := protowire.ConsumeVarint(b)
+			*values = append(*values, float64(int64(v)))
+			return n
+		}
+	}
+	if typ != protowire.BytesType {
+		return consumeOne(b)
+	}
+	packed, n := protowire.ConsumeBytes(b)
+	for len(packed) > 0 {
+		m := consumeOne(packed)
+		if m < 0 {
+			return m
+		}
+		packed = packed[m:]
+	}
+	return n
+}
+
+// parseMessage calls consumeField for every field of the protobuf message.
+// consumeField returns the length of the field value or negative protowire error code.
+func parseMessage(data []byte, consumeField func(num protowire.Number, typ protowire.Type, b []byte) (int, error)) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		n, err := consumeField(num, typ, data)
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+	return nil
+}
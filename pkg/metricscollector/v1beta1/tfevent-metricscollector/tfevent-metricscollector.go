@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tfeventmetricscollector
+
+import (
+	"io"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// Follower reads scalar summaries of the metrics from event files in the directory.
+// When the event file is under a directory (e.g. test dir), the metric name must be "{{dirname}}/{{metric name}}".
+type Follower struct {
+	dir     string
+	metrics []string
+	// offsets contains the position after the last complete record for each event file.
+	offsets map[string]int64
+	// corrupted contains event files which are not read anymore because of checksum mismatch.
+	corrupted map[string]bool
+}
+
+// NewFollower creates the Follower for event files in the dir, metrics are Katib metric names and the first one is objective.
+func NewFollower(dir string, metrics []string) *Follower {
+	return &Follower{
+		dir:       dir,
+		metrics:   metrics,
+		offsets:   make(map[string]int64),
+		corrupted: make(map[string]bool),
+	}
+}
+
+// Poll returns metric logs of the records which are written to event files since the previous poll.
+// The incomplete record at the end of the file is read by the next poll.
+func (f *Follower) Poll() ([]*v1beta1.MetricLog, error) {
+	// Directory is created by the training container, so it may not exist yet.
+	if _, err := os.Stat(f.dir); os.IsNotExist(err) {
+		return nil, nil
+	}
+	var files []string
+	err := filepath.Walk(f.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() && !f.corrupted[path] {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var mlogs []*v1beta1.MetricLog
+	for _, file := range files {
+		fileLogs, err := f.readFile(file)
+		if err != nil {
+			klog.Warningf("Event file %v is skipped: %v", file, err)
+			f.corrupted[file] = true
+		}
+		mlogs = append(mlogs, fileLogs...)
+	}
+	return mlogs, nil
+}
+
+func (f *Follower) readFile(file string) ([]*v1beta1.MetricLog, error) {
+	fd, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	offset := f.offsets[file]
+	if _, err = fd.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var mlogs []*v1beta1.MetricLog
+	rr := NewRecordReader(fd)
+	for {
+		data, err := rr.Next()
+		if err == io.EOF || err == ErrTruncatedRecord {
+			break
+		}
+		if err != nil {
+			return mlogs, err
+		}
+		f.offsets[file] = offset + rr.Offset()
+		event, err := ParseEvent(data)
+		if err != nil {
+			return mlogs, err
+		}
+		mlogs = append(mlogs, f.eventMetricLogs(filepath.Dir(file), event)...)
+	}
+	return mlogs, nil
+}
+
+// eventMetricLogs returns metric logs of the event scalars which match the metrics.
+func (f *Follower) eventMetricLogs(fileDir string, event *Event) []*v1beta1.MetricLog {
+	var mlogs []*v1beta1.MetricLog
+	sec, frac := math.Modf(event.WallTime)
+	timestamp := time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC().Format(time.RFC3339Nano)
+	for _, scalar := range event.Scalars {
+		for _, metric := range f.metrics {
+			if !matchScalar(metric, fileDir, scalar.Tag) {
+				continue
+			}
+			mlogs = append(mlogs, &v1beta1.MetricLog{
+				TimeStamp: timestamp,
+				Metric: &v1beta1.Metric{
+					Name:  metric,
+					Value: strconv.FormatFloat(scalar.Value, 'f', -1, scalar.BitSize),
+				},
+				Step: strconv.FormatInt(event.Step, 10),
+			})
+		}
+	}
+	return mlogs
+}
+
+// matchScalar returns true if the tag starts with the metric name and
+// the event file directory ends with the metric directory, if it is set.
+func matchScalar(metric, fileDir, tag string) bool {
+	if !strings.HasPrefix(tag, path.Base(metric)) {
+		return false
+	}
+	if !strings.Contains(metric, "/") {
+		return true
+	}
+	return strings.HasSuffix(filepath.ToSlash(fileDir), path.Dir(metric))
+}
+
+// CollectObservationLog parses event files in the dir and returns the ObservationLog of the metrics.
+// If objective metric is not found, the unavailable value is reported.
+func CollectObservationLog(dir string, metrics []string) (*v1beta1.ObservationLog, error) {
+	mlogs, err := NewFollower(dir, metrics).Poll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Metrics logs must contain at least one objective metric value.
+	// Objective metric is located at first index.
+	for _, mlog := range mlogs {
+		if mlog.Metric.Name == metrics[0] {
+			return &v1beta1.ObservationLog{MetricLogs: mlogs}, nil
+		}
+	}
+	// If objective metrics were not reported, insert unavailable value in the DB.
+	klog.Infof("Objective metric %v is not found in event files, %v value is reported", metrics[0], consts.UnavailableMetricValue)
+	return &v1beta1.ObservationLog{
+		MetricLogs: []*v1beta1.MetricLog{
+			{
+				TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+				Metric: &v1beta1.Metric{
+					Name:  metrics[0],
+					Value: consts.UnavailableMetricValue,
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tfeventmetricscollector
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// testScalar is the scalar summary which is written as simple_value or as float tensor.
+type testScalar struct {
+	tag    string
+	value  float32
+	tensor bool
+}
+
+func encodeRecord(data []byte) []byte {
+	record := make([]byte, recordHeaderSize, recordHeaderSize+len(data)+recordFooterSize)
+	binary.LittleEndian.PutUint64(record, uint64(len(data)))
+	binary.LittleEndian.PutUint32(record[8:], maskedCRC(record[:8]))
+	record = append(record, data...)
+	footer := make([]byte, recordFooterSize)
+	binary.LittleEndian.PutUint32(footer, maskedCRC(data))
+	return append(record, footer...)
+}
+
+func encodeEvent(wallTime float64, step int64, scalars ...testScalar) []byte {
+	var summary []byte
+	for _, s := range scalars {
+		var value []byte
+		value = protowire.AppendTag(value, valueTagField, protowire.BytesType)
+		value = protowire.AppendString(value, s.tag)
+		if s.tensor {
+			var tensor []byte
+			tensor = protowire.AppendTag(tensor, tensorDtypeField, protowire.VarintType)
+			tensor = protowire.AppendVarint(tensor, dtFloat)
+			tensor = protowire.AppendTag(tensor, tensorContentField, protowire.BytesType)
+			content := make([]byte, 4)
+			binary.LittleEndian.PutUint32(content, math.Float32bits(s.value))
+			tensor = protowire.AppendBytes(tensor, content)
+			value = protowire.AppendTag(value, valueTensorField, protowire.BytesType)
+			value = protowire.AppendBytes(value, tensor)
+		} else {
+			value = protowire.AppendTag(value, valueSimpleValueField, protowire.Fixed32Type)
+			value = protowire.AppendFixed32(value, math.Float32bits(s.value))
+		}
+		summary = protowire.AppendTag(summary, summaryValueField, protowire.BytesType)
+		summary = protowire.AppendBytes(summary, value)
+	}
+
+	var event []byte
+	event = protowire.AppendTag(event, eventWallTimeField, protowire.Fixed64Type)
+	event = protowire.AppendFixed64(event, math.Float64bits(wallTime))
+	event = protowire.AppendTag(event, eventStepField, protowire.VarintType)
+	event = protowire.AppendVarint(event, uint64(step))
+	event = protowire.AppendTag(event, eventSummaryField, protowire.BytesType)
+	return protowire.AppendBytes(event, summary)
+}
+
+func TestRecordReader(t *testing.T) {
+	first := encodeRecord([]byte("first"))
+	second := encodeRecord([]byte("second"))
+	corrupted := encodeRecord([]byte("corrupted"))
+	corrupted[len(corrupted)-1]++
+
+	testCases := []struct {
+		description    string
+		data           []byte
+		expected       []string
+		expectedOffset int64
+		expectedErr    error
+		err            bool
+	}{
+		{
+			description:    "All records are read",
+			data:           append(append([]byte{}, first...), second...),
+			expected:       []string{"first", "second"},
+			expectedOffset: int64(len(first) + len(second)),
+			expectedErr:    io.EOF,
+		},
+		{
+			description:    "Truncated record is not read",
+			data:           append(append([]byte{}, first...), second[:len(second)-2]...),
+			expected:       []string{"first"},
+			expectedOffset: int64(len(first)),
+			expectedErr:    ErrTruncatedRecord,
+		},
+		{
+			description:    "Truncated header is not read",
+			data:           append(append([]byte{}, first...), second[:4]...),
+			expected:       []string{"first"},
+			expectedOffset: int64(len(first)),
+			expectedErr:    ErrTruncatedRecord,
+		},
+		{
+			description:    "Record with data checksum mismatch",
+			data:           append(append([]byte{}, first...), corrupted...),
+			expected:       []string{"first"},
+			expectedOffset: int64(len(first)),
+			err:            true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			rr := NewRecordReader(bytes.NewReader(tc.data))
+			var actual []string
+			var err error
+			for {
+				var data []byte
+				if data, err = rr.Next(); err != nil {
+					break
+				}
+				actual = append(actual, string(data))
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected records %v\n got %v", tc.expected, actual)
+			}
+			if tc.err {
+				if err == io.EOF || err == ErrTruncatedRecord {
+					t.Errorf("Expected checksum error, got %v", err)
+				}
+			} else if err != tc.expectedErr {
+				t.Errorf("Expected error %v, got %v", tc.expectedErr, err)
+			}
+			if rr.Offset() != tc.expectedOffset {
+				t.Errorf("Expected offset %d, got %d", tc.expectedOffset, rr.Offset())
+			}
+		})
+	}
+}
+
+func TestCollectObservationLog(t *testing.T) {
+	dir := t.TempDir()
+	writeEventFile(t, filepath.Join(dir, "train", "events.out.tfevents.1"),
+		encodeEvent(1640995200, 1, testScalar{tag: "loss", value: 0.5}),
+		encodeEvent(1640995200.5, 2, testScalar{tag: "loss", value: 0.25}, testScalar{tag: "accuracy", value: 0.75}),
+	)
+	writeEventFile(t, filepath.Join(dir, "test", "events.out.tfevents.1"),
+		encodeEvent(1640995201, 2, testScalar{tag: "accuracy", value: 0.7, tensor: true}),
+	)
+	// Not event files are skipped.
+	if err := ioutil.WriteFile(filepath.Join(dir, "checkpoint"), []byte(`model_checkpoint_path: "model.ckpt-2"`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		description string
+		metrics     []string
+		expected    *v1beta1.ObservationLog
+	}{
+		{
+			description: "Metrics are collected from event files in directories",
+			metrics:     []string{"test/accuracy", "train/loss"},
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					newMetricLog("2022-01-01T00:00:01Z", "test/accuracy", "0.7", "2"),
+					newMetricLog("2022-01-01T00:00:00Z", "train/loss", "0.5", "1"),
+					newMetricLog("2022-01-01T00:00:00.5Z", "train/loss", "0.25", "2"),
+				},
+			},
+		},
+		{
+			description: "Metric without directory is collected from all event files",
+			metrics:     []string{"accuracy"},
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					newMetricLog("2022-01-01T00:00:01Z", "accuracy", "0.7", "2"),
+					newMetricLog("2022-01-01T00:00:00.5Z", "accuracy", "0.75", "2"),
+				},
+			},
+		},
+		{
+			description: "Objective metric is not found",
+			metrics:     []string{"f1", "train/loss"},
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					newMetricLog("0001-01-01T00:00:00Z", "f1", consts.UnavailableMetricValue, ""),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			actual, err := CollectObservationLog(dir, tc.metrics)
+			if err != nil {
+				t.Fatalf("CollectObservationLog failed: %v", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected %v\n got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFollowerPoll(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "events.out.tfevents.1")
+	first := encodeRecord(encodeEvent(1640995200, 1, testScalar{tag: "accuracy", value: 0.5}))
+	second := encodeRecord(encodeEvent(1640995260, 2, testScalar{tag: "accuracy", value: 0.6}))
+
+	follower := NewFollower(dir, []string{"accuracy"})
+	poll := func(data []byte) []*v1beta1.MetricLog {
+		fd, err := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = fd.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		fd.Close()
+		mlogs, err := follower.Poll()
+		if err != nil {
+			t.Fatalf("Poll failed: %v", err)
+		}
+		return mlogs
+	}
+
+	expected := []*v1beta1.MetricLog{newMetricLog("2022-01-01T00:00:00Z", "accuracy", "0.5", "1")}
+	if actual := poll(append(append([]byte{}, first...), second[:10]...)); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v\n got %v", expected, actual)
+	}
+	expected = []*v1beta1.MetricLog{newMetricLog("2022-01-01T00:01:00Z", "accuracy", "0.6", "2")}
+	if actual := poll(second[10:]); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v\n got %v", expected, actual)
+	}
+	if actual := poll(nil); len(actual) != 0 {
+		t.Errorf("Expected no new metric logs, got %v", actual)
+	}
+}
+
+func writeEventFile(t *testing.T, file string, events ...[]byte) {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	var data []byte
+	for _, event := range events {
+		data = append(data, encodeRecord(event)...)
+	}
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newMetricLog(timestamp, name, value, step string) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		TimeStamp: timestamp,
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+		Step: step,
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tfeventmetricscollector
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+const (
+	// TFRecord header contains uint64 length of the data and masked crc32c of the length.
+	recordHeaderSize = 12
+	// TFRecord footer contains masked crc32c of the data.
+	recordFooterSize = 4
+	// maxRecordSize limits size of the record, bigger records are treated as corrupted.
+	maxRecordSize = 1 << 30
+
+	crcMaskDelta = 0xa282ead8
+)
+
+// ErrTruncatedRecord is returned if the file ends in the middle of the record, e.g. the record is being written.
+var ErrTruncatedRecord = errors.New("truncated TFRecord")
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// maskedCRC returns crc32c checksum masked in the same way as TensorFlow does.
+func maskedCRC(data []byte) uint32 {
+	crc := crc32.Checksum(data, crc32c)
+	return ((crc >> 15) | (crc << 17)) + crcMaskDelta
+}
+
+// RecordReader reads records of the TFRecord file.
+type RecordReader struct {
+	r io.Reader
+	// offset is the position after the last complete record.
+	offset int64
+}
+
+// NewRecordReader creates RecordReader which reads records from r.
+func NewRecordReader(r io.Reader) *RecordReader {
+	return &RecordReader{r: r}
+}
+
+// Offset returns the number of bytes read by complete records.
+func (rr *RecordReader) Offset() int64 {
+	return rr.offset
+}
+
+// Next returns data of the next record.
+// It returns io.EOF if there are no more records and ErrTruncatedRecord if the last record is incomplete.
+func (rr *RecordReader) Next() ([]byte, error) {
+	header := make([]byte, recordHeaderSize)
+	if n, err := io.ReadFull(rr.r, header); err != nil {
+		if err == io.EOF && n == 0 {
+			return nil, io.EOF
+		}
+		if err == io.ErrUnexpectedEOF {
+			return nil, ErrTruncatedRecord
+		}
+		return nil, err
+	}
+	length := binary.LittleEndian.Uint64(header[:8])
+	if crc := binary.LittleEndian.Uint32(header[8:]); crc != maskedCRC(header[:8]) {
+		return nil, fmt.Errorf("corrupted TFRecord at offset %d: length checksum mismatch", rr.offset)
+	}
+	if length > maxRecordSize {
+		return nil, fmt.Errorf("corrupted TFRecord at offset %d: record length %d is too big", rr.offset, length)
+	}
+
+	data := make([]byte, int(length)+recordFooterSize)
+	if _, err := io.ReadFull(rr.r, data); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, ErrTruncatedRecord
+		}
+		return nil, err
+	}
+	footer := data[length:]
+	data = data[:length]
+	if crc := binary.LittleEndian.Uint32(footer); crc != maskedCRC(data) {
+		return nil, fmt.Errorf("corrupted TFRecord at offset %d: data checksum mismatch", rr.offset)
+	}
+	rr.offset += recordHeaderSize + int64(length) + recordFooterSize
+	return data, nil
+}
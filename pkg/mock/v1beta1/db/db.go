@@ -6,9 +6,11 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/db/v1beta1/common"
 )
 
 // MockKatibDBInterface is a mock of KatibDBInterface interface.
@@ -47,46 +49,136 @@ func (mr *MockKatibDBInterfaceMockRecorder) DBInit() *gomock.Call {
 }
 
 // DeleteObservationLog mocks base method.
-func (m *MockKatibDBInterface) DeleteObservationLog(arg0 string) error {
+func (m *MockKatibDBInterface) DeleteObservationLog(arg0, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteObservationLog", arg0)
+	ret := m.ctrl.Call(m, "DeleteObservationLog", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteObservationLog indicates an expected call of DeleteObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) DeleteObservationLog(arg0 interface{}) *gomock.Call {
+func (mr *MockKatibDBInterfaceMockRecorder) DeleteObservationLog(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteObservationLog), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteObservationLog), arg0, arg1)
+}
+
+// DeleteOwnerObservationLog mocks base method.
+func (m *MockKatibDBInterface) DeleteOwnerObservationLog(arg0 common.ObservationLogOwner) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOwnerObservationLog", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOwnerObservationLog indicates an expected call of DeleteOwnerObservationLog.
+func (mr *MockKatibDBInterfaceMockRecorder) DeleteOwnerObservationLog(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOwnerObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteOwnerObservationLog), arg0)
+}
+
+// DeleteOwnerObservationLogBefore mocks base method.
+func (m *MockKatibDBInterface) DeleteOwnerObservationLogBefore(arg0 common.ObservationLogOwner, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOwnerObservationLogBefore", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOwnerObservationLogBefore indicates an expected call of DeleteOwnerObservationLogBefore.
+func (mr *MockKatibDBInterfaceMockRecorder) DeleteOwnerObservationLogBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOwnerObservationLogBefore", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteOwnerObservationLogBefore), arg0, arg1)
 }
 
 // GetObservationLog mocks base method.
-func (m *MockKatibDBInterface) GetObservationLog(arg0, arg1, arg2, arg3 string) (*api_v1_beta1.ObservationLog, error) {
+func (m *MockKatibDBInterface) GetObservationLog(arg0, arg1, arg2, arg3, arg4, arg5, arg6 string, arg7 bool) (*api_v1_beta1.ObservationLog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetObservationLog", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetObservationLog", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].(*api_v1_beta1.ObservationLog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetObservationLog indicates an expected call of GetObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// GetObservationLogOwners mocks base method.
+func (m *MockKatibDBInterface) GetObservationLogOwners() ([]common.ObservationLogOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObservationLogOwners")
+	ret0, _ := ret[0].([]common.ObservationLogOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObservationLogOwners indicates an expected call of GetObservationLogOwners.
+func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLogOwners() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLogOwners", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLogOwners))
+}
+
+// GetObservationLogs mocks base method.
+func (m *MockKatibDBInterface) GetObservationLogs(arg0 string, arg1, arg2 []string, arg3, arg4 string) (map[string]*api_v1_beta1.ObservationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObservationLogs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(map[string]*api_v1_beta1.ObservationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObservationLogs indicates an expected call of GetObservationLogs.
+func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLogs(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLogs", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLogs), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetObservationSummary mocks base method.
+func (m *MockKatibDBInterface) GetObservationSummary(arg0, arg1 string, arg2 []string) ([]*api_v1_beta1.MetricSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObservationSummary", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*api_v1_beta1.MetricSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObservationSummary indicates an expected call of GetObservationSummary.
+func (mr *MockKatibDBInterfaceMockRecorder) GetObservationSummary(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationSummary", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationSummary), arg0, arg1, arg2)
 }
 
 // RegisterObservationLog mocks base method.
-func (m *MockKatibDBInterface) RegisterObservationLog(arg0 string, arg1 *api_v1_beta1.ObservationLog) error {
+func (m *MockKatibDBInterface) RegisterObservationLog(arg0, arg1, arg2, arg3 string, arg4 *api_v1_beta1.ObservationLog) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterObservationLog", arg0, arg1)
+	ret := m.ctrl.Call(m, "RegisterObservationLog", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RegisterObservationLog indicates an expected call of RegisterObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) RegisterObservationLog(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockKatibDBInterfaceMockRecorder) RegisterObservationLog(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterObservationLog), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RegisterObservationLogWithOffset mocks base method.
+func (m *MockKatibDBInterface) RegisterObservationLogWithOffset(arg0, arg1, arg2, arg3, arg4 string, arg5 int64, arg6 *api_v1_beta1.ObservationLog) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterObservationLogWithOffset", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterObservationLogWithOffset indicates an expected call of RegisterObservationLogWithOffset.
+func (mr *MockKatibDBInterfaceMockRecorder) RegisterObservationLogWithOffset(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterObservationLog), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObservationLogWithOffset", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterObservationLogWithOffset), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
 // SelectOne mocks base method.
@@ -50,17 +50,32 @@ func (mr *MockManagerClientMockRecorder) DeleteTrialObservationLog(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrialObservationLog", reflect.TypeOf((*MockManagerClient)(nil).DeleteTrialObservationLog), arg0)
 }
 
-// GetTrialObservationLog mocks base method.
-func (m *MockManagerClient) GetTrialObservationLog(arg0 *v1beta1.Trial) (*api_v1_beta1.GetObservationLogReply, error) {
+// GetTrialObservationSummary mocks base method.
+func (m *MockManagerClient) GetTrialObservationSummary(arg0 *v1beta1.Trial) (*api_v1_beta1.GetObservationSummaryReply, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrialObservationLog", arg0)
-	ret0, _ := ret[0].(*api_v1_beta1.GetObservationLogReply)
+	ret := m.ctrl.Call(m, "GetTrialObservationSummary", arg0)
+	ret0, _ := ret[0].(*api_v1_beta1.GetObservationSummaryReply)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTrialObservationLog indicates an expected call of GetTrialObservationLog.
-func (mr *MockManagerClientMockRecorder) GetTrialObservationLog(arg0 interface{}) *gomock.Call {
+// GetTrialObservationSummary indicates an expected call of GetTrialObservationSummary.
+func (mr *MockManagerClientMockRecorder) GetTrialObservationSummary(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialObservationLog", reflect.TypeOf((*MockManagerClient)(nil).GetTrialObservationLog), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialObservationSummary", reflect.TypeOf((*MockManagerClient)(nil).GetTrialObservationSummary), arg0)
+}
+
+// ReportTrialObservationLog mocks base method.
+func (m *MockManagerClient) ReportTrialObservationLog(arg0 *v1beta1.Trial, arg1 *api_v1_beta1.ObservationLog, arg2 string, arg3 int64) (*api_v1_beta1.ReportObservationLogReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportTrialObservationLog", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*api_v1_beta1.ReportObservationLogReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportTrialObservationLog indicates an expected call of ReportTrialObservationLog.
+func (mr *MockManagerClientMockRecorder) ReportTrialObservationLog(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportTrialObservationLog", reflect.TypeOf((*MockManagerClient)(nil).ReportTrialObservationLog), arg0, arg1, arg2, arg3)
 }
@@ -4,5 +4,6 @@ export enum CollectorKind {
   TFEVENT = 'TensorFlowEvent',
   PROMETHEUS = 'PrometheusMetric',
   CUSTOM = 'Custom',
+  PODLOGS = 'PodLogs',
   NONE = 'None',
 }
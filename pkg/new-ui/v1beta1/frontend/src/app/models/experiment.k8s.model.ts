@@ -110,6 +110,7 @@ export type CollectorKind =
   | 'TensorFlowEvent'
   | 'PrometheusMetric'
   | 'Custom'
+  | 'PodLogs'
   | 'None';
 
 export interface HttpGet {
@@ -37,6 +37,7 @@ export type CollectorKind =
   | 'TensorFlowEvent'
   | 'PrometheusMetric'
   | 'Custom'
+  | 'PodLogs'
   | 'None';
 
 export interface Objective {
@@ -358,7 +358,7 @@ export class ExperimentFormService {
       collector: { kind },
     };
 
-    if (kind === 'StdOut' || kind === 'None') {
+    if (kind === 'StdOut' || kind === 'PodLogs' || kind === 'None') {
       delete metrics.source;
       return metrics;
     }
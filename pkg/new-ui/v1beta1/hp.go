@@ -36,9 +36,6 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 	experimentName := r.URL.Query()["experimentName"][0]
 	namespace := r.URL.Query()["namespace"][0]
 
-	conn, c := k.connectManager()
-	defer conn.Close()
-
 	resultText := "trialName,Status"
 	experiment, err := k.katibClient.GetExperiment(experimentName, namespace)
 	if err != nil {
@@ -71,6 +68,19 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 	}
 	log.Printf("Got Trial List")
 
+	var trialNames []string
+	for _, t := range trialList.Items {
+		if t.IsSucceeded() || t.IsEarlyStopped() {
+			trialNames = append(trialNames, t.Name)
+		}
+	}
+	obsLogs, err := k.getObservationLogs(namespace, trialNames)
+	if err != nil {
+		log.Printf("GetObservationLogs from HP job failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	// append a column for the Pipeline UID associated with the Trial
 	if havePipelineUID(trialList.Items) {
 		resultText += ",KFP Run"
@@ -96,21 +106,7 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 		trialResText := make([]string, len(metricsList)+len(paramList))
 
 		if t.IsSucceeded() || t.IsEarlyStopped() {
-			obsLogResp, err := c.GetObservationLog(
-				context.Background(),
-				&api_pb_v1beta1.GetObservationLogRequest{
-					TrialName: t.Name,
-					StartTime: "",
-					EndTime:   "",
-				},
-			)
-			if err != nil {
-				log.Printf("GetObservationLog from HP job failed: %v", err)
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
-
-			for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			for _, m := range obsLogs[t.Name].GetMetricLogs() {
 				if trialResText[metricsList[m.Metric.Name]] == "" {
 					trialResText[metricsList[m.Metric.Name]] = m.Metric.Value
 				} else {
@@ -151,8 +147,7 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 	//enableCors(&w)
 	trialName := r.URL.Query()["trialName"][0]
 	namespace := r.URL.Query()["namespace"][0]
-	conn, c := k.connectManager()
-	defer conn.Close()
+	c := k.dbManagerClient
 
 	trial, err := k.katibClient.GetTrial(trialName, namespace)
 
@@ -172,6 +167,7 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 			TrialName: trialName,
 			StartTime: "",
 			EndTime:   "",
+			Namespace: namespace,
 		},
 	)
 	if err != nil {
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	v1beta1experiment "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
 )
@@ -74,8 +75,8 @@ type Template struct {
 }
 
 type KatibUIHandler struct {
-	katibClient   katibclient.Client
-	dbManagerAddr string
+	katibClient     katibclient.Client
+	dbManagerClient api_pb_v1beta1.DBManagerClient
 }
 
 type NNView struct {
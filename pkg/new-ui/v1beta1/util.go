@@ -17,12 +17,14 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"sort"
 	"strconv"
 	"strings"
 
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 
 	gographviz "github.com/awalterschulze/gographviz"
@@ -76,6 +78,28 @@ func havePipelineUID(trials []trialv1beta1.Trial) bool {
 	return false
 }
 
+// getObservationLogs returns observation logs of the Trials from the DB manager in one round trip.
+func (k *KatibUIHandler) getObservationLogs(namespace string, trialNames []string) (map[string]*api_pb_v1beta1.ObservationLog, error) {
+	obsLogs := make(map[string]*api_pb_v1beta1.ObservationLog, len(trialNames))
+	if len(trialNames) == 0 {
+		return obsLogs, nil
+	}
+	obsLogsResp, err := k.dbManagerClient.GetObservationLogs(
+		context.Background(),
+		&api_pb_v1beta1.GetObservationLogsRequest{
+			TrialNames: trialNames,
+			Namespace:  namespace,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, trialObsLog := range obsLogsResp.TrialObservationLogs {
+		obsLogs[trialObsLog.TrialName] = trialObsLog.ObservationLog
+	}
+	return obsLogs, nil
+}
+
 func (k *KatibUIHandler) getTrialTemplatesViewList() ([]TrialTemplatesDataView, error) {
 	trialTemplatesDataView := make([]TrialTemplatesDataView, 0)
 
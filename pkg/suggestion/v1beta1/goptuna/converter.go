@@ -213,9 +213,31 @@ func getFinalMetric(objectMetricName string, trial *api_v1_beta1.Trial) (float64
 	return 0, fmt.Errorf("No objective metric in Trial %v", trial)
 }
 
+// toGoptunaObjectiveUserAttrs returns values of all objectives of the multi-objective Experiment.
+// Only Random and Sobol samplers, which don't depend on objective values, are allowed for the multi-objective Experiment.
+// Values of all objectives are stored in the user attributes of the trial.
+func toGoptunaObjectiveUserAttrs(objective *api_v1_beta1.ObjectiveSpec, trial *api_v1_beta1.Trial) (map[string]string, error) {
+	if len(objective.GetAdditionalObjectives()) == 0 {
+		return nil, nil
+	}
+	metricNames := []string{objective.GetObjectiveMetricName()}
+	for _, o := range objective.GetAdditionalObjectives() {
+		metricNames = append(metricNames, o.GetObjectiveMetricName())
+	}
+	userAttrs := make(map[string]string, len(metricNames))
+	for _, metricName := range metricNames {
+		v, err := getFinalMetric(metricName, trial)
+		if err != nil {
+			return nil, err
+		}
+		userAttrs[objectiveUserAttrPrefix+metricName] = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return userAttrs, nil
+}
+
 func toGoptunaTrials(
 	ktrials []*api_v1_beta1.Trial,
-	objectMetricName string,
+	objective *api_v1_beta1.ObjectiveSpec,
 	study *goptuna.Study,
 	searchSpace map[string]interface{},
 ) (map[string]goptuna.FrozenTrial, error) {
@@ -241,8 +263,13 @@ func toGoptunaTrials(
 		}
 
 		var finalValue float64
+		var userAttrs map[string]string
 		if state == goptuna.TrialStateComplete {
-			finalValue, err = getFinalMetric(objectMetricName, kt)
+			finalValue, err = getFinalMetric(objective.GetObjectiveMetricName(), kt)
+			if err != nil {
+				return nil, err
+			}
+			userAttrs, err = toGoptunaObjectiveUserAttrs(objective, kt)
 			if err != nil {
 				return nil, err
 			}
@@ -266,7 +293,7 @@ func toGoptunaTrials(
 			InternalParams:     internalParams,
 			Params:             externalParams,
 			Distributions:      searchSpace,
-			UserAttrs:          nil,
+			UserAttrs:          userAttrs,
 			SystemAttrs:        nil,
 		}
 		gtrials[kt.GetName()] = gt
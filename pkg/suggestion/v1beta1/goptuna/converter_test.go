@@ -191,3 +191,74 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 		})
 	}
 }
+
+func Test_toGoptunaObjectiveUserAttrs(t *testing.T) {
+	trial := &api_v1_beta1.Trial{
+		Name: "trial-1",
+		Status: &api_v1_beta1.TrialStatus{
+			Observation: &api_v1_beta1.Observation{
+				Metrics: []*api_v1_beta1.Metric{
+					{Name: "accuracy", Value: "0.9"},
+					{Name: "latency", Value: "25.5"},
+				},
+			},
+		},
+	}
+	for _, tt := range []struct {
+		name      string
+		objective *api_v1_beta1.ObjectiveSpec
+		expected  map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "single objective",
+			objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MAXIMIZE,
+				ObjectiveMetricName: "accuracy",
+			},
+			expected: nil,
+		},
+		{
+			name: "multi-objective",
+			objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MAXIMIZE,
+				ObjectiveMetricName: "accuracy",
+				AdditionalObjectives: []*api_v1_beta1.AdditionalObjective{
+					{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "latency",
+					},
+				},
+			},
+			expected: map[string]string{
+				"objective:accuracy": "0.9",
+				"objective:latency":  "25.5",
+			},
+		},
+		{
+			name: "additional objective is not reported",
+			objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MAXIMIZE,
+				ObjectiveMetricName: "accuracy",
+				AdditionalObjectives: []*api_v1_beta1.AdditionalObjective{
+					{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "memory",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toGoptunaObjectiveUserAttrs(tt.objective, trial)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toGoptunaObjectiveUserAttrs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("toGoptunaObjectiveUserAttrs() got = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
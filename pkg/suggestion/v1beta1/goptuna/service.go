@@ -34,6 +34,9 @@ const (
 	AlgorithmSobol  = "sobol"
 
 	defaultStudyName = "Katib"
+
+	// objectiveUserAttrPrefix is the prefix of the trial user attributes with values of the multi-objective Experiment objectives.
+	objectiveUserAttrPrefix = "objective:"
 )
 
 func NewSuggestionService() *SuggestionService {
@@ -60,8 +63,7 @@ func (s *SuggestionService) GetSuggestions(
 		return nil, status.Errorf(codes.Internal, "Failed to create goptuna study and search space: %s", err.Error())
 	}
 
-	objectMetricName := req.GetExperiment().GetSpec().GetObjective().GetObjectiveMetricName()
-	trials, err := toGoptunaTrials(req.GetTrials(), objectMetricName, s.study, s.searchSpace)
+	trials, err := toGoptunaTrials(req.GetTrials(), req.GetExperiment().GetSpec().GetObjective(), s.study, s.searchSpace)
 	if err != nil {
 		klog.Errorf("Failed to convert to Goptuna trials: %s", err)
 		return nil, status.Error(codes.Internal, err.Error())
@@ -136,6 +138,12 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 			if err != nil {
 				return err
 			}
+			for key, value := range ktrial.UserAttrs {
+				err = s.study.Storage.SetTrialUserAttr(gtrialID, key, value)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 		err = s.study.Storage.SetTrialState(gtrialID, ktrial.State)
@@ -196,6 +204,11 @@ func (s *SuggestionService) ValidateAlgorithmSettings(
 		}
 	}
 
+	// TPE and CMA-ES samplers optimize only the single objective.
+	if (algorithmName == AlgorithmCMAES || algorithmName == AlgorithmTPE) && len(req.GetExperiment().GetSpec().GetObjective().GetAdditionalObjectives()) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s doesn't support additional objectives", algorithmName)
+	}
+
 	paramSet := make(map[string]interface{}, len(params))
 	for _, p := range params {
 		if _, ok := paramSet[p.Name]; ok {
@@ -22,8 +22,6 @@ import (
 	"net/http"
 
 	"github.com/ghodss/yaml"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,28 +30,18 @@ import (
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
 )
 
-func NewKatibUIHandler(dbManagerAddr string) *KatibUIHandler {
+func NewKatibUIHandler(dbManagerClient api_pb_v1beta1.DBManagerClient) *KatibUIHandler {
 	kclient, err := katibclient.NewClient(client.Options{})
 	if err != nil {
 		log.Printf("NewClient for Katib failed: %v", err)
 		panic(err)
 	}
 	return &KatibUIHandler{
-		katibClient:   kclient,
-		dbManagerAddr: dbManagerAddr,
+		katibClient:     kclient,
+		dbManagerClient: dbManagerClient,
 	}
 }
 
-func (k *KatibUIHandler) connectManager() (*grpc.ClientConn, api_pb_v1beta1.DBManagerClient) {
-	conn, err := grpc.Dial(k.dbManagerAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Printf("Dial to GRPC failed: %v", err)
-		return nil, nil
-	}
-	c := api_pb_v1beta1.NewDBManagerClient(conn)
-	return conn, c
-}
-
 func (k *KatibUIHandler) SubmitYamlJob(w http.ResponseWriter, r *http.Request) {
 	var data map[string]interface{}
 
@@ -17,14 +17,12 @@ limitations under the License.
 package v1beta1
 
 import (
-	"context"
 	"encoding/json"
 	"log"
 	"net/http"
 	"strconv"
 
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
-	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 )
 
 func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request) {
@@ -36,10 +34,6 @@ func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request)
 	var architecture string
 	var decoder string
 
-	conn, c := k.connectManager()
-
-	defer conn.Close()
-
 	trials, err := k.katibClient.GetTrialList(experimentName, namespace)
 	if err != nil {
 		log.Printf("GetTrialList from NAS job failed: %v", err)
@@ -48,6 +42,19 @@ func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request)
 	}
 	log.Printf("Got Trial List")
 
+	var trialNames []string
+	for _, t := range trials.Items {
+		if t.IsSucceeded() {
+			trialNames = append(trialNames, t.Name)
+		}
+	}
+	obsLogs, err := k.getObservationLogs(namespace, trialNames)
+	if err != nil {
+		log.Printf("GetObservationLogs from NAS job failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	for i, t := range trials.Items {
 		succeeded := false
 		for _, condition := range t.Status.Conditions {
@@ -56,22 +63,9 @@ func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request)
 			}
 		}
 		if succeeded {
-			obsLogResp, err := c.GetObservationLog(
-				context.Background(),
-				&api_pb_v1beta1.GetObservationLogRequest{
-					TrialName: t.Name,
-					StartTime: "",
-					EndTime:   "",
-				},
-			)
-			if err != nil {
-				log.Printf("GetObservationLog from NAS job failed: %v", err)
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
 			metricsName := make([]string, 0)
 			metricsValue := make([]string, 0)
-			for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			for _, m := range obsLogs[t.Name].GetMetricLogs() {
 				metricsName = append(metricsName, m.Metric.Name)
 				metricsValue = append(metricsValue, m.Metric.Value)
 
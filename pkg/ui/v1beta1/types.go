@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
 )
@@ -72,8 +73,8 @@ type Template struct {
 }
 
 type KatibUIHandler struct {
-	katibClient   katibclient.Client
-	dbManagerAddr string
+	katibClient     katibclient.Client
+	dbManagerClient api_pb_v1beta1.DBManagerClient
 }
 
 type NNView struct {
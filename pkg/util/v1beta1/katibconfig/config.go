@@ -25,11 +25,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 )
 
 // SuggestionConfig is the JSON suggestion structure in Katib config.
@@ -56,6 +58,39 @@ type MetricsCollectorConfig struct {
 	ImagePullPolicy  corev1.PullPolicy           `json:"imagePullPolicy,omitempty"`
 	Resource         corev1.ResourceRequirements `json:"resources,omitempty"`
 	WaitAllProcesses *bool                       `json:"waitAllProcesses,omitempty"`
+	// TrainingTermination configures how training processes are stopped when training is early stopped.
+	TrainingTermination *TrainingTerminationConfig `json:"trainingTermination,omitempty"`
+	// FlushInterval is the interval between reports of new metrics while training is running.
+	// It is used only by the File and StdOut collectors. Defaults to 10s.
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+}
+
+// TrainingTerminationConfig is the JSON training termination structure in Katib config.
+type TrainingTerminationConfig struct {
+	// Mode is ProcessTree to signal all descendant processes of the main process or
+	// ProcessGroup to signal process groups of its children. Defaults to ProcessTree.
+	Mode string `json:"mode,omitempty"`
+	// Signal is sent to training processes, e.g. SIGINT. Defaults to SIGTERM.
+	Signal string `json:"signal,omitempty"`
+	// GracePeriod is the time to wait until training processes are stopped by the signal.
+	// After that they are killed with SIGKILL. Defaults to 30s.
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// DBManagerConfig is the JSON DB manager structure in Katib config.
+type DBManagerConfig struct {
+	ObservationLogRetention ObservationLogRetentionConfig `json:"observationLogRetention,omitempty"`
+}
+
+// ObservationLogRetentionConfig is the JSON observation log garbage collection structure in Katib config.
+type ObservationLogRetentionConfig struct {
+	// TTL is the max age of observation logs of completed or deleted Trials.
+	// Logs of running Trials are never deleted. Logs are kept forever if it is not set.
+	TTL metav1.Duration `json:"ttl,omitempty"`
+	// DeleteOrphaned enables deletion of logs which Trials don't exist.
+	DeleteOrphaned bool `json:"deleteOrphaned,omitempty"`
+	// Interval is the period of garbage collection.
+	Interval metav1.Duration `json:"interval,omitempty"`
 }
 
 // GetSuggestionConfigData gets the config data for the given suggestion algorithm name.
@@ -222,9 +257,64 @@ func GetMetricsCollectorConfigData(cKind common.CollectorKind, client client.Cli
 	// Set resource requirements for metrics collector
 	metricsCollectorConfigData.Resource = setResourceRequirements(metricsCollectorConfigData.Resource)
 
+	// Validate training termination for early stopping
+	if termination := metricsCollectorConfigData.TrainingTermination; termination != nil {
+		mode, signal := termination.Mode, termination.Signal
+		if mode == "" {
+			mode = mccommon.DefaultTerminationMode
+		}
+		if signal == "" {
+			signal = mccommon.DefaultTerminationSignal
+		}
+		gracePeriod := mccommon.DefaultTerminationGracePeriod
+		if termination.GracePeriod != nil {
+			gracePeriod = termination.GracePeriod.Duration
+		}
+		if _, err := mccommon.NewTerminationOpts(mode, signal, gracePeriod); err != nil {
+			return MetricsCollectorConfig{}, fmt.Errorf("invalid training termination of metrics collector kind: %s: %v", kind, err)
+		}
+	}
+
+	// Validate flush interval of metrics
+	if flushInterval := metricsCollectorConfigData.FlushInterval; flushInterval != nil && flushInterval.Duration <= 0 {
+		return MetricsCollectorConfig{}, fmt.Errorf("flush interval of metrics collector kind: %s must be greater than 0", kind)
+	}
+
 	return metricsCollectorConfigData, nil
 }
 
+// GetDBManagerConfigData gets the config data for Katib DB Manager.
+// Default config is returned if Katib config doesn't have DB manager data.
+func GetDBManagerConfigData(client client.Client) (DBManagerConfig, error) {
+	configMap := &corev1.ConfigMap{}
+	dbManagerConfigData := DBManagerConfig{}
+	err := client.Get(
+		context.TODO(),
+		apitypes.NamespacedName{Name: consts.KatibConfigMapName, Namespace: consts.DefaultKatibNamespace},
+		configMap)
+	if err != nil {
+		return DBManagerConfig{}, err
+	}
+
+	// Try to find DB manager data in config map
+	if config, ok := configMap.Data[consts.LabelDBManagerTag]; ok {
+		if err := json.Unmarshal([]byte(config), &dbManagerConfigData); err != nil {
+			return DBManagerConfig{}, err
+		}
+	}
+
+	retention := &dbManagerConfigData.ObservationLogRetention
+	if retention.TTL.Duration < 0 || retention.Interval.Duration < 0 {
+		return DBManagerConfig{}, fmt.Errorf("observation log retention ttl and interval must not be negative in ConfigMap: %s", consts.KatibConfigMapName)
+	}
+	// Set default interval of garbage collection
+	if retention.Interval.Duration == 0 {
+		retention.Interval.Duration = consts.DefaultObservationLogRetentionInterval
+	}
+
+	return dbManagerConfigData, nil
+}
+
 func setImagePullPolicy(imagePullPolicy corev1.PullPolicy) corev1.PullPolicy {
 	if imagePullPolicy != corev1.PullAlways && imagePullPolicy != corev1.PullIfNotPresent && imagePullPolicy != corev1.PullNever {
 		return consts.DefaultImagePullPolicy
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -37,6 +38,7 @@ type katibConfig struct {
 	suggestion       map[string]*SuggestionConfig
 	earlyStopping    map[string]*EarlyStoppingConfig
 	metricsCollector map[commonv1beta1.CollectorKind]*MetricsCollectorConfig
+	dbManager        *DBManagerConfig
 }
 
 func TestGetSuggestionConfigData(t *testing.T) {
@@ -341,6 +343,64 @@ func TestGetMetricsCollectorConfigData(t *testing.T) {
 			inputCollectorKind: testCollectorKind,
 			err:                false,
 		},
+		{
+			testDescription: "Training termination is specified",
+			katibConfig: func() *katibConfig {
+				kc := &katibConfig{metricsCollector: map[commonv1beta1.CollectorKind]*MetricsCollectorConfig{testCollectorKind: newFakeMetricsCollectorConfig()}}
+				kc.metricsCollector[testCollectorKind].TrainingTermination = &TrainingTerminationConfig{
+					Mode:        "ProcessGroup",
+					Signal:      "SIGINT",
+					GracePeriod: &metav1.Duration{Duration: 10 * time.Second},
+				}
+				return kc
+			}(),
+			expected: func() *MetricsCollectorConfig {
+				c := newFakeMetricsCollectorConfig()
+				c.TrainingTermination = &TrainingTerminationConfig{
+					Mode:        "ProcessGroup",
+					Signal:      "SIGINT",
+					GracePeriod: &metav1.Duration{Duration: 10 * time.Second},
+				}
+				return c
+			}(),
+			inputCollectorKind: testCollectorKind,
+			err:                false,
+		},
+		{
+			testDescription: "Invalid signal of training termination",
+			katibConfig: func() *katibConfig {
+				kc := &katibConfig{metricsCollector: map[commonv1beta1.CollectorKind]*MetricsCollectorConfig{testCollectorKind: newFakeMetricsCollectorConfig()}}
+				kc.metricsCollector[testCollectorKind].TrainingTermination = &TrainingTerminationConfig{Signal: "SIGSTOPPED"}
+				return kc
+			}(),
+			inputCollectorKind: testCollectorKind,
+			err:                true,
+		},
+		{
+			testDescription: "Flush interval is specified",
+			katibConfig: func() *katibConfig {
+				kc := &katibConfig{metricsCollector: map[commonv1beta1.CollectorKind]*MetricsCollectorConfig{testCollectorKind: newFakeMetricsCollectorConfig()}}
+				kc.metricsCollector[testCollectorKind].FlushInterval = &metav1.Duration{Duration: 30 * time.Second}
+				return kc
+			}(),
+			expected: func() *MetricsCollectorConfig {
+				c := newFakeMetricsCollectorConfig()
+				c.FlushInterval = &metav1.Duration{Duration: 30 * time.Second}
+				return c
+			}(),
+			inputCollectorKind: testCollectorKind,
+			err:                false,
+		},
+		{
+			testDescription: "Invalid flush interval",
+			katibConfig: func() *katibConfig {
+				kc := &katibConfig{metricsCollector: map[commonv1beta1.CollectorKind]*MetricsCollectorConfig{testCollectorKind: newFakeMetricsCollectorConfig()}}
+				kc.metricsCollector[testCollectorKind].FlushInterval = &metav1.Duration{}
+				return kc
+			}(),
+			inputCollectorKind: testCollectorKind,
+			err:                true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -358,6 +418,72 @@ func TestGetMetricsCollectorConfigData(t *testing.T) {
 	}
 }
 
+func TestGetDBManagerConfigData(t *testing.T) {
+	tests := []struct {
+		testDescription string
+		katibConfig     *katibConfig
+		expected        *DBManagerConfig
+		err             bool
+	}{
+		{
+			testDescription: "All parameters correctly are specified",
+			katibConfig: &katibConfig{dbManager: &DBManagerConfig{
+				ObservationLogRetention: ObservationLogRetentionConfig{
+					TTL:            metav1.Duration{Duration: 24 * time.Hour},
+					DeleteOrphaned: true,
+					Interval:       metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}},
+			expected: &DBManagerConfig{
+				ObservationLogRetention: ObservationLogRetentionConfig{
+					TTL:            metav1.Duration{Duration: 24 * time.Hour},
+					DeleteOrphaned: true,
+					Interval:       metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+			err: false,
+		},
+		{
+			testDescription: "There is not katib-config.",
+			katibConfig:     nil,
+			err:             true,
+		},
+		{
+			testDescription: fmt.Sprintf("GetDBManagerConfigData sets defaults if there is not %s field in katib-config configMap", consts.LabelDBManagerTag),
+			katibConfig:     &katibConfig{},
+			expected: &DBManagerConfig{
+				ObservationLogRetention: ObservationLogRetentionConfig{
+					Interval: metav1.Duration{Duration: consts.DefaultObservationLogRetentionInterval},
+				},
+			},
+			err: false,
+		},
+		{
+			testDescription: "Negative ttl in katib-config configMap",
+			katibConfig: &katibConfig{dbManager: &DBManagerConfig{
+				ObservationLogRetention: ObservationLogRetentionConfig{
+					TTL: metav1.Duration{Duration: -time.Hour},
+				},
+			}},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testDescription, func(t *testing.T) {
+			fakeKubeClient := newFakeKubeClient(newFakeKatibConfigMap(tt.katibConfig))
+			actual, err := GetDBManagerConfigData(fakeKubeClient)
+			if (err != nil) != tt.err {
+				t.Errorf("want error: %v, actual: %v", tt.err, err)
+			} else if tt.expected != nil {
+				if !reflect.DeepEqual(actual, *tt.expected) {
+					t.Errorf("Generated DBManagerConfig is invalid.\n\nactual:\n%v\n\nexpected:\n%v\n\n", actual, *tt.expected)
+				}
+			}
+		})
+	}
+}
+
 func newFakeKubeClient(katibConfigMap *corev1.ConfigMap) client.Client {
 	fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
 	if katibConfigMap != nil {
@@ -384,6 +510,10 @@ func newFakeKatibConfigMap(config *katibConfig) *corev1.ConfigMap {
 		bMetricsCollector, _ := json.Marshal(config.metricsCollector)
 		data[consts.LabelMetricsCollectorSidecar] = string(bMetricsCollector)
 	}
+	if config.dbManager != nil {
+		bDBManager, _ := json.Marshal(config.dbManager)
+		data[consts.LabelDBManagerTag] = string(bDBManager)
+	}
 
 	return &corev1.ConfigMap{
 		TypeMeta: metav1.TypeMeta{
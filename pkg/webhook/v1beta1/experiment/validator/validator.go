@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -43,6 +44,10 @@ import (
 
 var log = logf.Log.WithName("experiment-validating-webhook")
 
+// multiObjectiveAlgorithms are algorithms which sample independently of objective values,
+// so they can be used in the multi-objective Experiment.
+var multiObjectiveAlgorithms = []string{"random", "grid", "sobol"}
+
 type Validator interface {
 	ValidateExperiment(instance, oldInst *experimentsv1beta1.Experiment) error
 	InjectClient(c client.Client)
@@ -84,6 +89,10 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 		return fmt.Errorf("spec.parallelTrialCount must be greater than 0")
 	}
 	if oldInst != nil {
+		// Experiment can be suspended and resumed at any time, it doesn't restart the experiment.
+		oldInst.Spec.Suspend = instance.Spec.Suspend
+		oldInst.Spec.SuspendPolicy = instance.Spec.SuspendPolicy
+
 		// We should validate restart only if appropriate fields are changed.
 		// Otherwise check below is triggered when experiment is deleted.
 		isRestarting := false
@@ -107,7 +116,8 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 		oldInst.Spec.MaxTrialCount = instance.Spec.MaxTrialCount
 		oldInst.Spec.ParallelTrialCount = instance.Spec.ParallelTrialCount
 		if !equality.Semantic.DeepEqual(instance.Spec, oldInst.Spec) {
-			return fmt.Errorf("only spec.parallelTrialCount, spec.maxTrialCount and spec.maxFailedTrialCount are editable")
+			return fmt.Errorf("only spec.parallelTrialCount, spec.maxTrialCount, spec.maxFailedTrialCount, " +
+				"spec.suspend and spec.suspendPolicy are editable")
 		}
 	}
 	if err := g.validateObjective(instance.Spec.Objective); err != nil {
@@ -116,12 +126,25 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	if err := g.validateAlgorithm(instance.Spec.Algorithm); err != nil {
 		return err
 	}
+	if len(instance.Spec.Objective.AdditionalObjectives) != 0 && !contains(multiObjectiveAlgorithms, instance.Spec.Algorithm.AlgorithmName) {
+		return fmt.Errorf("spec.objective.additionalObjectives are supported only by %v algorithms, got %s",
+			multiObjectiveAlgorithms, instance.Spec.Algorithm.AlgorithmName)
+	}
 	if err := g.validateEarlyStopping(instance.Spec.EarlyStopping); err != nil {
 		return err
 	}
 	if err := g.validateResumePolicy(instance.Spec.ResumePolicy); err != nil {
 		return err
 	}
+	if err := g.validateBudget(instance.Spec.Budget); err != nil {
+		return err
+	}
+	if err := g.validatePlateau(instance.Spec.Plateau); err != nil {
+		return err
+	}
+	if err := g.validateSuspendPolicy(instance.Spec.SuspendPolicy); err != nil {
+		return err
+	}
 
 	if err := g.validateTrialTemplate(instance); err != nil {
 		return err
@@ -160,6 +183,33 @@ func (g *DefaultValidator) validateObjective(obj *commonapiv1beta1.ObjectiveSpec
 	if contains(obj.AdditionalMetricNames, obj.ObjectiveMetricName) {
 		return fmt.Errorf("spec.objective.additionalMetricNames should not contain spec.objective.objectiveMetricName")
 	}
+	objectiveMetricNames := map[string]bool{obj.ObjectiveMetricName: true}
+	for i, additionalObjective := range obj.AdditionalObjectives {
+		if additionalObjective.Type != commonapiv1beta1.ObjectiveTypeMinimize && additionalObjective.Type != commonapiv1beta1.ObjectiveTypeMaximize {
+			return fmt.Errorf("spec.objective.additionalObjectives[%d].type must be %s or %s", i, commonapiv1beta1.ObjectiveTypeMinimize, commonapiv1beta1.ObjectiveTypeMaximize)
+		}
+		if additionalObjective.ObjectiveMetricName == "" {
+			return fmt.Errorf("no spec.objective.additionalObjectives[%d].objectiveMetricName specified", i)
+		}
+		if objectiveMetricNames[additionalObjective.ObjectiveMetricName] {
+			return fmt.Errorf("spec.objective.additionalObjectives[%d].objectiveMetricName %s is duplicated", i, additionalObjective.ObjectiveMetricName)
+		}
+		objectiveMetricNames[additionalObjective.ObjectiveMetricName] = true
+	}
+	for i, constraint := range obj.Constraints {
+		if constraint.MetricName == "" {
+			return fmt.Errorf("no spec.objective.constraints[%d].metricName specified", i)
+		}
+		if constraint.Comparison != commonapiv1beta1.ComparisonTypeEqual &&
+			constraint.Comparison != commonapiv1beta1.ComparisonTypeLess &&
+			constraint.Comparison != commonapiv1beta1.ComparisonTypeGreater {
+			return fmt.Errorf("spec.objective.constraints[%d].comparison must be %s, %s or %s", i,
+				commonapiv1beta1.ComparisonTypeEqual, commonapiv1beta1.ComparisonTypeLess, commonapiv1beta1.ComparisonTypeGreater)
+		}
+		if _, err := strconv.ParseFloat(constraint.Value, 64); err != nil {
+			return fmt.Errorf("spec.objective.constraints[%d].value %q must be a number", i, constraint.Value)
+		}
+	}
 	return nil
 }
 
@@ -193,6 +243,38 @@ func (g *DefaultValidator) validateEarlyStopping(es *commonapiv1beta1.EarlyStopp
 	return nil
 }
 
+func (g *DefaultValidator) validateBudget(budget *experimentsv1beta1.BudgetSpec) error {
+	if budget == nil {
+		return nil
+	}
+	if budget.MaxDurationSeconds != nil && *budget.MaxDurationSeconds <= 0 {
+		return fmt.Errorf("spec.budget.maxDurationSeconds must be greater than 0")
+	}
+	if budget.MaxTrialRuntimeSeconds != nil && *budget.MaxTrialRuntimeSeconds <= 0 {
+		return fmt.Errorf("spec.budget.maxTrialRuntimeSeconds must be greater than 0")
+	}
+	if budget.MaxCPUHours != nil && *budget.MaxCPUHours <= 0 {
+		return fmt.Errorf("spec.budget.maxCPUHours must be greater than 0")
+	}
+	if budget.MaxGPUHours != nil && *budget.MaxGPUHours <= 0 {
+		return fmt.Errorf("spec.budget.maxGPUHours must be greater than 0")
+	}
+	return nil
+}
+
+func (g *DefaultValidator) validatePlateau(plateau *experimentsv1beta1.PlateauSpec) error {
+	if plateau == nil {
+		return nil
+	}
+	if plateau.TrialCount <= 0 {
+		return fmt.Errorf("spec.plateau.trialCount must be greater than 0")
+	}
+	if plateau.Delta < 0 {
+		return fmt.Errorf("spec.plateau.delta should not be less than 0")
+	}
+	return nil
+}
+
 func (g *DefaultValidator) validateResumePolicy(resume experimentsv1beta1.ResumePolicyType) error {
 	validTypes := map[experimentsv1beta1.ResumePolicyType]string{
 		"":                             "",
@@ -206,6 +288,19 @@ func (g *DefaultValidator) validateResumePolicy(resume experimentsv1beta1.Resume
 	return nil
 }
 
+func (g *DefaultValidator) validateSuspendPolicy(suspendPolicy commonapiv1beta1.SuspendPolicyType) error {
+	validTypes := map[commonapiv1beta1.SuspendPolicyType]string{
+		"": "",
+		commonapiv1beta1.SuspendPolicyKeepRunning: "",
+		commonapiv1beta1.SuspendPolicySuspendJobs: "",
+		commonapiv1beta1.SuspendPolicyDeleteJobs:  "",
+	}
+	if _, ok := validTypes[suspendPolicy]; !ok {
+		return fmt.Errorf("invalid SuspendPolicyType %s", suspendPolicy)
+	}
+	return nil
+}
+
 func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.ParameterSpec) error {
 	for i, param := range parameters {
 
@@ -358,6 +453,14 @@ func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Ex
 		return fmt.Errorf("invalid spec.trialTemplate: %v", err)
 	}
 
+	// Check if Trial job can be suspended with the job suspend field
+	// Only batch Job and Kubeflow Training Jobs have the suspend field
+	if instance.Spec.SuspendPolicy == commonapiv1beta1.SuspendPolicySuspendJobs &&
+		runSpec.GetKind() != consts.JobKindJob && !experimentsv1beta1.KubeflowJobKinds[runSpec.GetKind()] {
+		return fmt.Errorf("spec.suspendPolicy %s is not supported for %s in spec.trialTemplate, use %s or %s",
+			commonapiv1beta1.SuspendPolicySuspendJobs, runSpec.GetKind(), commonapiv1beta1.SuspendPolicyKeepRunning, commonapiv1beta1.SuspendPolicyDeleteJobs)
+	}
+
 	return nil
 }
 
@@ -428,6 +531,15 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 	switch mcKind {
 	case commonapiv1beta1.NoneCollector, commonapiv1beta1.StdOutCollector:
 		return nil
+	case commonapiv1beta1.PodLogsCollector:
+		// Metrics are parsed from the primary container logs in TEXT format.
+		if mcSpec.Source != nil && (mcSpec.Source.FileSystemPath != nil || mcSpec.Source.HttpGet != nil) {
+			return fmt.Errorf(".spec.metricsCollectorSpec.source.fileSystemPath and .spec.metricsCollectorSpec.source.httpGet must be nil for metrics collector kind: %v", mcKind)
+		}
+		// Early stopping rules are checked by the metrics collector sidecar which is not injected for PodLogs.
+		if inst.Spec.EarlyStopping != nil {
+			return fmt.Errorf(".spec.earlyStopping is not supported for metrics collector kind: %v", mcKind)
+		}
 	case commonapiv1beta1.FileCollector:
 		if mcSpec.Source == nil || mcSpec.Source.FileSystemPath == nil ||
 			mcSpec.Source.FileSystemPath.Kind != commonapiv1beta1.FileKind || !filepath.IsAbs(mcSpec.Source.FileSystemPath.Path) {
@@ -435,11 +547,17 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 		}
 		// Format
 		fileFormat := mcSpec.Source.FileSystemPath.Format
-		if fileFormat != commonapiv1beta1.TextFormat && fileFormat != commonapiv1beta1.JsonFormat {
+		if fileFormat != commonapiv1beta1.TextFormat && fileFormat != commonapiv1beta1.JsonFormat &&
+			fileFormat != commonapiv1beta1.CsvFormat && fileFormat != commonapiv1beta1.LogfmtFormat {
 			return fmt.Errorf("format of metrics file is required by .spec.metricsCollectorSpec.source.fileSystemPath.format")
 		}
-		if fileFormat == commonapiv1beta1.JsonFormat && mcSpec.Source.Filter != nil {
-			return fmt.Errorf(".spec.metricsCollectorSpec.source.filter must be nil when format of metrics file is %v", commonapiv1beta1.JsonFormat)
+		// Filters are used only to parse metrics in TEXT format.
+		if fileFormat != commonapiv1beta1.TextFormat && mcSpec.Source.Filter != nil {
+			return fmt.Errorf(".spec.metricsCollectorSpec.source.filter must be nil when format of metrics file is %v", fileFormat)
+		}
+		// Metrics in TEXT format have timestamp at the beginning of the line.
+		if fileFormat == commonapiv1beta1.TextFormat && mcSpec.Source.FileSystemPath.TimestampKey != "" {
+			return fmt.Errorf(".spec.metricsCollectorSpec.source.fileSystemPath.timestampKey must be empty when format of metrics file is %v", fileFormat)
 		}
 	case commonapiv1beta1.TfEventCollector:
 		if mcSpec.Source == nil || mcSpec.Source.FileSystemPath == nil ||
@@ -449,14 +567,21 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 		if mcSpec.Source.FileSystemPath.Format != "" {
 			return fmt.Errorf(".spec.metricsCollectorSpec.source.fileSystemPath.format must be empty")
 		}
+		if mcSpec.Source.FileSystemPath.TimestampKey != "" {
+			return fmt.Errorf(".spec.metricsCollectorSpec.source.fileSystemPath.timestampKey must be empty")
+		}
 	case commonapiv1beta1.PrometheusMetricCollector:
-		i, err := strconv.Atoi(mcSpec.Source.HttpGet.Port.String())
-		if err != nil || i <= 0 {
+		// Named ports are not resolved, since the sidecar scrapes the metrics endpoint by the URL with the port number.
+		if mcSpec.Source.HttpGet.Port.Type != intstr.Int || mcSpec.Source.HttpGet.Port.IntVal <= 0 {
 			return fmt.Errorf(".spec.metricsCollectorSpec.source.httpGet.port must be a positive integer value for metrics collector kind: %v", mcKind)
 		}
 		if !strings.HasPrefix(mcSpec.Source.HttpGet.Path, "/") {
 			return fmt.Errorf(".spec.metricsCollectorSpec.source.httpGet.path is invalid for metrics collector kind: %v", mcKind)
 		}
+		// Prometheus metrics collector doesn't check early stopping rules.
+		if inst.Spec.EarlyStopping != nil {
+			return fmt.Errorf(".spec.earlyStopping is not supported for metrics collector kind: %v", mcKind)
+		}
 	case commonapiv1beta1.CustomCollector:
 		if mcSpec.Collector.CustomCollector == nil {
 			return fmt.Errorf(".spec.metricsCollectorSpec.collector.customCollector is required for metrics collector kind: %v", mcKind)
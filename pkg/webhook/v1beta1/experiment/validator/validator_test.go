@@ -118,6 +118,107 @@ func TestValidateExperiment(t *testing.T) {
 			Err:             true,
 			testDescription: "additionalMetricNames should not contain objective metric name",
 		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "random"
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{
+						Type:                commonv1beta1.ObjectiveTypeMinimize,
+						ObjectiveMetricName: "latency",
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid additional objective",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "tpe"
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{
+						Type:                commonv1beta1.ObjectiveTypeMinimize,
+						ObjectiveMetricName: "latency",
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Additional objective is not supported by algorithm",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{
+						ObjectiveMetricName: "latency",
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Additional objective type is unknown",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{
+						Type:                commonv1beta1.ObjectiveTypeMinimize,
+						ObjectiveMetricName: i.Spec.Objective.ObjectiveMetricName,
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Additional objective metric name is the objective metric name",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Constraints = []commonv1beta1.ObjectiveConstraint{
+					{
+						MetricName: "latency",
+						Value:      "50",
+						Comparison: commonv1beta1.ComparisonTypeLess,
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid objective constraint",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Constraints = []commonv1beta1.ObjectiveConstraint{
+					{
+						MetricName: "latency",
+						Value:      "50",
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Objective constraint comparison is unknown",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Constraints = []commonv1beta1.ObjectiveConstraint{
+					{
+						MetricName: "latency",
+						Value:      "fast",
+						Comparison: commonv1beta1.ComparisonTypeLess,
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Objective constraint value is not a number",
+		},
 		// Algorithm
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -240,6 +341,82 @@ func TestValidateExperiment(t *testing.T) {
 			Err:             true,
 			testDescription: "Invalid resume policy",
 		},
+		// Validate Budget
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				maxGPUHours := 10.0
+				i.Spec.Budget = &experimentsv1beta1.BudgetSpec{
+					MaxGPUHours:      &maxGPUHours,
+					KillActiveTrials: true,
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid budget",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				maxDurationSeconds := int64(0)
+				i.Spec.Budget = &experimentsv1beta1.BudgetSpec{
+					MaxDurationSeconds: &maxDurationSeconds,
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Budget max duration is not positive",
+		},
+		// Validate Plateau
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Plateau = &experimentsv1beta1.PlateauSpec{
+					TrialCount: 10,
+					Delta:      0.01,
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid plateau",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Plateau = &experimentsv1beta1.PlateauSpec{}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Plateau trial count is not set",
+		},
+		// Validate Suspend
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Suspend = true
+				i.Spec.SuspendPolicy = commonv1beta1.SuspendPolicySuspendJobs
+				return i
+			}(),
+			Err: false,
+			oldInstance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Status = experimentsv1beta1.ExperimentStatus{
+					Trials: *i.Spec.MaxTrialCount,
+				}
+				return i
+			}(),
+			testDescription: "Suspend running experiment",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Suspend = true
+				i.Spec.SuspendPolicy = "invalid-policy"
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Invalid suspend policy",
+		},
 		// Validate NAS Config
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -435,6 +612,7 @@ spec:
 	notEmptyMetadataTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(notEmptyMetadataStr, nil)
 	emptyAPIVersionTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(emptyAPIVersionStr, nil)
 	customJobTypeTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(customJobTypeStr, nil)
+	customJobTypeSuspendTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(customJobTypeStr, nil)
 
 	gomock.InOrder(
 		emptyConfigMap,
@@ -453,6 +631,7 @@ spec:
 		notEmptyMetadataTemplate,
 		emptyAPIVersionTemplate,
 		customJobTypeTemplate,
+		customJobTypeSuspendTemplate,
 	)
 
 	tcs := []struct {
@@ -677,6 +856,17 @@ spec:
 			Err:             false,
 			testDescription: "Trial template has custom Kind",
 		},
+		// Trial Template has custom Kind which can't be suspended
+		// customJobTypeSuspendTemplate case
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.SuspendPolicy = commonv1beta1.SuspendPolicySuspendJobs
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Trial template has custom Kind with SuspendJobs suspend policy",
+		},
 		// Trial Template doesn't have PrimaryContainerName
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -852,6 +1042,56 @@ func TestValidateMetricsCollector(t *testing.T) {
 			Err:             true,
 			testDescription: "Invalid path for File metrics collector",
 		},
+		// Valid PodLogsCollector
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = nil
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PodLogsCollector,
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Run validator for correct PodLogs metrics collector",
+		},
+		// PodLogsCollector with file system path
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = nil
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PodLogsCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path: "/var/log/katib/metrics.log",
+							Kind: commonv1beta1.FileKind,
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "File system path for PodLogs metrics collector",
+		},
+		// PodLogsCollector with early stopping
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PodLogsCollector,
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Early stopping for PodLogs metrics collector",
+		},
 		// TfEventCollector invalid Path
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -935,6 +1175,68 @@ func TestValidateMetricsCollector(t *testing.T) {
 			Err:             true,
 			testDescription: "Invalid path for Prometheus metrics collector",
 		},
+		// PrometheusMetricCollector named Port
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = nil
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PrometheusMetricCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						HttpGet: &v1.HTTPGetAction{
+							Port: intstr.FromString("metrics"),
+							Path: "/metrics",
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Named port for Prometheus metrics collector",
+		},
+		// PrometheusMetricCollector with early stopping
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PrometheusMetricCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						HttpGet: &v1.HTTPGetAction{
+							Port: intstr.FromInt(8080),
+							Path: "/metrics",
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Early stopping for Prometheus metrics collector",
+		},
+		// Valid PrometheusMetricCollector
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = nil
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PrometheusMetricCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						HttpGet: &v1.HTTPGetAction{
+							Port: intstr.FromInt(8080),
+							Path: "/metrics",
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Run validator for correct Prometheus metrics collector",
+		},
 		//  CustomCollector empty CustomCollector
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -1087,6 +1389,93 @@ func TestValidateMetricsCollector(t *testing.T) {
 			Err:             false,
 			testDescription: "Run validator for correct File metrics collector",
 		},
+		// FileMetricCollector invalid metrics filter for CSV format
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.CsvFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Invalid metrics filer for File metrics collector when file format is `CSV`",
+		},
+		// Valid FileMetricCollector with LOGFMT format
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.LogfmtFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Run validator for correct File metrics collector with `LOGFMT` format",
+		},
+		// Valid FileMetricCollector with timestamp key in JSON format
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:         "/absolute/path",
+							Kind:         commonv1beta1.FileKind,
+							Format:       commonv1beta1.JsonFormat,
+							TimestampKey: "meta.time",
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Run validator for correct File metrics collector with timestamp key in `JSON` format",
+		},
+		// Invalid FileMetricCollector with timestamp key in TEXT format
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:         "/absolute/path",
+							Kind:         commonv1beta1.FileKind,
+							Format:       commonv1beta1.TextFormat,
+							TimestampKey: "time",
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Invalid timestamp key for File metrics collector when file format is `TEXT`",
+		},
 	}
 
 	for _, tc := range tcs {
@@ -20,10 +20,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 	v1 "k8s.io/api/core/v1"
@@ -54,12 +57,22 @@ type SidecarInjector struct {
 	// injectSecurityContext indicates if we should inject the security
 	// context into the metrics collector sidecar.
 	injectSecurityContext bool
+
+	// trialTokenKeyFile is the file with the key to sign Trial tokens.
+	// If it is set, Trial token to push metrics is injected into the pods with None metrics collector
+	// and Trial token to report metrics is injected into the metrics collectors if Katib DB Manager uses TLS.
+	trialTokenKeyFile string
+
+	// trialTokenLifetime is the time after which injected Trial tokens expire.
+	trialTokenLifetime time.Duration
 }
 
 // NewSidecarInjector returns a new sidecar injector with the given client.
 func NewSidecarInjector(c client.Client) *SidecarInjector {
 	return &SidecarInjector{
 		injectSecurityContext: viper.GetBool(consts.ConfigInjectSecurityContext),
+		trialTokenKeyFile:     viper.GetString(consts.ConfigTrialTokenKeyFile),
+		trialTokenLifetime:    viper.GetDuration(consts.ConfigTrialTokenLifetime),
 		client:                c,
 	}
 }
@@ -132,9 +145,15 @@ func (s *SidecarInjector) MutationRequired(pod *v1.Pod, ns string) (bool, error)
 		}
 	}
 
-	if trial.Spec.MetricsCollector.Collector.Kind == common.NoneCollector {
+	// Metrics of pods with PodLogs metrics collector are collected from the pod logs by Katib controller.
+	if trial.Spec.MetricsCollector.Collector.Kind == common.PodLogsCollector {
 		return false, nil
 	}
+
+	// Pods with None metrics collector are mutated only to inject Trial token.
+	if trial.Spec.MetricsCollector.Collector.Kind == common.NoneCollector {
+		return s.trialTokenKeyFile != "", nil
+	}
 	return true, nil
 }
 
@@ -155,11 +174,23 @@ func (s *SidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 		return nil, err
 	}
 
+	// Training code pushes metrics to Katib DB Manager itself, so only Trial token is injected.
+	if trial.Spec.MetricsCollector.Collector.Kind == common.NoneCollector {
+		if err := s.injectTrialToken(mutatedPod, trial); err != nil {
+			return nil, err
+		}
+		log.Info("Inject Trial token", "Trial", jobName)
+		return mutatedPod, nil
+	}
+
 	// Create metrics sidecar container spec
 	injectContainer, err := s.getMetricsCollectorContainer(trial, pod)
 	if err != nil {
 		return nil, err
 	}
+	if err = s.injectDBManagerClientCredentials(mutatedPod, injectContainer, trial); err != nil {
+		return nil, err
+	}
 	mutatedPod.Spec.Containers = append(mutatedPod.Spec.Containers, *injectContainer)
 
 	// Enable shared volume between suggestion <> trial
@@ -191,6 +222,63 @@ func (s *SidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 	return mutatedPod, nil
 }
 
+// injectTrialToken adds the Trial name, the Trial token and Katib DB Manager URL to push metrics
+// as env variables to the primary container. If Katib DB Manager uses TLS, the CA certificate
+// to verify DB Manager is mounted to the primary container as well.
+func (s *SidecarInjector) injectTrialToken(pod *v1.Pod, trial *trialsv1beta1.Trial) error {
+	index := getPrimaryContainerIndex(pod.Spec.Containers, trial.Spec.PrimaryContainerName)
+	if index < 0 {
+		return fmt.Errorf("Unable to find primary container %v in mutated pod containers %v",
+			trial.Spec.PrimaryContainerName, pod.Spec.Containers)
+	}
+	token, err := s.newTrialToken(trial)
+	if err != nil {
+		return err
+	}
+	c := &pod.Spec.Containers[index]
+	c.Env = append(c.Env,
+		v1.EnvVar{Name: consts.KatibTrialNameEnvName, Value: trial.Name},
+		v1.EnvVar{Name: consts.KatibTrialTokenEnvName, Value: token},
+		v1.EnvVar{Name: consts.KatibMetricsPushURLEnvName, Value: katibmanagerv1beta1.GetDBManagerPushURL()},
+	)
+	mutateDBManagerCAVolume(pod, c, trial, consts.KatibMetricsPushCAFileEnvName)
+	return nil
+}
+
+// injectDBManagerClientCredentials mounts the CA certificate to verify Katib DB Manager into the metrics collector
+// and adds the Trial token to it, if Katib DB Manager uses TLS. Trial token allows to report and delete
+// observation logs only of this Trial, so the metrics collector doesn't get the credentials of Katib components.
+func (s *SidecarInjector) injectDBManagerClientCredentials(pod *v1.Pod, container *v1.Container, trial *trialsv1beta1.Trial) error {
+	if os.Getenv(consts.KatibDBManagerTLSCAFileEnvName) == "" {
+		return nil
+	}
+	mutateDBManagerCAVolume(pod, container, trial, consts.KatibDBManagerTLSCAFileEnvName)
+	if s.trialTokenKeyFile == "" {
+		return nil
+	}
+	token, err := s.newTrialToken(trial)
+	if err != nil {
+		return err
+	}
+	container.Env = append(container.Env, v1.EnvVar{Name: consts.KatibTrialTokenEnvName, Value: token})
+	return nil
+}
+
+// newTrialToken signs the Trial token which expires after the Trial token lifetime.
+func (s *SidecarInjector) newTrialToken(trial *trialsv1beta1.Trial) (string, error) {
+	key, err := katibmanagerv1beta1.ReadTokenFile(s.trialTokenKeyFile)
+	if err != nil {
+		return "", err
+	}
+	return katibmanagerv1beta1.NewTrialToken([]byte(key), katibmanagerv1beta1.TrialTokenClaims{
+		Namespace:      trial.Namespace,
+		ExperimentName: trial.ObjectMeta.Labels[consts.LabelExperimentName],
+		TrialName:      trial.Name,
+		TrialUID:       string(trial.UID),
+		ExpiresAt:      time.Now().Add(s.trialTokenLifetime).Unix(),
+	})
+}
+
 func (s *SidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Trial, originalPod *v1.Pod) (*v1.Container, error) {
 	mc := trial.Spec.MetricsCollector
 	if mc.Collector.Kind == common.CustomCollector {
@@ -202,13 +290,7 @@ func (s *SidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Tria
 		metricNames += v
 	}
 
-	// Convert rules to flag value with name;value;comparison;startStep order, e.g. accuracy;0.8;less;4.
-	// If start step is empty, we apply rule from the first recorded metrics and flag is equal to accuracy;0.8;less;0.
-	earlyStoppingRules := []string{}
-	for _, rule := range trial.Spec.EarlyStoppingRules {
-		newRule := rule.Name + ";" + rule.Value + ";" + string(rule.Comparison) + ";" + strconv.Itoa(rule.StartStep)
-		earlyStoppingRules = append(earlyStoppingRules, newRule)
-	}
+	earlyStoppingRules := getEarlyStoppingRuleArgs(trial)
 	metricsCollectorConfigData, err := katibconfig.GetMetricsCollectorConfigData(mc.Collector.Kind, s.client)
 	if err != nil {
 		return nil, err
@@ -296,6 +378,8 @@ func (s *SidecarInjector) getKatibJob(object *unstructured.Unstructured, namespa
 
 func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, metricNames string, mc common.MetricsCollectorSpec, metricsCollectorConfigData katibconfig.MetricsCollectorConfig, esRules []string) ([]string, error) {
 	args := []string{"-t", trial.Name, "-m", metricNames, "-o-type", string(trial.Spec.Objective.Type), "-s-db", katibmanagerv1beta1.GetDBManagerAddr()}
+	// Observation logs are stored with the Trial namespace, Experiment name and Trial UID.
+	args = append(args, "-n", trial.Namespace, "-e", trial.ObjectMeta.Labels[consts.LabelExperimentName], "-uid", string(trial.UID))
 	if mountPath, _ := getMountPath(mc); mountPath != "" {
 		args = append(args, "-path", mountPath)
 	}
@@ -305,11 +389,22 @@ func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, me
 	if mc.Collector.Kind == common.FileCollector && mc.Source != nil {
 		if mc.Source.FileSystemPath != nil {
 			args = append(args, "-format", string(mc.Source.FileSystemPath.Format))
+			if mc.Source.FileSystemPath.TimestampKey != "" {
+				args = append(args, "-timestamp-key", mc.Source.FileSystemPath.TimestampKey)
+			}
 		}
 	}
 	if mc.Collector.Kind == common.StdOutCollector {
 		args = append(args, "-format", string(common.TextFormat))
 	}
+	if mc.Collector.Kind == common.PrometheusMetricCollector {
+		args = append(args, "-url", getPrometheusMetricsURL(mc))
+	}
+	// Metrics of the file collector are flushed as it is set in Katib config.
+	if flushInterval := metricsCollectorConfigData.FlushInterval; flushInterval != nil &&
+		(mc.Collector.Kind == common.StdOutCollector || mc.Collector.Kind == common.FileCollector) {
+		args = append(args, "-flush-interval", flushInterval.Duration.String())
+	}
 	if metricsCollectorConfigData.WaitAllProcesses != nil {
 		args = append(args, "-w", strconv.FormatBool(*metricsCollectorConfigData.WaitAllProcesses))
 	}
@@ -328,6 +423,20 @@ func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, me
 			return nil, err
 		}
 		args = append(args, "-s-earlystop", util.GetEarlyStoppingEndpoint(suggestion))
+		// Training processes are stopped as it is set in Katib config.
+		// Only the file and TensorFlow event metrics collectors stop training processes by themselves.
+		if termination := metricsCollectorConfigData.TrainingTermination; termination != nil &&
+			(mc.Collector.Kind == common.StdOutCollector || mc.Collector.Kind == common.FileCollector || mc.Collector.Kind == common.TfEventCollector) {
+			if termination.Mode != "" {
+				args = append(args, "-stop-mode", termination.Mode)
+			}
+			if termination.Signal != "" {
+				args = append(args, "-stop-signal", termination.Signal)
+			}
+			if termination.GracePeriod != nil {
+				args = append(args, "-stop-grace-period", termination.GracePeriod.Duration.String())
+			}
+		}
 	}
 
 	return args, nil
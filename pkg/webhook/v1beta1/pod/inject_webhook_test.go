@@ -19,8 +19,10 @@ package pod
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -34,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -43,6 +46,7 @@ import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
@@ -342,6 +346,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-n", testNamespace,
+				"-e", testSuggestionName,
+				"-uid", string(testTrial.UID),
 				"-path", common.DefaultFilePath,
 				"-format", string(common.TextFormat),
 				"-w", "false",
@@ -374,6 +381,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-n", testNamespace,
+				"-e", testSuggestionName,
+				"-uid", string(testTrial.UID),
 				"-path", testPath,
 				"-f", "{mn1: ([a-b]), mv1: [0-9]};{mn2: ([a-b]), mv2: ([0-9])}",
 				"-format", string(common.TextFormat),
@@ -389,8 +399,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				},
 				Source: &common.SourceSpec{
 					FileSystemPath: &common.FileSystemPath{
-						Path:   testPath,
-						Format: common.JsonFormat,
+						Path:         testPath,
+						Format:       common.JsonFormat,
+						TimestampKey: "meta.time",
 					},
 				},
 			},
@@ -400,8 +411,12 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-n", testNamespace,
+				"-e", testSuggestionName,
+				"-uid", string(testTrial.UID),
 				"-path", testPath,
 				"-format", string(common.JsonFormat),
+				"-timestamp-key", "meta.time",
 			},
 			Name: "File MC with Json Format",
 		},
@@ -424,6 +439,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-n", testNamespace,
+				"-e", testSuggestionName,
+				"-uid", string(testTrial.UID),
 				"-path", testPath,
 			},
 			Name: "Tf Event MC",
@@ -442,6 +460,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-n", testNamespace,
+				"-e", testSuggestionName,
+				"-uid", string(testTrial.UID),
 			},
 			Name: "Custom MC without Path",
 		},
@@ -464,6 +485,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-n", testNamespace,
+				"-e", testSuggestionName,
+				"-uid", string(testTrial.UID),
 				"-path", testPath,
 			},
 			Name: "Custom MC with Path",
@@ -482,6 +506,10 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-n", testNamespace,
+				"-e", testSuggestionName,
+				"-uid", string(testTrial.UID),
+				"-url", "http://localhost:8080/metrics",
 			},
 			Name: "Prometheus MC without Path",
 		},
@@ -500,6 +528,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-n", testNamespace,
+				"-e", testSuggestionName,
+				"-uid", string(testTrial.UID),
 				"-path", common.DefaultFilePath,
 				"-format", string(common.TextFormat),
 				"-stop-rule", earlyStoppingRules[0],
@@ -657,6 +688,98 @@ func TestMutateMetricsCollectorVolume(t *testing.T) {
 	}
 }
 
+func TestInjectDBManagerClientCredentials(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	if err := ioutil.WriteFile(keyFile, []byte("test-key\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	si := &SidecarInjector{trialTokenKeyFile: keyFile, trialTokenLifetime: time.Hour}
+	trial := &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trial-name",
+			Namespace: "trial-namespace",
+			UID:       "trial-uid",
+			Labels: map[string]string{
+				consts.LabelExperimentName: "experiment-name",
+			},
+		},
+	}
+	t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, "/etc/katib/db-manager/ca.crt")
+	t.Setenv(consts.KatibDBManagerTokenFileEnvName, "/etc/katib/db-manager/token")
+
+	pod := &v1.Pod{}
+	container := &v1.Container{
+		Name: "metrics-logger-and-collector",
+	}
+	if err := si.injectDBManagerClientCredentials(pod, container, trial); err != nil {
+		t.Fatalf("injectDBManagerClientCredentials failed: %v", err)
+	}
+
+	expectedVolumes := []v1.Volume{
+		{
+			Name: consts.DBManagerClientVolumeName,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: "trial-name-db-manager-client",
+				},
+			},
+		},
+	}
+	if !equality.Semantic.DeepEqual(pod.Spec.Volumes, expectedVolumes) {
+		t.Errorf("Expected volumes %v, got %v", expectedVolumes, pod.Spec.Volumes)
+	}
+	expectedVolumeMounts := []v1.VolumeMount{
+		{
+			Name:      consts.DBManagerClientVolumeName,
+			MountPath: consts.DBManagerClientMountPath,
+			ReadOnly:  true,
+		},
+	}
+	if !equality.Semantic.DeepEqual(container.VolumeMounts, expectedVolumeMounts) {
+		t.Errorf("Expected volume mounts %v, got %v", expectedVolumeMounts, container.VolumeMounts)
+	}
+	// Only the CA certificate and the Trial token are passed, the admin token stays in Katib namespace.
+	if len(container.Env) != 2 {
+		t.Fatalf("Expected CA file and Trial token envs, got %v", container.Env)
+	}
+	expectedCAEnv := v1.EnvVar{
+		Name:  consts.KatibDBManagerTLSCAFileEnvName,
+		Value: filepath.Join(consts.DBManagerClientMountPath, consts.DBManagerClientCAKey),
+	}
+	if container.Env[0] != expectedCAEnv {
+		t.Errorf("Expected CA file env %v, got %v", expectedCAEnv, container.Env[0])
+	}
+	if container.Env[1].Name != consts.KatibTrialTokenEnvName {
+		t.Fatalf("Expected Trial token env, got %v", container.Env[1])
+	}
+	claims, err := katibmanagerv1beta1.VerifyTrialToken([]byte("test-key"), container.Env[1].Value, time.Now())
+	if err != nil {
+		t.Fatalf("Injected token is invalid: %v", err)
+	}
+	if claims.Namespace != "trial-namespace" || claims.TrialName != "trial-name" || claims.TrialUID != "trial-uid" {
+		t.Errorf("Injected token is not scoped to the Trial, got claims %v", *claims)
+	}
+
+	// Without Trial token key only the CA certificate is mounted.
+	noKeyContainer := &v1.Container{}
+	if err = (&SidecarInjector{}).injectDBManagerClientCredentials(&v1.Pod{}, noKeyContainer, trial); err != nil {
+		t.Fatalf("injectDBManagerClientCredentials failed: %v", err)
+	}
+	if len(noKeyContainer.Env) != 1 || noKeyContainer.Env[0] != expectedCAEnv {
+		t.Errorf("Expected only CA file env without Trial token key, got %v", noKeyContainer.Env)
+	}
+
+	t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, "")
+	insecurePod := &v1.Pod{}
+	insecureContainer := &v1.Container{}
+	if err = si.injectDBManagerClientCredentials(insecurePod, insecureContainer, trial); err != nil {
+		t.Fatalf("injectDBManagerClientCredentials failed: %v", err)
+	}
+	if len(insecurePod.Spec.Volumes) != 0 || len(insecureContainer.Env) != 0 {
+		t.Errorf("Expected no volumes and envs for insecure connection, got %v and %v", insecurePod.Spec.Volumes, insecureContainer.Env)
+	}
+}
+
 func TestGetSidecarContainerName(t *testing.T) {
 	testCases := []struct {
 		CollectorKind         common.CollectorKind
@@ -680,6 +803,184 @@ func TestGetSidecarContainerName(t *testing.T) {
 	}
 }
 
+func TestGetEarlyStoppingRuleArgs(t *testing.T) {
+	trial := &trialsv1beta1.Trial{
+		Spec: trialsv1beta1.TrialSpec{
+			Objective: &common.ObjectiveSpec{
+				ObjectiveMetricName: "accuracy",
+				MetricStrategies: []common.MetricStrategy{
+					{Name: "accuracy", Value: common.ExtractByMax},
+					{Name: "loss", Value: common.ExtractByLatest},
+				},
+			},
+			EarlyStoppingRules: []common.EarlyStoppingRule{
+				{
+					Name:       "accuracy",
+					Value:      "0.6",
+					Comparison: common.ComparisonTypeLess,
+					StartStep:  5,
+				},
+				{
+					Name:       "loss",
+					Value:      "2",
+					Comparison: common.ComparisonTypeGreater,
+					Strategy:   common.EarlyStoppingStrategyMovingAverage,
+					WindowSize: 3,
+				},
+				{
+					Name:       "epoch",
+					Value:      "10",
+					Comparison: common.ComparisonTypeEqual,
+				},
+			},
+		},
+	}
+	expectedArgs := []string{
+		"accuracy;0.6;less;5;max;0",
+		"loss;2;greater;0;movingAverage;3",
+		"epoch;10;equal;0",
+	}
+
+	args := getEarlyStoppingRuleArgs(trial)
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Errorf("Expected stop rule args %v, got %v", expectedArgs, args)
+	}
+}
+
+func TestInjectTrialToken(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	if err := ioutil.WriteFile(keyFile, []byte("test-key\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	si := &SidecarInjector{trialTokenKeyFile: keyFile, trialTokenLifetime: time.Hour}
+
+	trial := &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trial-name",
+			Namespace: "trial-namespace",
+			UID:       "trial-uid",
+			Labels: map[string]string{
+				consts.LabelExperimentName: "experiment-name",
+			},
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			PrimaryContainerName: "training-container",
+		},
+	}
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "sidecar"},
+				{Name: "training-container"},
+			},
+		},
+	}
+
+	if err := si.injectTrialToken(pod, trial); err != nil {
+		t.Fatalf("injectTrialToken failed: %v", err)
+	}
+	if len(pod.Spec.Containers[0].Env) != 0 {
+		t.Errorf("Envs must be injected only to the primary container, got %v", pod.Spec.Containers[0].Env)
+	}
+	env := map[string]string{}
+	for _, e := range pod.Spec.Containers[1].Env {
+		env[e.Name] = e.Value
+	}
+	if env[consts.KatibTrialNameEnvName] != "trial-name" {
+		t.Errorf("Expected Trial name env trial-name, got %v", env[consts.KatibTrialNameEnvName])
+	}
+	if env[consts.KatibMetricsPushURLEnvName] != katibmanagerv1beta1.GetDBManagerPushURL() {
+		t.Errorf("Expected push URL env %v, got %v", katibmanagerv1beta1.GetDBManagerPushURL(), env[consts.KatibMetricsPushURLEnvName])
+	}
+	if _, ok := env[consts.KatibMetricsPushCAFileEnvName]; ok || len(pod.Spec.Volumes) != 0 {
+		t.Errorf("CA certificate must not be mounted without TLS, got env %v and volumes %v", env, pod.Spec.Volumes)
+	}
+	now := time.Now()
+	claims, err := katibmanagerv1beta1.VerifyTrialToken([]byte("test-key"), env[consts.KatibTrialTokenEnvName], now)
+	if err != nil {
+		t.Fatalf("Injected token is invalid: %v", err)
+	}
+	if _, err = katibmanagerv1beta1.VerifyTrialToken([]byte("test-key"), env[consts.KatibTrialTokenEnvName], now.Add(time.Hour+time.Second)); err == nil {
+		t.Errorf("Injected token must expire after the Trial token lifetime")
+	}
+	expectedClaims := katibmanagerv1beta1.TrialTokenClaims{
+		Namespace:      "trial-namespace",
+		ExperimentName: "experiment-name",
+		TrialName:      "trial-name",
+		TrialUID:       "trial-uid",
+		ExpiresAt:      claims.ExpiresAt,
+	}
+	if *claims != expectedClaims {
+		t.Errorf("Expected token claims %v, got %v", expectedClaims, *claims)
+	}
+
+	// Training code verifies Katib DB Manager by the CA certificate if TLS is used.
+	t.Setenv(consts.KatibDBManagerTLSCAFileEnvName, "/etc/katib/db-manager/ca.crt")
+	tlsPod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "training-container"},
+			},
+		},
+	}
+	if err = si.injectTrialToken(tlsPod, trial); err != nil {
+		t.Fatalf("injectTrialToken failed: %v", err)
+	}
+	env = map[string]string{}
+	for _, e := range tlsPod.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	if url := env[consts.KatibMetricsPushURLEnvName]; !strings.HasPrefix(url, "https://") {
+		t.Errorf("Expected HTTPS push URL, got %v", url)
+	}
+	expectedCAFile := filepath.Join(consts.DBManagerClientMountPath, consts.DBManagerClientCAKey)
+	if env[consts.KatibMetricsPushCAFileEnvName] != expectedCAFile {
+		t.Errorf("Expected CA file env %v, got %v", expectedCAFile, env[consts.KatibMetricsPushCAFileEnvName])
+	}
+	if len(tlsPod.Spec.Volumes) != 1 || tlsPod.Spec.Volumes[0].Secret.SecretName != katibmanagerv1beta1.GetDBManagerClientSecretName("trial-name") {
+		t.Errorf("Expected volume with the Secret %v, got %v", katibmanagerv1beta1.GetDBManagerClientSecretName("trial-name"), tlsPod.Spec.Volumes)
+	}
+
+	trial.Spec.PrimaryContainerName = "invalid"
+	if err = si.injectTrialToken(pod, trial); err == nil {
+		t.Errorf("Expected error for missing primary container")
+	}
+}
+
+func TestGetPrometheusMetricsURL(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		MCSpec      common.MetricsCollectorSpec
+		ExpectedURL string
+	}{
+		{
+			Name:        "Default endpoint",
+			MCSpec:      common.MetricsCollectorSpec{},
+			ExpectedURL: "http://localhost:8080/metrics",
+		},
+		{
+			Name: "Custom endpoint",
+			MCSpec: common.MetricsCollectorSpec{
+				Source: &common.SourceSpec{
+					HttpGet: &v1.HTTPGetAction{
+						Scheme: v1.URISchemeHTTPS,
+						Host:   "127.0.0.1",
+						Port:   intstr.FromInt(9090),
+						Path:   "/custom/metrics",
+					},
+				},
+			},
+			ExpectedURL: "https://127.0.0.1:9090/custom/metrics",
+		},
+	}
+
+	for _, tc := range testCases {
+		if url := getPrometheusMetricsURL(tc.MCSpec); url != tc.ExpectedURL {
+			t.Errorf("Case %v. Expected URL: %v, got %v", tc.Name, tc.ExpectedURL, url)
+		}
+	}
+}
+
 func TestGetKatibJob(t *testing.T) {
 	// Start test k8s server
 	envTest := &envtest.Environment{
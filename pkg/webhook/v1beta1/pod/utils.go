@@ -19,7 +19,11 @@ package pod
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -31,6 +35,8 @@ import (
 
 	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 )
 
@@ -138,6 +144,33 @@ func getMountPath(mc common.MetricsCollectorSpec) (string, common.FileSystemKind
 	}
 }
 
+// getPrometheusMetricsURL returns URL of the metrics endpoint which is scraped from the sidecar container.
+// Metrics collector and training containers share the network, so host is localhost by default.
+// Named ports are rejected by the Experiment validator, so port is always a number.
+func getPrometheusMetricsURL(mc common.MetricsCollectorSpec) string {
+	httpGet := v1.HTTPGetAction{}
+	if mc.Source != nil && mc.Source.HttpGet != nil {
+		httpGet = *mc.Source.HttpGet
+	}
+	scheme := strings.ToLower(string(httpGet.Scheme))
+	if scheme == "" {
+		scheme = "http"
+	}
+	host := httpGet.Host
+	if host == "" {
+		host = "localhost"
+	}
+	port := httpGet.Port.String()
+	if port == "0" {
+		port = strconv.Itoa(common.DefaultPrometheusPort)
+	}
+	path := httpGet.Path
+	if path == "" {
+		path = common.DefaultPrometheusPath
+	}
+	return (&url.URL{Scheme: scheme, Host: net.JoinHostPort(host, port), Path: path}).String()
+}
+
 func needWrapWorkerContainer(mc common.MetricsCollectorSpec) bool {
 	mcKind := mc.Collector.Kind
 	for _, kind := range NeedWrapWorkerMetricsCollectorList {
@@ -211,6 +244,32 @@ func getEarlyStoppingCommand(metricsFileDir string, pathKind common.FileSystemKi
 		pidFile, pidFileCondition, mccommon.TrainingEarlyStopped, containerStopped, containerFailed)
 }
 
+// getEarlyStoppingRuleArgs converts the Trial early stopping rules to the stop rule flag values
+// with name;value;comparison;startStep;strategy;windowSize order, e.g. accuracy;0.8;less;4;movingAverage;3.
+// If start step is empty, we apply rule from the first recorded metrics and flag is equal to accuracy;0.8;less;0.
+// If strategy is empty, the metric strategy of the Trial objective is used.
+func getEarlyStoppingRuleArgs(trial *trialsv1beta1.Trial) []string {
+	metricStrategies := make(map[string]common.MetricStrategyType)
+	for _, strategy := range trial.Spec.Objective.MetricStrategies {
+		metricStrategies[strategy.Name] = strategy.Value
+	}
+	earlyStoppingRules := []string{}
+	for _, rule := range trial.Spec.EarlyStoppingRules {
+		if rule.Strategy == "" {
+			switch metricStrategies[rule.Name] {
+			case common.ExtractByMin:
+				rule.Strategy = common.EarlyStoppingStrategyMin
+			case common.ExtractByMax:
+				rule.Strategy = common.EarlyStoppingStrategyMax
+			case common.ExtractByLatest:
+				rule.Strategy = common.EarlyStoppingStrategyLatest
+			}
+		}
+		earlyStoppingRules = append(earlyStoppingRules, mccommon.FormatStopRule(rule))
+	}
+	return earlyStoppingRules
+}
+
 func getMarkCompletedCommand(metricsFileDir string, pathKind common.FileSystemKind) string {
 	// $$$$ is process id in shell
 	pidFile := filepath.Join(metricsFileDir, "$$$$.pid")
@@ -267,3 +326,29 @@ func getSidecarContainerName(cKind common.CollectorKind) string {
 		return mccommon.MetricCollectorContainerName
 	}
 }
+
+// mutateDBManagerCAVolume mounts the CA certificate to verify Katib DB Manager into the container which reports metrics
+// and sets the path of the CA certificate to the env. Secret is created by Trial controller in the Trial namespace,
+// see reconcileDBManagerClientSecret.
+func mutateDBManagerCAVolume(pod *v1.Pod, container *v1.Container, trial *trialsv1beta1.Trial, envName string) {
+	if os.Getenv(consts.KatibDBManagerTLSCAFileEnvName) == "" {
+		return
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: consts.DBManagerClientVolumeName,
+		VolumeSource: v1.VolumeSource{
+			Secret: &v1.SecretVolumeSource{
+				SecretName: katibmanagerv1beta1.GetDBManagerClientSecretName(trial.Name),
+			},
+		},
+	})
+	addContainerVolumeMount(container, &v1.VolumeMount{
+		Name:      consts.DBManagerClientVolumeName,
+		MountPath: consts.DBManagerClientMountPath,
+		ReadOnly:  true,
+	})
+	container.Env = append(container.Env, v1.EnvVar{
+		Name:  envName,
+		Value: filepath.Join(consts.DBManagerClientMountPath, consts.DBManagerClientCAKey),
+	})
+}
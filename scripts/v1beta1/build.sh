@@ -71,12 +71,11 @@ docker build --platform "linux/$ARCH" -t "${REGISTRY}/cert-generator:${TAG}" -f
 echo -e "\nBuilding file metrics collector image...\n"
 docker build --platform "linux/$ARCH" -t "${REGISTRY}/file-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/file-metricscollector/Dockerfile .
 
+echo -e "\nBuilding Prometheus metrics collector image...\n"
+docker build --platform "linux/$ARCH" -t "${REGISTRY}/prometheus-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/prometheus-metricscollector/Dockerfile .
+
 echo -e "\nBuilding TF Event metrics collector image...\n"
-if [ "$ARCH" == "ppc64le" ]; then
-  docker build --platform "linux/$ARCH" -t "${REGISTRY}/tfevent-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile.ppc64le .
-else
-  docker build --platform "linux/$ARCH" -t "${REGISTRY}/tfevent-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile .
-fi
+docker build --platform "linux/$ARCH" -t "${REGISTRY}/tfevent-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile .
 
 # Suggestion images
 echo -e "\nBuilding suggestion images..."
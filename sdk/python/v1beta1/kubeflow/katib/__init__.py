@@ -27,8 +27,10 @@ from kubeflow.katib.exceptions import ApiValueError
 from kubeflow.katib.exceptions import ApiKeyError
 from kubeflow.katib.exceptions import ApiException
 # import models into sdk package
+from kubeflow.katib.models.v1beta1_additional_objective import V1beta1AdditionalObjective
 from kubeflow.katib.models.v1beta1_algorithm_setting import V1beta1AlgorithmSetting
 from kubeflow.katib.models.v1beta1_algorithm_spec import V1beta1AlgorithmSpec
+from kubeflow.katib.models.v1beta1_budget_spec import V1beta1BudgetSpec
 from kubeflow.katib.models.v1beta1_collector_spec import V1beta1CollectorSpec
 from kubeflow.katib.models.v1beta1_config_map_source import V1beta1ConfigMapSource
 from kubeflow.katib.models.v1beta1_early_stopping_rule import V1beta1EarlyStoppingRule
@@ -47,12 +49,14 @@ from kubeflow.katib.models.v1beta1_metric import V1beta1Metric
 from kubeflow.katib.models.v1beta1_metric_strategy import V1beta1MetricStrategy
 from kubeflow.katib.models.v1beta1_metrics_collector_spec import V1beta1MetricsCollectorSpec
 from kubeflow.katib.models.v1beta1_nas_config import V1beta1NasConfig
+from kubeflow.katib.models.v1beta1_objective_constraint import V1beta1ObjectiveConstraint
 from kubeflow.katib.models.v1beta1_objective_spec import V1beta1ObjectiveSpec
 from kubeflow.katib.models.v1beta1_observation import V1beta1Observation
 from kubeflow.katib.models.v1beta1_operation import V1beta1Operation
 from kubeflow.katib.models.v1beta1_optimal_trial import V1beta1OptimalTrial
 from kubeflow.katib.models.v1beta1_parameter_assignment import V1beta1ParameterAssignment
 from kubeflow.katib.models.v1beta1_parameter_spec import V1beta1ParameterSpec
+from kubeflow.katib.models.v1beta1_plateau_spec import V1beta1PlateauSpec
 from kubeflow.katib.models.v1beta1_source_spec import V1beta1SourceSpec
 from kubeflow.katib.models.v1beta1_suggestion import V1beta1Suggestion
 from kubeflow.katib.models.v1beta1_suggestion_condition import V1beta1SuggestionCondition
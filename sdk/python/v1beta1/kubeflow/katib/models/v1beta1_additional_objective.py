@@ -0,0 +1,178 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1AdditionalObjective(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'goal': 'float',
+        'objective_metric_name': 'str',
+        'type': 'str'
+    }
+
+    attribute_map = {
+        'goal': 'goal',
+        'objective_metric_name': 'objectiveMetricName',
+        'type': 'type'
+    }
+
+    def __init__(self, goal=None, objective_metric_name=None, type=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1AdditionalObjective - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._goal = None
+        self._objective_metric_name = None
+        self._type = None
+        self.discriminator = None
+
+        if goal is not None:
+            self.goal = goal
+        if objective_metric_name is not None:
+            self.objective_metric_name = objective_metric_name
+        if type is not None:
+            self.type = type
+
+    @property
+    def goal(self):
+        """Gets the goal of this V1beta1AdditionalObjective.  # noqa: E501
+
+        Goal is the objective goal that should be reached. Experiment goal is reached when one Trial reaches goals of all objectives which have goal.  # noqa: E501
+
+        :return: The goal of this V1beta1AdditionalObjective.  # noqa: E501
+        :rtype: float
+        """
+        return self._goal
+
+    @goal.setter
+    def goal(self, goal):
+        """Sets the goal of this V1beta1AdditionalObjective.
+
+        Goal is the objective goal that should be reached. Experiment goal is reached when one Trial reaches goals of all objectives which have goal.  # noqa: E501
+
+        :param goal: The goal of this V1beta1AdditionalObjective.  # noqa: E501
+        :type: float
+        """
+
+        self._goal = goal
+
+    @property
+    def objective_metric_name(self):
+        """Gets the objective_metric_name of this V1beta1AdditionalObjective.  # noqa: E501
+
+        ObjectiveMetricName represents metric to optimize.  # noqa: E501
+
+        :return: The objective_metric_name of this V1beta1AdditionalObjective.  # noqa: E501
+        :rtype: str
+        """
+        return self._objective_metric_name
+
+    @objective_metric_name.setter
+    def objective_metric_name(self, objective_metric_name):
+        """Sets the objective_metric_name of this V1beta1AdditionalObjective.
+
+        ObjectiveMetricName represents metric to optimize.  # noqa: E501
+
+        :param objective_metric_name: The objective_metric_name of this V1beta1AdditionalObjective.  # noqa: E501
+        :type: str
+        """
+
+        self._objective_metric_name = objective_metric_name
+
+    @property
+    def type(self):
+        """Gets the type of this V1beta1AdditionalObjective.  # noqa: E501
+
+        Type for the objective optimization.  # noqa: E501
+
+        :return: The type of this V1beta1AdditionalObjective.  # noqa: E501
+        :rtype: str
+        """
+        return self._type
+
+    @type.setter
+    def type(self, type):
+        """Sets the type of this V1beta1AdditionalObjective.
+
+        Type for the objective optimization.  # noqa: E501
+
+        :param type: The type of this V1beta1AdditionalObjective.  # noqa: E501
+        :type: str
+        """
+
+        self._type = type
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1AdditionalObjective):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1AdditionalObjective):
+            return True
+
+        return self.to_dict() != other.to_dict()
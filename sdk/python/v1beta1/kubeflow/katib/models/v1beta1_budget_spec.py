@@ -0,0 +1,234 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1BudgetSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'kill_active_trials': 'bool',
+        'max_cpu_hours': 'float',
+        'max_duration_seconds': 'int',
+        'max_gpu_hours': 'float',
+        'max_trial_runtime_seconds': 'int'
+    }
+
+    attribute_map = {
+        'kill_active_trials': 'killActiveTrials',
+        'max_cpu_hours': 'maxCPUHours',
+        'max_duration_seconds': 'maxDurationSeconds',
+        'max_gpu_hours': 'maxGPUHours',
+        'max_trial_runtime_seconds': 'maxTrialRuntimeSeconds'
+    }
+
+    def __init__(self, kill_active_trials=None, max_cpu_hours=None, max_duration_seconds=None, max_gpu_hours=None, max_trial_runtime_seconds=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1BudgetSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._kill_active_trials = None
+        self._max_cpu_hours = None
+        self._max_duration_seconds = None
+        self._max_gpu_hours = None
+        self._max_trial_runtime_seconds = None
+        self.discriminator = None
+
+        if kill_active_trials is not None:
+            self.kill_active_trials = kill_active_trials
+        if max_cpu_hours is not None:
+            self.max_cpu_hours = max_cpu_hours
+        if max_duration_seconds is not None:
+            self.max_duration_seconds = max_duration_seconds
+        if max_gpu_hours is not None:
+            self.max_gpu_hours = max_gpu_hours
+        if max_trial_runtime_seconds is not None:
+            self.max_trial_runtime_seconds = max_trial_runtime_seconds
+
+    @property
+    def kill_active_trials(self):
+        """Gets the kill_active_trials of this V1beta1BudgetSpec.  # noqa: E501
+
+        KillActiveTrials indicates that pending and running Trials are killed once the budget is exhausted. Otherwise, new Trials are not created, but active Trials are running until completion.  # noqa: E501
+
+        :return: The kill_active_trials of this V1beta1BudgetSpec.  # noqa: E501
+        :rtype: bool
+        """
+        return self._kill_active_trials
+
+    @kill_active_trials.setter
+    def kill_active_trials(self, kill_active_trials):
+        """Sets the kill_active_trials of this V1beta1BudgetSpec.
+
+        KillActiveTrials indicates that pending and running Trials are killed once the budget is exhausted. Otherwise, new Trials are not created, but active Trials are running until completion.  # noqa: E501
+
+        :param kill_active_trials: The kill_active_trials of this V1beta1BudgetSpec.  # noqa: E501
+        :type: bool
+        """
+
+        self._kill_active_trials = kill_active_trials
+
+    @property
+    def max_cpu_hours(self):
+        """Gets the max_cpu_hours of this V1beta1BudgetSpec.  # noqa: E501
+
+        Max cumulative CPU-hours of all Trials. CPU-hours of the Trial are derived from CPU requests of pods in the Trial run spec and the Trial runtime.  # noqa: E501
+
+        :return: The max_cpu_hours of this V1beta1BudgetSpec.  # noqa: E501
+        :rtype: float
+        """
+        return self._max_cpu_hours
+
+    @max_cpu_hours.setter
+    def max_cpu_hours(self, max_cpu_hours):
+        """Sets the max_cpu_hours of this V1beta1BudgetSpec.
+
+        Max cumulative CPU-hours of all Trials. CPU-hours of the Trial are derived from CPU requests of pods in the Trial run spec and the Trial runtime.  # noqa: E501
+
+        :param max_cpu_hours: The max_cpu_hours of this V1beta1BudgetSpec.  # noqa: E501
+        :type: float
+        """
+
+        self._max_cpu_hours = max_cpu_hours
+
+    @property
+    def max_duration_seconds(self):
+        """Gets the max_duration_seconds of this V1beta1BudgetSpec.  # noqa: E501
+
+        Max duration in seconds of the Experiment since it was started.  # noqa: E501
+
+        :return: The max_duration_seconds of this V1beta1BudgetSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_duration_seconds
+
+    @max_duration_seconds.setter
+    def max_duration_seconds(self, max_duration_seconds):
+        """Sets the max_duration_seconds of this V1beta1BudgetSpec.
+
+        Max duration in seconds of the Experiment since it was started.  # noqa: E501
+
+        :param max_duration_seconds: The max_duration_seconds of this V1beta1BudgetSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._max_duration_seconds = max_duration_seconds
+
+    @property
+    def max_gpu_hours(self):
+        """Gets the max_gpu_hours of this V1beta1BudgetSpec.  # noqa: E501
+
+        Max cumulative GPU-hours of all Trials. GPU-hours of the Trial are derived from GPU requests of pods in the Trial run spec and the Trial runtime.  # noqa: E501
+
+        :return: The max_gpu_hours of this V1beta1BudgetSpec.  # noqa: E501
+        :rtype: float
+        """
+        return self._max_gpu_hours
+
+    @max_gpu_hours.setter
+    def max_gpu_hours(self, max_gpu_hours):
+        """Sets the max_gpu_hours of this V1beta1BudgetSpec.
+
+        Max cumulative GPU-hours of all Trials. GPU-hours of the Trial are derived from GPU requests of pods in the Trial run spec and the Trial runtime.  # noqa: E501
+
+        :param max_gpu_hours: The max_gpu_hours of this V1beta1BudgetSpec.  # noqa: E501
+        :type: float
+        """
+
+        self._max_gpu_hours = max_gpu_hours
+
+    @property
+    def max_trial_runtime_seconds(self):
+        """Gets the max_trial_runtime_seconds of this V1beta1BudgetSpec.  # noqa: E501
+
+        Max cumulative runtime in seconds of all Trials. Trial runtime is the time between the Trial start and completion.  # noqa: E501
+
+        :return: The max_trial_runtime_seconds of this V1beta1BudgetSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_trial_runtime_seconds
+
+    @max_trial_runtime_seconds.setter
+    def max_trial_runtime_seconds(self, max_trial_runtime_seconds):
+        """Sets the max_trial_runtime_seconds of this V1beta1BudgetSpec.
+
+        Max cumulative runtime in seconds of all Trials. Trial runtime is the time between the Trial start and completion.  # noqa: E501
+
+        :param max_trial_runtime_seconds: The max_trial_runtime_seconds of this V1beta1BudgetSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._max_trial_runtime_seconds = max_trial_runtime_seconds
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1BudgetSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1BudgetSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
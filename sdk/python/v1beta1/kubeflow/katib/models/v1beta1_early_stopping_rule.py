@@ -36,17 +36,21 @@ class V1beta1EarlyStoppingRule(object):
         'comparison': 'str',
         'name': 'str',
         'start_step': 'int',
-        'value': 'str'
+        'strategy': 'str',
+        'value': 'str',
+        'window_size': 'int'
     }
 
     attribute_map = {
         'comparison': 'comparison',
         'name': 'name',
         'start_step': 'startStep',
-        'value': 'value'
+        'strategy': 'strategy',
+        'value': 'value',
+        'window_size': 'windowSize'
     }
 
-    def __init__(self, comparison=None, name=None, start_step=None, value=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, comparison=None, name=None, start_step=None, strategy=None, value=None, window_size=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1EarlyStoppingRule - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -55,7 +59,9 @@ class V1beta1EarlyStoppingRule(object):
         self._comparison = None
         self._name = None
         self._start_step = None
+        self._strategy = None
         self._value = None
+        self._window_size = None
         self.discriminator = None
 
         if comparison is not None:
@@ -64,8 +70,12 @@ class V1beta1EarlyStoppingRule(object):
             self.name = name
         if start_step is not None:
             self.start_step = start_step
+        if strategy is not None:
+            self.strategy = strategy
         if value is not None:
             self.value = value
+        if window_size is not None:
+            self.window_size = window_size
 
     @property
     def comparison(self):
@@ -136,6 +146,29 @@ class V1beta1EarlyStoppingRule(object):
 
         self._start_step = start_step
 
+    @property
+    def strategy(self):
+        """Gets the strategy of this V1beta1EarlyStoppingRule.  # noqa: E501
+
+        Strategy defines how the reported metric values are aggregated before comparison with the rule value, one of min, max, latest or movingAverage. If strategy is empty, the best value is used for the objective metric and the latest value for other metrics.  # noqa: E501
+
+        :return: The strategy of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :rtype: str
+        """
+        return self._strategy
+
+    @strategy.setter
+    def strategy(self, strategy):
+        """Sets the strategy of this V1beta1EarlyStoppingRule.
+
+        Strategy defines how the reported metric values are aggregated before comparison with the rule value, one of min, max, latest or movingAverage. If strategy is empty, the best value is used for the objective metric and the latest value for other metrics.  # noqa: E501
+
+        :param strategy: The strategy of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :type: str
+        """
+
+        self._strategy = strategy
+
     @property
     def value(self):
         """Gets the value of this V1beta1EarlyStoppingRule.  # noqa: E501
@@ -159,6 +192,29 @@ class V1beta1EarlyStoppingRule(object):
 
         self._value = value
 
+    @property
+    def window_size(self):
+        """Gets the window_size of this V1beta1EarlyStoppingRule.  # noqa: E501
+
+        WindowSize defines quantity of the latest reported values that are averaged by the movingAverage strategy. Rule is applied once WindowSize values are received.  # noqa: E501
+
+        :return: The window_size of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :rtype: int
+        """
+        return self._window_size
+
+    @window_size.setter
+    def window_size(self, window_size):
+        """Sets the window_size of this V1beta1EarlyStoppingRule.
+
+        WindowSize defines quantity of the latest reported values that are averaged by the movingAverage strategy. Rule is applied once WindowSize values are received.  # noqa: E501
+
+        :param window_size: The window_size of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :type: int
+        """
+
+        self._window_size = window_size
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
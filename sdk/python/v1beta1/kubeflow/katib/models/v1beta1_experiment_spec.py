@@ -34,6 +34,7 @@ class V1beta1ExperimentSpec(object):
     """
     openapi_types = {
         'algorithm': 'V1beta1AlgorithmSpec',
+        'budget': 'V1beta1BudgetSpec',
         'early_stopping': 'V1beta1EarlyStoppingSpec',
         'max_failed_trial_count': 'int',
         'max_trial_count': 'int',
@@ -42,12 +43,16 @@ class V1beta1ExperimentSpec(object):
         'objective': 'V1beta1ObjectiveSpec',
         'parallel_trial_count': 'int',
         'parameters': 'list[V1beta1ParameterSpec]',
+        'plateau': 'V1beta1PlateauSpec',
         'resume_policy': 'str',
+        'suspend': 'bool',
+        'suspend_policy': 'str',
         'trial_template': 'V1beta1TrialTemplate'
     }
 
     attribute_map = {
         'algorithm': 'algorithm',
+        'budget': 'budget',
         'early_stopping': 'earlyStopping',
         'max_failed_trial_count': 'maxFailedTrialCount',
         'max_trial_count': 'maxTrialCount',
@@ -56,17 +61,21 @@ class V1beta1ExperimentSpec(object):
         'objective': 'objective',
         'parallel_trial_count': 'parallelTrialCount',
         'parameters': 'parameters',
+        'plateau': 'plateau',
         'resume_policy': 'resumePolicy',
+        'suspend': 'suspend',
+        'suspend_policy': 'suspendPolicy',
         'trial_template': 'trialTemplate'
     }
 
-    def __init__(self, algorithm=None, early_stopping=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameters=None, resume_policy=None, trial_template=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, algorithm=None, budget=None, early_stopping=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameters=None, plateau=None, resume_policy=None, suspend=None, suspend_policy=None, trial_template=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ExperimentSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
         self._algorithm = None
+        self._budget = None
         self._early_stopping = None
         self._max_failed_trial_count = None
         self._max_trial_count = None
@@ -75,12 +84,17 @@ class V1beta1ExperimentSpec(object):
         self._objective = None
         self._parallel_trial_count = None
         self._parameters = None
+        self._plateau = None
         self._resume_policy = None
+        self._suspend = None
+        self._suspend_policy = None
         self._trial_template = None
         self.discriminator = None
 
         if algorithm is not None:
             self.algorithm = algorithm
+        if budget is not None:
+            self.budget = budget
         if early_stopping is not None:
             self.early_stopping = early_stopping
         if max_failed_trial_count is not None:
@@ -97,8 +111,14 @@ class V1beta1ExperimentSpec(object):
             self.parallel_trial_count = parallel_trial_count
         if parameters is not None:
             self.parameters = parameters
+        if plateau is not None:
+            self.plateau = plateau
         if resume_policy is not None:
             self.resume_policy = resume_policy
+        if suspend is not None:
+            self.suspend = suspend
+        if suspend_policy is not None:
+            self.suspend_policy = suspend_policy
         if trial_template is not None:
             self.trial_template = trial_template
 
@@ -123,6 +143,27 @@ class V1beta1ExperimentSpec(object):
 
         self._algorithm = algorithm
 
+    @property
+    def budget(self):
+        """Gets the budget of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The budget of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1BudgetSpec
+        """
+        return self._budget
+
+    @budget.setter
+    def budget(self, budget):
+        """Sets the budget of this V1beta1ExperimentSpec.
+
+
+        :param budget: The budget of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1BudgetSpec
+        """
+
+        self._budget = budget
+
     @property
     def early_stopping(self):
         """Gets the early_stopping of this V1beta1ExperimentSpec.  # noqa: E501
@@ -299,6 +340,27 @@ class V1beta1ExperimentSpec(object):
 
         self._parameters = parameters
 
+    @property
+    def plateau(self):
+        """Gets the plateau of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The plateau of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1PlateauSpec
+        """
+        return self._plateau
+
+    @plateau.setter
+    def plateau(self, plateau):
+        """Sets the plateau of this V1beta1ExperimentSpec.
+
+
+        :param plateau: The plateau of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1PlateauSpec
+        """
+
+        self._plateau = plateau
+
     @property
     def resume_policy(self):
         """Gets the resume_policy of this V1beta1ExperimentSpec.  # noqa: E501
@@ -322,6 +384,52 @@ class V1beta1ExperimentSpec(object):
 
         self._resume_policy = resume_policy
 
+    @property
+    def suspend(self):
+        """Gets the suspend of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Suspend indicates that the experiment is suspended. New trials are not created and suggestion deployment is scaled to zero while the experiment is suspended. Experiment is resumed once the flag is cleared.  # noqa: E501
+
+        :return: The suspend of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: bool
+        """
+        return self._suspend
+
+    @suspend.setter
+    def suspend(self, suspend):
+        """Sets the suspend of this V1beta1ExperimentSpec.
+
+        Suspend indicates that the experiment is suspended. New trials are not created and suggestion deployment is scaled to zero while the experiment is suspended. Experiment is resumed once the flag is cleared.  # noqa: E501
+
+        :param suspend: The suspend of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: bool
+        """
+
+        self._suspend = suspend
+
+    @property
+    def suspend_policy(self):
+        """Gets the suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes how active trials are handled while the experiment is suspended. Defaults to KeepRunning.  # noqa: E501
+
+        :return: The suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._suspend_policy
+
+    @suspend_policy.setter
+    def suspend_policy(self, suspend_policy):
+        """Sets the suspend_policy of this V1beta1ExperimentSpec.
+
+        Describes how active trials are handled while the experiment is suspended. Defaults to KeepRunning.  # noqa: E501
+
+        :param suspend_policy: The suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._suspend_policy = suspend_policy
+
     @property
     def trial_template(self):
         """Gets the trial_template of this V1beta1ExperimentSpec.  # noqa: E501
@@ -38,17 +38,22 @@ class V1beta1ExperimentStatus(object):
         'current_optimal_trial': 'V1beta1OptimalTrial',
         'early_stopped_trial_list': 'list[str]',
         'failed_trial_list': 'list[str]',
+        'infeasible_trial_list': 'list[str]',
         'killed_trial_list': 'list[str]',
         'last_reconcile_time': 'datetime',
         'metrics_unavailable_trial_list': 'list[str]',
+        'pareto_optimal_trials': 'list[V1beta1OptimalTrial]',
         'pending_trial_list': 'list[str]',
         'running_trial_list': 'list[str]',
         'start_time': 'datetime',
         'succeeded_trial_list': 'list[str]',
+        'suspend_time': 'datetime',
+        'suspended_seconds': 'int',
         'trial_metrics_unavailable': 'int',
         'trials': 'int',
         'trials_early_stopped': 'int',
         'trials_failed': 'int',
+        'trials_infeasible': 'int',
         'trials_killed': 'int',
         'trials_pending': 'int',
         'trials_running': 'int',
@@ -61,24 +66,29 @@ class V1beta1ExperimentStatus(object):
         'current_optimal_trial': 'currentOptimalTrial',
         'early_stopped_trial_list': 'earlyStoppedTrialList',
         'failed_trial_list': 'failedTrialList',
+        'infeasible_trial_list': 'infeasibleTrialList',
         'killed_trial_list': 'killedTrialList',
         'last_reconcile_time': 'lastReconcileTime',
         'metrics_unavailable_trial_list': 'metricsUnavailableTrialList',
+        'pareto_optimal_trials': 'paretoOptimalTrials',
         'pending_trial_list': 'pendingTrialList',
         'running_trial_list': 'runningTrialList',
         'start_time': 'startTime',
         'succeeded_trial_list': 'succeededTrialList',
+        'suspend_time': 'suspendTime',
+        'suspended_seconds': 'suspendedSeconds',
         'trial_metrics_unavailable': 'trialMetricsUnavailable',
         'trials': 'trials',
         'trials_early_stopped': 'trialsEarlyStopped',
         'trials_failed': 'trialsFailed',
+        'trials_infeasible': 'trialsInfeasible',
         'trials_killed': 'trialsKilled',
         'trials_pending': 'trialsPending',
         'trials_running': 'trialsRunning',
         'trials_succeeded': 'trialsSucceeded'
     }
 
-    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, early_stopped_trial_list=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, metrics_unavailable_trial_list=None, pending_trial_list=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, trial_metrics_unavailable=None, trials=None, trials_early_stopped=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, early_stopped_trial_list=None, failed_trial_list=None, infeasible_trial_list=None, killed_trial_list=None, last_reconcile_time=None, metrics_unavailable_trial_list=None, pareto_optimal_trials=None, pending_trial_list=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, suspend_time=None, suspended_seconds=None, trial_metrics_unavailable=None, trials=None, trials_early_stopped=None, trials_failed=None, trials_infeasible=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ExperimentStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -89,17 +99,22 @@ class V1beta1ExperimentStatus(object):
         self._current_optimal_trial = None
         self._early_stopped_trial_list = None
         self._failed_trial_list = None
+        self._infeasible_trial_list = None
         self._killed_trial_list = None
         self._last_reconcile_time = None
         self._metrics_unavailable_trial_list = None
+        self._pareto_optimal_trials = None
         self._pending_trial_list = None
         self._running_trial_list = None
         self._start_time = None
         self._succeeded_trial_list = None
+        self._suspend_time = None
+        self._suspended_seconds = None
         self._trial_metrics_unavailable = None
         self._trials = None
         self._trials_early_stopped = None
         self._trials_failed = None
+        self._trials_infeasible = None
         self._trials_killed = None
         self._trials_pending = None
         self._trials_running = None
@@ -116,12 +131,16 @@ class V1beta1ExperimentStatus(object):
             self.early_stopped_trial_list = early_stopped_trial_list
         if failed_trial_list is not None:
             self.failed_trial_list = failed_trial_list
+        if infeasible_trial_list is not None:
+            self.infeasible_trial_list = infeasible_trial_list
         if killed_trial_list is not None:
             self.killed_trial_list = killed_trial_list
         if last_reconcile_time is not None:
             self.last_reconcile_time = last_reconcile_time
         if metrics_unavailable_trial_list is not None:
             self.metrics_unavailable_trial_list = metrics_unavailable_trial_list
+        if pareto_optimal_trials is not None:
+            self.pareto_optimal_trials = pareto_optimal_trials
         if pending_trial_list is not None:
             self.pending_trial_list = pending_trial_list
         if running_trial_list is not None:
@@ -130,6 +149,10 @@ class V1beta1ExperimentStatus(object):
             self.start_time = start_time
         if succeeded_trial_list is not None:
             self.succeeded_trial_list = succeeded_trial_list
+        if suspend_time is not None:
+            self.suspend_time = suspend_time
+        if suspended_seconds is not None:
+            self.suspended_seconds = suspended_seconds
         if trial_metrics_unavailable is not None:
             self.trial_metrics_unavailable = trial_metrics_unavailable
         if trials is not None:
@@ -138,6 +161,8 @@ class V1beta1ExperimentStatus(object):
             self.trials_early_stopped = trials_early_stopped
         if trials_failed is not None:
             self.trials_failed = trials_failed
+        if trials_infeasible is not None:
+            self.trials_infeasible = trials_infeasible
         if trials_killed is not None:
             self.trials_killed = trials_killed
         if trials_pending is not None:
@@ -258,6 +283,29 @@ class V1beta1ExperimentStatus(object):
 
         self._failed_trial_list = failed_trial_list
 
+    @property
+    def infeasible_trial_list(self):
+        """Gets the infeasible_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
+
+        List of trial names which metrics don't satisfy the objective constraints. Infeasible trials are also in the list of their current state.  # noqa: E501
+
+        :return: The infeasible_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._infeasible_trial_list
+
+    @infeasible_trial_list.setter
+    def infeasible_trial_list(self, infeasible_trial_list):
+        """Sets the infeasible_trial_list of this V1beta1ExperimentStatus.
+
+        List of trial names which metrics don't satisfy the objective constraints. Infeasible trials are also in the list of their current state.  # noqa: E501
+
+        :param infeasible_trial_list: The infeasible_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._infeasible_trial_list = infeasible_trial_list
+
     @property
     def killed_trial_list(self):
         """Gets the killed_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
@@ -325,6 +373,29 @@ class V1beta1ExperimentStatus(object):
 
         self._metrics_unavailable_trial_list = metrics_unavailable_trial_list
 
+    @property
+    def pareto_optimal_trials(self):
+        """Gets the pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+
+        Pareto optimal trials of the multi-objective Experiment, which are not dominated by other trials. For the multi-objective Experiment current optimal trial is the best trial by the primary objective.  # noqa: E501
+
+        :return: The pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: list[V1beta1OptimalTrial]
+        """
+        return self._pareto_optimal_trials
+
+    @pareto_optimal_trials.setter
+    def pareto_optimal_trials(self, pareto_optimal_trials):
+        """Sets the pareto_optimal_trials of this V1beta1ExperimentStatus.
+
+        Pareto optimal trials of the multi-objective Experiment, which are not dominated by other trials. For the multi-objective Experiment current optimal trial is the best trial by the primary objective.  # noqa: E501
+
+        :param pareto_optimal_trials: The pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: list[V1beta1OptimalTrial]
+        """
+
+        self._pareto_optimal_trials = pareto_optimal_trials
+
     @property
     def pending_trial_list(self):
         """Gets the pending_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
@@ -415,6 +486,50 @@ class V1beta1ExperimentStatus(object):
 
         self._succeeded_trial_list = succeeded_trial_list
 
+    @property
+    def suspend_time(self):
+        """Gets the suspend_time of this V1beta1ExperimentStatus.  # noqa: E501
+
+
+        :return: The suspend_time of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: datetime
+        """
+        return self._suspend_time
+
+    @suspend_time.setter
+    def suspend_time(self, suspend_time):
+        """Sets the suspend_time of this V1beta1ExperimentStatus.
+
+
+        :param suspend_time: The suspend_time of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: datetime
+        """
+
+        self._suspend_time = suspend_time
+
+    @property
+    def suspended_seconds(self):
+        """Gets the suspended_seconds of this V1beta1ExperimentStatus.  # noqa: E501
+
+        Total time in seconds which the Experiment was suspended before it was resumed last time. Suspended time is not counted in the Experiment budget.  # noqa: E501
+
+        :return: The suspended_seconds of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._suspended_seconds
+
+    @suspended_seconds.setter
+    def suspended_seconds(self, suspended_seconds):
+        """Sets the suspended_seconds of this V1beta1ExperimentStatus.
+
+        Total time in seconds which the Experiment was suspended before it was resumed last time. Suspended time is not counted in the Experiment budget.  # noqa: E501
+
+        :param suspended_seconds: The suspended_seconds of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._suspended_seconds = suspended_seconds
+
     @property
     def trial_metrics_unavailable(self):
         """Gets the trial_metrics_unavailable of this V1beta1ExperimentStatus.  # noqa: E501
@@ -507,6 +622,29 @@ class V1beta1ExperimentStatus(object):
 
         self._trials_failed = trials_failed
 
+    @property
+    def trials_infeasible(self):
+        """Gets the trials_infeasible of this V1beta1ExperimentStatus.  # noqa: E501
+
+        How many trials don't satisfy the objective constraints.  # noqa: E501
+
+        :return: The trials_infeasible of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._trials_infeasible
+
+    @trials_infeasible.setter
+    def trials_infeasible(self, trials_infeasible):
+        """Sets the trials_infeasible of this V1beta1ExperimentStatus.
+
+        How many trials don't satisfy the objective constraints.  # noqa: E501
+
+        :param trials_infeasible: The trials_infeasible of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._trials_infeasible = trials_infeasible
+
     @property
     def trials_killed(self):
         """Gets the trials_killed of this V1beta1ExperimentStatus.  # noqa: E501
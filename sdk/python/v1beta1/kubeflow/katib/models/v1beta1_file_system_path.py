@@ -35,16 +35,18 @@ class V1beta1FileSystemPath(object):
     openapi_types = {
         'format': 'str',
         'kind': 'str',
-        'path': 'str'
+        'path': 'str',
+        'timestamp_key': 'str'
     }
 
     attribute_map = {
         'format': 'format',
         'kind': 'kind',
-        'path': 'path'
+        'path': 'path',
+        'timestamp_key': 'timestampKey'
     }
 
-    def __init__(self, format=None, kind=None, path=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, format=None, kind=None, path=None, timestamp_key=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1FileSystemPath - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -53,6 +55,7 @@ class V1beta1FileSystemPath(object):
         self._format = None
         self._kind = None
         self._path = None
+        self._timestamp_key = None
         self.discriminator = None
 
         if format is not None:
@@ -61,6 +64,8 @@ class V1beta1FileSystemPath(object):
             self.kind = kind
         if path is not None:
             self.path = path
+        if timestamp_key is not None:
+            self.timestamp_key = timestamp_key
 
     @property
     def format(self):
@@ -125,6 +130,29 @@ class V1beta1FileSystemPath(object):
 
         self._path = path
 
+    @property
+    def timestamp_key(self):
+        """Gets the timestamp_key of this V1beta1FileSystemPath.  # noqa: E501
+
+        Key of the metrics timestamp in JSON, CSV and LOGFMT formats. Defaults to "timestamp". In JSON format, it can be GJSON path, e.g. "meta.time".  # noqa: E501
+
+        :return: The timestamp_key of this V1beta1FileSystemPath.  # noqa: E501
+        :rtype: str
+        """
+        return self._timestamp_key
+
+    @timestamp_key.setter
+    def timestamp_key(self, timestamp_key):
+        """Sets the timestamp_key of this V1beta1FileSystemPath.
+
+        Key of the metrics timestamp in JSON, CSV and LOGFMT formats. Defaults to "timestamp". In JSON format, it can be GJSON path, e.g. "meta.time".  # noqa: E501
+
+        :param timestamp_key: The timestamp_key of this V1beta1FileSystemPath.  # noqa: E501
+        :type: str
+        """
+
+        self._timestamp_key = timestamp_key
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
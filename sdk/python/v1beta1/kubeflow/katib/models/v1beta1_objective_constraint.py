@@ -0,0 +1,178 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1ObjectiveConstraint(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'comparison': 'str',
+        'metric_name': 'str',
+        'value': 'str'
+    }
+
+    attribute_map = {
+        'comparison': 'comparison',
+        'metric_name': 'metricName',
+        'value': 'value'
+    }
+
+    def __init__(self, comparison=None, metric_name=None, value=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1ObjectiveConstraint - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._comparison = None
+        self._metric_name = None
+        self._value = None
+        self.discriminator = None
+
+        if comparison is not None:
+            self.comparison = comparison
+        if metric_name is not None:
+            self.metric_name = metric_name
+        if value is not None:
+            self.value = value
+
+    @property
+    def comparison(self):
+        """Gets the comparison of this V1beta1ObjectiveConstraint.  # noqa: E501
+
+        Comparison defines correlation between metric value and constraint value. Trial is feasible if metric value satisfies the comparison, e.g. latency < 50.  # noqa: E501
+
+        :return: The comparison of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :rtype: str
+        """
+        return self._comparison
+
+    @comparison.setter
+    def comparison(self, comparison):
+        """Sets the comparison of this V1beta1ObjectiveConstraint.
+
+        Comparison defines correlation between metric value and constraint value. Trial is feasible if metric value satisfies the comparison, e.g. latency < 50.  # noqa: E501
+
+        :param comparison: The comparison of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :type: str
+        """
+
+        self._comparison = comparison
+
+    @property
+    def metric_name(self):
+        """Gets the metric_name of this V1beta1ObjectiveConstraint.  # noqa: E501
+
+        MetricName contains metric name for the constraint.  # noqa: E501
+
+        :return: The metric_name of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :rtype: str
+        """
+        return self._metric_name
+
+    @metric_name.setter
+    def metric_name(self, metric_name):
+        """Sets the metric_name of this V1beta1ObjectiveConstraint.
+
+        MetricName contains metric name for the constraint.  # noqa: E501
+
+        :param metric_name: The metric_name of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :type: str
+        """
+
+        self._metric_name = metric_name
+
+    @property
+    def value(self):
+        """Gets the value of this V1beta1ObjectiveConstraint.  # noqa: E501
+
+        Value contains metric value for the constraint.  # noqa: E501
+
+        :return: The value of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :rtype: str
+        """
+        return self._value
+
+    @value.setter
+    def value(self, value):
+        """Sets the value of this V1beta1ObjectiveConstraint.
+
+        Value contains metric value for the constraint.  # noqa: E501
+
+        :param value: The value of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :type: str
+        """
+
+        self._value = value
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ObjectiveConstraint):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1ObjectiveConstraint):
+            return True
+
+        return self.to_dict() != other.to_dict()
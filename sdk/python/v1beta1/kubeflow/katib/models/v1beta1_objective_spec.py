@@ -34,6 +34,8 @@ class V1beta1ObjectiveSpec(object):
     """
     openapi_types = {
         'additional_metric_names': 'list[str]',
+        'additional_objectives': 'list[V1beta1AdditionalObjective]',
+        'constraints': 'list[V1beta1ObjectiveConstraint]',
         'goal': 'float',
         'metric_strategies': 'list[V1beta1MetricStrategy]',
         'objective_metric_name': 'str',
@@ -42,19 +44,23 @@ class V1beta1ObjectiveSpec(object):
 
     attribute_map = {
         'additional_metric_names': 'additionalMetricNames',
+        'additional_objectives': 'additionalObjectives',
+        'constraints': 'constraints',
         'goal': 'goal',
         'metric_strategies': 'metricStrategies',
         'objective_metric_name': 'objectiveMetricName',
         'type': 'type'
     }
 
-    def __init__(self, additional_metric_names=None, goal=None, metric_strategies=None, objective_metric_name=None, type=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, additional_metric_names=None, additional_objectives=None, constraints=None, goal=None, metric_strategies=None, objective_metric_name=None, type=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ObjectiveSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
         self._additional_metric_names = None
+        self._additional_objectives = None
+        self._constraints = None
         self._goal = None
         self._metric_strategies = None
         self._objective_metric_name = None
@@ -63,6 +69,10 @@ class V1beta1ObjectiveSpec(object):
 
         if additional_metric_names is not None:
             self.additional_metric_names = additional_metric_names
+        if additional_objectives is not None:
+            self.additional_objectives = additional_objectives
+        if constraints is not None:
+            self.constraints = constraints
         if goal is not None:
             self.goal = goal
         if metric_strategies is not None:
@@ -95,6 +105,52 @@ class V1beta1ObjectiveSpec(object):
 
         self._additional_metric_names = additional_metric_names
 
+    @property
+    def additional_objectives(self):
+        """Gets the additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+
+        AdditionalObjectives represents metrics which are optimized together with the primary metric in the multi-objective Experiment. Each objective has its own type and goal. For the multi-objective Experiment status contains the Pareto front of Trials. Experiment defaulter (webhook) adds the objective metrics to AdditionalMetricNames. Additional objectives are supported only by algorithms which sample independently of objective values: random, grid and sobol.  # noqa: E501
+
+        :return: The additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+        :rtype: list[V1beta1AdditionalObjective]
+        """
+        return self._additional_objectives
+
+    @additional_objectives.setter
+    def additional_objectives(self, additional_objectives):
+        """Sets the additional_objectives of this V1beta1ObjectiveSpec.
+
+        AdditionalObjectives represents metrics which are optimized together with the primary metric in the multi-objective Experiment. Each objective has its own type and goal. For the multi-objective Experiment status contains the Pareto front of Trials. Experiment defaulter (webhook) adds the objective metrics to AdditionalMetricNames. Additional objectives are supported only by algorithms which sample independently of objective values: random, grid and sobol.  # noqa: E501
+
+        :param additional_objectives: The additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+        :type: list[V1beta1AdditionalObjective]
+        """
+
+        self._additional_objectives = additional_objectives
+
+    @property
+    def constraints(self):
+        """Gets the constraints of this V1beta1ObjectiveSpec.  # noqa: E501
+
+        Constraints represents outcome constraints which Trial metrics must satisfy, e.g. latency less than 50. Infeasible Trials are not considered as optimal Trials. Experiment defaulter (webhook) adds the constraint metrics to AdditionalMetricNames.  # noqa: E501
+
+        :return: The constraints of this V1beta1ObjectiveSpec.  # noqa: E501
+        :rtype: list[V1beta1ObjectiveConstraint]
+        """
+        return self._constraints
+
+    @constraints.setter
+    def constraints(self, constraints):
+        """Sets the constraints of this V1beta1ObjectiveSpec.
+
+        Constraints represents outcome constraints which Trial metrics must satisfy, e.g. latency less than 50. Infeasible Trials are not considered as optimal Trials. Experiment defaulter (webhook) adds the constraint metrics to AdditionalMetricNames.  # noqa: E501
+
+        :param constraints: The constraints of this V1beta1ObjectiveSpec.  # noqa: E501
+        :type: list[V1beta1ObjectiveConstraint]
+        """
+
+        self._constraints = constraints
+
     @property
     def goal(self):
         """Gets the goal of this V1beta1ObjectiveSpec.  # noqa: E501
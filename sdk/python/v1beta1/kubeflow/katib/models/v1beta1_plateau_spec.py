@@ -0,0 +1,150 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1PlateauSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'delta': 'float',
+        'trial_count': 'int'
+    }
+
+    attribute_map = {
+        'delta': 'delta',
+        'trial_count': 'trialCount'
+    }
+
+    def __init__(self, delta=None, trial_count=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1PlateauSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._delta = None
+        self._trial_count = None
+        self.discriminator = None
+
+        if delta is not None:
+            self.delta = delta
+        if trial_count is not None:
+            self.trial_count = trial_count
+
+    @property
+    def delta(self):
+        """Gets the delta of this V1beta1PlateauSpec.  # noqa: E501
+
+        Min improvement of the best objective value which is not considered as plateau. Defaults to 0, any improvement of the best objective value is not considered as plateau.  # noqa: E501
+
+        :return: The delta of this V1beta1PlateauSpec.  # noqa: E501
+        :rtype: float
+        """
+        return self._delta
+
+    @delta.setter
+    def delta(self, delta):
+        """Sets the delta of this V1beta1PlateauSpec.
+
+        Min improvement of the best objective value which is not considered as plateau. Defaults to 0, any improvement of the best objective value is not considered as plateau.  # noqa: E501
+
+        :param delta: The delta of this V1beta1PlateauSpec.  # noqa: E501
+        :type: float
+        """
+
+        self._delta = delta
+
+    @property
+    def trial_count(self):
+        """Gets the trial_count of this V1beta1PlateauSpec.  # noqa: E501
+
+        Number of the last completed Trials which are compared with the previous Trials.  # noqa: E501
+
+        :return: The trial_count of this V1beta1PlateauSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._trial_count
+
+    @trial_count.setter
+    def trial_count(self, trial_count):
+        """Sets the trial_count of this V1beta1PlateauSpec.
+
+        Number of the last completed Trials which are compared with the previous Trials.  # noqa: E501
+
+        :param trial_count: The trial_count of this V1beta1PlateauSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._trial_count = trial_count
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1PlateauSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1PlateauSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -43,7 +43,9 @@ class V1beta1TrialSpec(object):
         'primary_pod_labels': 'dict(str, str)',
         'retain_run': 'bool',
         'run_spec': 'object',
-        'success_condition': 'str'
+        'success_condition': 'str',
+        'suspend': 'bool',
+        'suspend_policy': 'str'
     }
 
     attribute_map = {
@@ -57,10 +59,12 @@ class V1beta1TrialSpec(object):
         'primary_pod_labels': 'primaryPodLabels',
         'retain_run': 'retainRun',
         'run_spec': 'runSpec',
-        'success_condition': 'successCondition'
+        'success_condition': 'successCondition',
+        'suspend': 'suspend',
+        'suspend_policy': 'suspendPolicy'
     }
 
-    def __init__(self, early_stopping_rules=None, failure_condition=None, labels=None, metrics_collector=None, objective=None, parameter_assignments=None, primary_container_name=None, primary_pod_labels=None, retain_run=None, run_spec=None, success_condition=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, early_stopping_rules=None, failure_condition=None, labels=None, metrics_collector=None, objective=None, parameter_assignments=None, primary_container_name=None, primary_pod_labels=None, retain_run=None, run_spec=None, success_condition=None, suspend=None, suspend_policy=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -77,6 +81,8 @@ class V1beta1TrialSpec(object):
         self._retain_run = None
         self._run_spec = None
         self._success_condition = None
+        self._suspend = None
+        self._suspend_policy = None
         self.discriminator = None
 
         if early_stopping_rules is not None:
@@ -101,6 +107,10 @@ class V1beta1TrialSpec(object):
             self.run_spec = run_spec
         if success_condition is not None:
             self.success_condition = success_condition
+        if suspend is not None:
+            self.suspend = suspend
+        if suspend_policy is not None:
+            self.suspend_policy = suspend_policy
 
     @property
     def early_stopping_rules(self):
@@ -349,6 +359,52 @@ class V1beta1TrialSpec(object):
 
         self._success_condition = success_condition
 
+    @property
+    def suspend(self):
+        """Gets the suspend of this V1beta1TrialSpec.  # noqa: E501
+
+        Suspend indicates that the trial run object is suspended together with the experiment.  # noqa: E501
+
+        :return: The suspend of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: bool
+        """
+        return self._suspend
+
+    @suspend.setter
+    def suspend(self, suspend):
+        """Sets the suspend of this V1beta1TrialSpec.
+
+        Suspend indicates that the trial run object is suspended together with the experiment.  # noqa: E501
+
+        :param suspend: The suspend of this V1beta1TrialSpec.  # noqa: E501
+        :type: bool
+        """
+
+        self._suspend = suspend
+
+    @property
+    def suspend_policy(self):
+        """Gets the suspend_policy of this V1beta1TrialSpec.  # noqa: E501
+
+        Describes how the trial run object is suspended, the run object is suspended or deleted.  # noqa: E501
+
+        :return: The suspend_policy of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._suspend_policy
+
+    @suspend_policy.setter
+    def suspend_policy(self, suspend_policy):
+        """Sets the suspend_policy of this V1beta1TrialSpec.
+
+        Describes how the trial run object is suspended, the run object is suspended or deleted.  # noqa: E501
+
+        :param suspend_policy: The suspend_policy of this V1beta1TrialSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._suspend_policy = suspend_policy
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
@@ -37,7 +37,9 @@ class V1beta1TrialStatus(object):
         'conditions': 'list[V1beta1TrialCondition]',
         'last_reconcile_time': 'datetime',
         'observation': 'V1beta1Observation',
-        'start_time': 'datetime'
+        'start_time': 'datetime',
+        'suspend_time': 'datetime',
+        'suspended_seconds': 'int'
     }
 
     attribute_map = {
@@ -45,10 +47,12 @@ class V1beta1TrialStatus(object):
         'conditions': 'conditions',
         'last_reconcile_time': 'lastReconcileTime',
         'observation': 'observation',
-        'start_time': 'startTime'
+        'start_time': 'startTime',
+        'suspend_time': 'suspendTime',
+        'suspended_seconds': 'suspendedSeconds'
     }
 
-    def __init__(self, completion_time=None, conditions=None, last_reconcile_time=None, observation=None, start_time=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, completion_time=None, conditions=None, last_reconcile_time=None, observation=None, start_time=None, suspend_time=None, suspended_seconds=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -59,6 +63,8 @@ class V1beta1TrialStatus(object):
         self._last_reconcile_time = None
         self._observation = None
         self._start_time = None
+        self._suspend_time = None
+        self._suspended_seconds = None
         self.discriminator = None
 
         if completion_time is not None:
@@ -71,6 +77,10 @@ class V1beta1TrialStatus(object):
             self.observation = observation
         if start_time is not None:
             self.start_time = start_time
+        if suspend_time is not None:
+            self.suspend_time = suspend_time
+        if suspended_seconds is not None:
+            self.suspended_seconds = suspended_seconds
 
     @property
     def completion_time(self):
@@ -179,6 +189,50 @@ class V1beta1TrialStatus(object):
 
         self._start_time = start_time
 
+    @property
+    def suspend_time(self):
+        """Gets the suspend_time of this V1beta1TrialStatus.  # noqa: E501
+
+
+        :return: The suspend_time of this V1beta1TrialStatus.  # noqa: E501
+        :rtype: datetime
+        """
+        return self._suspend_time
+
+    @suspend_time.setter
+    def suspend_time(self, suspend_time):
+        """Sets the suspend_time of this V1beta1TrialStatus.
+
+
+        :param suspend_time: The suspend_time of this V1beta1TrialStatus.  # noqa: E501
+        :type: datetime
+        """
+
+        self._suspend_time = suspend_time
+
+    @property
+    def suspended_seconds(self):
+        """Gets the suspended_seconds of this V1beta1TrialStatus.  # noqa: E501
+
+        Total time in seconds which the Trial was suspended before it was resumed last time. Suspended time is not counted in the Experiment budget.  # noqa: E501
+
+        :return: The suspended_seconds of this V1beta1TrialStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._suspended_seconds
+
+    @suspended_seconds.setter
+    def suspended_seconds(self, suspended_seconds):
+        """Sets the suspended_seconds of this V1beta1TrialStatus.
+
+        Total time in seconds which the Trial was suspended before it was resumed last time. Suspended time is not counted in the Experiment budget.  # noqa: E501
+
+        :param suspended_seconds: The suspended_seconds of this V1beta1TrialStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._suspended_seconds = suspended_seconds
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}